@@ -0,0 +1,446 @@
+package aim
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// aggregation computes a single value out of the sorted values of a step.
+type aggregation func(sorted []float64) float64
+
+// groupByField is a field of the run or of the metric, which the metric series are grouped by.
+type groupByField struct {
+	name string
+	kind string
+	key  string
+}
+
+// metricSeriesKey identifies a single metric series of a run.
+type metricSeriesKey struct {
+	runID     string
+	key       string
+	contextID uint
+}
+
+// metricGroup keeps the values of the metric series belonging to the same group.
+type metricGroup struct {
+	name   string
+	group  fiber.Map
+	runs   []string
+	values map[int64][]float64
+}
+
+func AggregateMetrics(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("aggregateMetrics namespace: %s", ns.Code)
+
+	var q request.AggregateMetricsRequest
+	if err = c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if c.Query("p") == "" {
+		q.Steps = 50
+	}
+	if len(q.Aggregations) == 0 {
+		q.Aggregations = []string{"mean"}
+	}
+
+	aggregations := make(map[string]aggregation, len(q.Aggregations))
+	for _, name := range q.Aggregations {
+		fn, err := parseAggregation(name)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+		aggregations[name] = fn
+	}
+
+	groupBy := make([]groupByField, len(q.GroupBy))
+	for i, name := range q.GroupBy {
+		field, err := parseGroupByField(name)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+		groupBy[i] = field
+	}
+
+	tzOffset, err := strconv.Atoi(c.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "experiments",
+			"metrics":     "latest_metrics",
+		},
+		TzOffset:  tzOffset,
+		Dialector: database.DB.Dialector.Name(),
+	}
+	pq, err := qp.Parse(q.Query)
+	if err != nil {
+		return err
+	}
+
+	if !pq.IsMetricSelected() {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "No metrics are selected")
+	}
+
+	var runs []database.Run
+	if tx := database.DB.
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID", "Name",
+			).Where(&models.Experiment{NamespaceID: ns.ID}),
+		).
+		Preload("Params").
+		Preload("Tags").
+		Where("run_uuid IN (?)", pq.Filter(database.DB.
+			Select("runs.run_uuid").
+			Table("runs").
+			Joins(
+				"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+				ns.ID,
+			).
+			Joins("LEFT JOIN metrics USING(run_uuid)").
+			Joins("LEFT JOIN latest_metrics USING(run_uuid)"))).
+		Find(&runs); tx.Error != nil {
+		return fmt.Errorf("error aggregating run metrics: %w", tx.Error)
+	}
+
+	runsByID := make(map[string]*database.Run, len(runs))
+	for i := range runs {
+		runsByID[runs[i].ID] = &runs[i]
+	}
+
+	// the steps are sampled in the database, so only the values of the sampled steps are read.
+	// Like sampleIndices does for a group, evenly distributed steps are selected out of
+	// the distinct steps of all the selected series of the metric.
+	tx := database.DB.
+		Select(
+			"metrics.*",
+			"DENSE_RANK() OVER (PARTITION BY metrics.key ORDER BY metrics.step) - 1 AS step_index",
+		).
+		Table("metrics").
+		Joins(
+			"INNER JOIN (?) runmetrics USING(run_uuid, key)",
+			pq.Filter(database.DB.
+				Distinct(
+					"runs.run_uuid",
+					"latest_metrics.key",
+				).
+				Table("runs").
+				Joins(
+					"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+					ns.ID,
+				).
+				Joins("LEFT JOIN metrics USING(run_uuid)").
+				Joins("LEFT JOIN latest_metrics USING(run_uuid)")),
+		).
+		Where("metrics.is_nan = ?", false)
+	tx = database.DB.
+		Select(`
+			metrics.*,
+			c.json AS context_json`,
+		).
+		Table(
+			"(?) metrics",
+			database.DB.Select(
+				"metrics.*",
+				"MAX(metrics.step_index) OVER (PARTITION BY metrics.key) + 1 AS step_count",
+			).Table("(?) metrics", tx),
+		).
+		Joins("LEFT JOIN contexts AS c ON c.id = metrics.context_id")
+	if q.Steps > 0 {
+		tx = tx.Where(
+			"metrics.step_count <= ? OR "+
+				"(metrics.step_index * ? + metrics.step_count - 1) / metrics.step_count * metrics.step_count / ? = "+
+				"metrics.step_index",
+			q.Steps, q.Steps, q.Steps,
+		)
+	}
+	rows, err := tx.
+		Order("metrics.run_uuid").
+		Order("metrics.key").
+		Order("metrics.context_id").
+		Order("metrics.iter").
+		Rows()
+	if err != nil {
+		return fmt.Errorf("error aggregating run metrics: %w", err)
+	}
+	//nolint:errcheck
+	defer rows.Close()
+
+	// the value logged for the same step once again replaces the previous one.
+	var (
+		series       []metricSeriesKey
+		seriesGroups = map[metricSeriesKey]*metricGroup{}
+		seriesValues = map[metricSeriesKey]map[int64]float64{}
+		groups       = map[string]*metricGroup{}
+	)
+	for rows.Next() {
+		var metric struct {
+			database.Metric
+			Context datatypes.JSON `gorm:"column:context_json"`
+		}
+		if err := database.DB.ScanRows(rows, &metric); err != nil {
+			return fmt.Errorf("error aggregating run metrics: %w", err)
+		}
+
+		key := metricSeriesKey{runID: metric.RunID, key: metric.Key}
+		if metric.ContextID != nil {
+			key.contextID = *metric.ContextID
+		}
+		if _, ok := seriesValues[key]; !ok {
+			run, ok := runsByID[metric.RunID]
+			if !ok {
+				continue
+			}
+			group, err := getMetricGroup(groups, groupBy, run, metric.Key, metric.Context)
+			if err != nil {
+				return fmt.Errorf("error aggregating run metrics: %w", err)
+			}
+			series = append(series, key)
+			seriesGroups[key] = group
+			seriesValues[key] = map[int64]float64{}
+		}
+		seriesValues[key][metric.Step] = metric.Value
+	}
+	if err := rows.Err(); err != nil {
+		return api.NewInternalError("error getting query result: %s", err)
+	}
+
+	for _, key := range series {
+		group := seriesGroups[key]
+		if len(group.runs) == 0 || group.runs[len(group.runs)-1] != key.runID {
+			group.runs = append(group.runs, key.runID)
+		}
+		for step, value := range seriesValues[key] {
+			group.values[step] = append(group.values[step], value)
+		}
+	}
+
+	groupKeys := make([]string, 0, len(groups))
+	for key := range groups {
+		groupKeys = append(groupKeys, key)
+	}
+	sort.Strings(groupKeys)
+
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			for i, key := range groupKeys {
+				if err := encoding.EncodeTree(w, fiber.Map{
+					fmt.Sprintf("%d", i): aggregateMetricGroup(groups[key], aggregations, q.Steps),
+				}); err != nil {
+					return err
+				}
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+			return nil
+		}(); err != nil {
+			log.Errorf("Error encountered in %s %s: error streaming metric aggregations: %s", c.Method(), c.Path(), err)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+	})
+
+	return nil
+}
+
+// parseGroupByField parses the name of the field, which the metric series are grouped by.
+func parseGroupByField(name string) (groupByField, error) {
+	if name == "run.experiment" {
+		return groupByField{name: name, kind: "experiment"}, nil
+	}
+	for prefix, kind := range map[string]string{
+		"run.hparams.":    "params",
+		"run.params.":     "params",
+		"run.tags.":       "tags",
+		"metric.context.": "context",
+	} {
+		if key, ok := strings.CutPrefix(name, prefix); ok && key != "" {
+			return groupByField{name: name, kind: kind, key: key}, nil
+		}
+	}
+	return groupByField{}, fmt.Errorf("invalid group_by field %q", name)
+}
+
+// getMetricGroup returns the group of the metric series, creating it when needed.
+func getMetricGroup(
+	groups map[string]*metricGroup, groupBy []groupByField, run *database.Run, name string, contextJSON datatypes.JSON,
+) (*metricGroup, error) {
+	var context map[string]any
+	group := make(fiber.Map, len(groupBy))
+	for _, field := range groupBy {
+		var value any
+		switch field.kind {
+		case "experiment":
+			value = run.Experiment.Name
+		case "params":
+			for _, p := range run.Params {
+				if p.Key == field.key {
+					value = p.Value
+				}
+			}
+		case "tags":
+			for _, t := range run.Tags {
+				if t.Key == field.key {
+					value = t.Value
+				}
+			}
+		case "context":
+			if context == nil {
+				context = map[string]any{}
+				if len(contextJSON) > 0 {
+					if err := json.Unmarshal(contextJSON, &context); err != nil {
+						return nil, err
+					}
+				}
+			}
+			value = context[field.key]
+		}
+		group[field.name] = value
+	}
+
+	values := make([]any, 0, len(groupBy)+1)
+	values = append(values, name)
+	for _, field := range groupBy {
+		values = append(values, group[field.name])
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	key := string(data)
+	if _, ok := groups[key]; !ok {
+		groups[key] = &metricGroup{
+			name:   name,
+			group:  group,
+			values: map[int64][]float64{},
+		}
+	}
+	return groups[key], nil
+}
+
+// aggregateMetricGroup aggregates the values of the metric group aligned on step.
+func aggregateMetricGroup(group *metricGroup, aggregations map[string]aggregation, density int) fiber.Map {
+	steps := make([]int64, 0, len(group.values))
+	for step := range group.values {
+		steps = append(steps, step)
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i] < steps[j] })
+
+	indices := sampleIndices(len(steps), density)
+	sampledSteps := make([]float64, len(indices))
+	counts := make([]float64, len(indices))
+	aggregated := make(map[string][]float64, len(aggregations))
+	for name := range aggregations {
+		aggregated[name] = make([]float64, len(indices))
+	}
+	for i, n := range indices {
+		values := group.values[steps[n]]
+		sort.Float64s(values)
+		sampledSteps[i] = float64(steps[n])
+		counts[i] = float64(len(values))
+		for name, fn := range aggregations {
+			aggregated[name][i] = fn(values)
+		}
+	}
+
+	values := make(fiber.Map, len(aggregated))
+	for name, v := range aggregated {
+		values[name] = toNumpy(v)
+	}
+	return fiber.Map{
+		"name":   group.name,
+		"group":  group.group,
+		"runs":   group.runs,
+		"steps":  toNumpy(sampledSteps),
+		"counts": toNumpy(counts),
+		"values": values,
+	}
+}
+
+// parseAggregation returns the aggregation of the provided name, one of `mean`, `median`, `min`, `max`, `std`
+// or the percentile `pNN`, where NN is between 0 and 100.
+func parseAggregation(name string) (aggregation, error) {
+	switch name {
+	case "mean":
+		return aggregateMean, nil
+	case "median":
+		return func(sorted []float64) float64 { return aggregatePercentile(sorted, 50) }, nil
+	case "min":
+		return func(sorted []float64) float64 { return sorted[0] }, nil
+	case "max":
+		return func(sorted []float64) float64 { return sorted[len(sorted)-1] }, nil
+	case "std":
+		return aggregateStd, nil
+	}
+	if p, ok := strings.CutPrefix(name, "p"); ok {
+		percentile, err := strconv.ParseFloat(p, 64)
+		if err == nil && percentile >= 0 && percentile <= 100 {
+			return func(sorted []float64) float64 { return aggregatePercentile(sorted, percentile) }, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid aggregation %q", name)
+}
+
+// aggregateMean returns the arithmetic mean of the values.
+func aggregateMean(sorted []float64) float64 {
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	return sum / float64(len(sorted))
+}
+
+// aggregateStd returns the population standard deviation of the values.
+func aggregateStd(sorted []float64) float64 {
+	mean := aggregateMean(sorted)
+	var sum float64
+	for _, v := range sorted {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(len(sorted)))
+}
+
+// aggregatePercentile returns the percentile of the sorted values,
+// interpolating linearly between the closest ones like numpy does.
+func aggregatePercentile(sorted []float64, percentile float64) float64 {
+	rank := percentile / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
@@ -0,0 +1,51 @@
+package aim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseAggregation(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4}
+	tests := []struct {
+		name     string
+		expected float64
+	}{
+		{name: "mean", expected: 2.5},
+		{name: "median", expected: 2.5},
+		{name: "min", expected: 1},
+		{name: "max", expected: 4},
+		{name: "std", expected: 1.118033988749895},
+		{name: "p0", expected: 1},
+		{name: "p25", expected: 1.75},
+		{name: "p90", expected: 3.7},
+		{name: "p100", expected: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := parseAggregation(tt.name)
+			require.Nil(t, err)
+			assert.InDelta(t, tt.expected, fn(sorted), 1e-9)
+		})
+	}
+
+	for _, name := range []string{"sum", "p", "p101", "p-1", "pxx"} {
+		_, err := parseAggregation(name)
+		assert.EqualError(t, err, `invalid aggregation "`+name+`"`)
+	}
+}
+
+func Test_parseGroupByField(t *testing.T) {
+	field, err := parseGroupByField("run.hparams.lr")
+	require.Nil(t, err)
+	assert.Equal(t, groupByField{name: "run.hparams.lr", kind: "params", key: "lr"}, field)
+
+	field, err = parseGroupByField("metric.context.subset")
+	require.Nil(t, err)
+	assert.Equal(t, groupByField{name: "metric.context.subset", kind: "context", key: "subset"}, field)
+
+	_, err = parseGroupByField("run.tags.")
+	assert.EqualError(t, err, `invalid group_by field "run.tags."`)
+}
@@ -0,0 +1,531 @@
+package aim
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	// image formats, which dimensions can be detected for logged images.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// defaultBlobFormats contains the formats of the records, which are logged without explicit format.
+var defaultBlobFormats = map[database.BlobType]string{
+	database.BlobTypeImage: "png",
+	database.BlobTypeAudio: "wav",
+}
+
+// blobRecord represents the stored image or audio record along with its context.
+type blobRecord struct {
+	database.Blob
+	Context datatypes.JSON `gorm:"column:context_json"`
+}
+
+// blobStorage provides access to the content of the image and audio records,
+// which is kept in the artifact storage of the runs.
+type blobStorage struct {
+	config                 *config.ServiceConfig
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// newBlobStorage creates new instance of blobStorage.
+func newBlobStorage(
+	config *config.ServiceConfig, artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *blobStorage {
+	return &blobStorage{
+		config:                 config,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+func (s blobStorage) LogRunImages(c *fiber.Ctx) error {
+	return s.logRunBlobs(c, database.BlobTypeImage)
+}
+
+func (s blobStorage) LogRunAudios(c *fiber.Ctx) error {
+	return s.logRunBlobs(c, database.BlobTypeAudio)
+}
+
+func (s blobStorage) GetImagesBatch(c *fiber.Ctx) error {
+	return s.getBlobsBatch(c, database.BlobTypeImage)
+}
+
+func (s blobStorage) GetAudiosBatch(c *fiber.Ctx) error {
+	return s.getBlobsBatch(c, database.BlobTypeAudio)
+}
+
+func SearchImages(c *fiber.Ctx) error {
+	return searchBlobs(c, database.BlobTypeImage)
+}
+
+func SearchAudios(c *fiber.Ctx) error {
+	return searchBlobs(c, database.BlobTypeAudio)
+}
+
+func GetRunImagesBatch(c *fiber.Ctx) error {
+	return getRunBlobsBatch(c, database.BlobTypeImage)
+}
+
+func GetRunAudiosBatch(c *fiber.Ctx) error {
+	return getRunBlobsBatch(c, database.BlobTypeAudio)
+}
+
+func (s blobStorage) logRunBlobs(c *fiber.Ctx, blobType database.BlobType) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunBlobs namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunBlobsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	for _, record := range req.Records {
+		if record.Name == "" {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "record name is required")
+		}
+		if len(record.Data) == 0 {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("record %q has no data", record.Name))
+		}
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	artifactStorage, artifactURI, err := s.getStorage(c.Context(), run)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	timestamp := time.Now().UnixMilli()
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		for _, record := range req.Records {
+			context, err := getOrCreateContext(tx, record.Context)
+			if err != nil {
+				return err
+			}
+
+			blob := newBlob(run.ID, blobType, context.ID, &record)
+			if blob.Timestamp == 0 {
+				blob.Timestamp = timestamp
+			}
+			// the record logged once again for the same step and index replaces the previous one.
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{
+					{Name: "run_uuid"}, {Name: "type"}, {Name: "name"}, {Name: "context_id"}, {Name: "step"}, {Name: "idx"},
+				},
+				DoUpdates: clause.AssignmentColumns([]string{"timestamp", "caption", "format", "width", "height", "size"}),
+			}).Create(&blob).Error; err != nil {
+				return eris.Wrapf(err, "error creating record %q", record.Name)
+			}
+
+			if err := artifactStorage.Put(
+				c.Context(), artifactURI, blobPath(&blob), bytes.NewReader(record.Data),
+			); err != nil {
+				return eris.Wrapf(err, "error storing content of record %q", record.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to log %s of run %q: %s", blobType, p.ID, err),
+		)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func searchBlobs(c *fiber.Ctx, blobType database.BlobType) error {
+	search, err := newSequenceSearch(
+		c, string(blobType), "blobs",
+		"INNER JOIN blobs ON blobs.run_uuid = runs.run_uuid AND blobs.type = ?", blobType,
+	)
+	if err != nil {
+		return err
+	}
+
+	runs, totalRuns, err := search.findRuns()
+	if err != nil {
+		return err
+	}
+
+	var records []blobRecord
+	if tx := database.DB.
+		Select("blobs.*", "contexts.json AS context_json").
+		Table("blobs").
+		Joins(
+			"INNER JOIN (?) sequences USING(run_uuid, name, context_id)",
+			search.filter(database.DB.Distinct("blobs.run_uuid", "blobs.name", "blobs.context_id")),
+		).
+		Joins("INNER JOIN contexts ON contexts.id = blobs.context_id").
+		Where("blobs.type = ?", blobType).
+		Order("blobs.run_uuid").
+		Order("blobs.name").
+		Order("blobs.context_id").
+		Order("blobs.step").
+		Order("blobs.idx").
+		Find(&records); tx.Error != nil {
+		return fmt.Errorf("error searching run %s: %w", blobType, tx.Error)
+	}
+
+	runRecords := make(map[string][]blobRecord, len(runs))
+	for _, record := range records {
+		runRecords[record.RunID] = append(runRecords[record.RunID], record)
+	}
+
+	search.stream(c, runs, totalRuns, func(r *database.Run) ([]fiber.Map, fiber.Map, error) {
+		return newSequenceTraces(
+			newBlobItems(runRecords[r.ID]), search.recordRange, search.indexRange,
+			search.RecordDensity, search.IndexDensity,
+		)
+	})
+
+	return nil
+}
+
+func getRunBlobsBatch(c *fiber.Ctx, blobType database.BlobType) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunBlobsBatch namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	q := struct {
+		RecordRange   string `query:"record_range"`
+		RecordDensity int    `query:"record_density"`
+		IndexRange    string `query:"index_range"`
+		IndexDensity  int    `query:"index_density"`
+	}{}
+
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	recordRange, err := parseSequenceRange(q.RecordRange)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("record_range: %s", err))
+	}
+
+	indexRange, err := parseSequenceRange(q.IndexRange)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("index_range: %s", err))
+	}
+
+	var req request.GetRunSequencesBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	traces := make([]fiber.Map, 0, len(req))
+	for _, sequence := range req {
+		if sequence.Context == nil {
+			sequence.Context = map[string]any{}
+		}
+		context, err := json.Marshal(sequence.Context)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid context: %s", err))
+		}
+
+		var records []blobRecord
+		if err := database.DB.
+			Select("blobs.*", "contexts.json AS context_json").
+			Table("blobs").
+			Joins("INNER JOIN contexts ON contexts.id = blobs.context_id").
+			Where("blobs.run_uuid = ?", run.ID).
+			Where("blobs.type = ?", blobType).
+			Where("blobs.name = ?", sequence.Name).
+			Where("contexts.json = ?", datatypes.JSON(context)).
+			Order("blobs.step").
+			Order("blobs.idx").
+			Find(&records).
+			Error; err != nil {
+			return fmt.Errorf("error getting %s of run %q: %w", blobType, p.ID, err)
+		}
+
+		if len(records) > 0 {
+			trace, _, _, err := newSequenceTrace(
+				newBlobItems(records), recordRange, indexRange, q.RecordDensity, q.IndexDensity,
+			)
+			if err != nil {
+				return err
+			}
+			traces = append(traces, trace)
+		}
+	}
+
+	return c.JSON(traces)
+}
+
+func (s blobStorage) getBlobsBatch(c *fiber.Ctx, blobType database.BlobType) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getBlobsBatch namespace: %s", ns.Code)
+
+	var uris []string
+	if err := c.BodyParser(&uris); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	ids := make([]uint64, len(uris))
+	for i, uri := range uris {
+		id, err := strconv.ParseUint(uri, 10, 64)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid blob uri %q", uri))
+		}
+		ids[i] = id
+	}
+
+	var blobs []struct {
+		database.Blob
+		ArtifactURI string `gorm:"column:artifact_uri"`
+	}
+	if err := database.DB.
+		Select("blobs.*", "runs.artifact_uri").
+		Table("blobs").
+		Joins("INNER JOIN runs ON runs.run_uuid = blobs.run_uuid").
+		Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			ns.ID,
+		).
+		Where("blobs.type = ?", blobType).
+		Where("blobs.id IN ?", ids).
+		Find(&blobs).
+		Error; err != nil {
+		return fmt.Errorf("error getting %s: %w", blobType, err)
+	}
+
+	locations := make(map[string]int, len(blobs))
+	for i := range blobs {
+		locations[blobURI(&blobs[i].Blob)] = i
+	}
+
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			for _, uri := range uris {
+				// unknown records are skipped, the same way as Aim does it.
+				i, ok := locations[uri]
+				if !ok {
+					continue
+				}
+
+				data, err := s.readBlob(context.Background(), &database.Run{
+					ID:          blobs[i].RunID,
+					ArtifactURI: blobs[i].ArtifactURI,
+				}, &blobs[i].Blob)
+				if err != nil {
+					return err
+				}
+
+				if err := encoding.EncodeTree(w, fiber.Map{
+					uri: data,
+				}); err != nil {
+					return err
+				}
+
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+			return nil
+		}(); err != nil {
+			log.Errorf("Error encountered in %s %s: error streaming %s: %s", c.Method(), c.Path(), blobType, err)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+	})
+
+	return nil
+}
+
+// getStorage returns the artifact storage of the run along with its resolved artifact uri.
+func (s blobStorage) getStorage(
+	ctx context.Context, run *database.Run,
+) (storage.ArtifactStorageProvider, string, error) {
+	artifactURI, err := s.config.ResolveArtifactURI(run.ArtifactURI)
+	if err != nil {
+		return nil, "", eris.Wrapf(err, "run with id '%s' has incorrect artifact uri", run.ID)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return nil, "", eris.Wrapf(err, "run with id '%s' has unsupported artifact storage", run.ID)
+	}
+	return artifactStorage, artifactURI, nil
+}
+
+// readBlob reads the content of the record from the artifact storage of the run.
+func (s blobStorage) readBlob(ctx context.Context, run *database.Run, blob *database.Blob) ([]byte, error) {
+	artifactStorage, artifactURI, err := s.getStorage(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := artifactStorage.Get(ctx, artifactURI, blobPath(blob))
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting content of record %q", blobURI(blob))
+	}
+	//nolint:errcheck
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error reading content of record %q", blobURI(blob))
+	}
+	return data, nil
+}
+
+// newBlob creates the record of the run from the logged one.
+// Format and dimensions of the images are detected from the content, when they are not provided.
+func newBlob(runID string, blobType database.BlobType, contextID uint, record *request.BlobRecord) database.Blob {
+	blob := database.Blob{
+		RunID:     runID,
+		Type:      blobType,
+		Name:      record.Name,
+		ContextID: contextID,
+		Step:      record.Step,
+		Index:     record.Index,
+		Timestamp: record.Timestamp,
+		Caption:   record.Caption,
+		Format:    record.Format,
+		Width:     record.Width,
+		Height:    record.Height,
+		Size:      int64(len(record.Data)),
+	}
+
+	if blobType == database.BlobTypeImage && (blob.Format == "" || blob.Width == 0 || blob.Height == 0) {
+		if config, format, err := image.DecodeConfig(bytes.NewReader(record.Data)); err == nil {
+			if blob.Format == "" {
+				blob.Format = format
+			}
+			if blob.Width == 0 {
+				blob.Width = int64(config.Width)
+			}
+			if blob.Height == 0 {
+				blob.Height = int64(config.Height)
+			}
+		}
+	}
+
+	if blob.Format == "" {
+		blob.Format = defaultBlobFormats[blobType]
+	}
+
+	return blob
+}
+
+// blobPath returns the path of the record content inside the artifacts of the run.
+func blobPath(blob *database.Blob) string {
+	return fmt.Sprintf("aim/%s/%d", blob.Type, blob.ID)
+}
+
+// blobURI returns the uri, which Aim UI uses to request the content of the record.
+func blobURI(blob *database.Blob) string {
+	return strconv.FormatUint(uint64(blob.ID), 10)
+}
+
+// newBlobItems converts the records into the items of the sequence traces.
+func newBlobItems(records []blobRecord) []sequenceItem {
+	items := make([]sequenceItem, len(records))
+	for i := range records {
+		record := records[i]
+		items[i] = sequenceItem{
+			Name:      record.Name,
+			ContextID: record.ContextID,
+			Context:   record.Context,
+			Step:      record.Step,
+			Index:     record.Index,
+			Timestamp: record.Timestamp,
+			Value: func() (any, error) {
+				value := fiber.Map{
+					"caption":  record.Caption,
+					"format":   record.Format,
+					"blob_uri": blobURI(&record.Blob),
+					"index":    record.Index,
+				}
+				if record.Type == database.BlobTypeImage {
+					value["width"] = record.Width
+					value["height"] = record.Height
+				}
+				return value, nil
+			},
+		}
+	}
+	return items
+}
+
+// findBlobSequences returns the distinct image and audio sequences of the runs.
+func findBlobSequences(runIDs []string) ([]runSequence, error) {
+	var sequences []runSequence
+	if len(runIDs) == 0 {
+		return sequences, nil
+	}
+	if err := database.DB.
+		Distinct("blobs.run_uuid", "blobs.type", "blobs.name", "contexts.json AS context_json").
+		Table("blobs").
+		Joins("INNER JOIN contexts ON contexts.id = blobs.context_id").
+		Where("blobs.run_uuid IN ?", runIDs).
+		Order("blobs.name").
+		Find(&sequences).
+		Error; err != nil {
+		return nil, eris.Wrap(err, "error getting image and audio sequences")
+	}
+	return sequences, nil
+}
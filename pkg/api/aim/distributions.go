@@ -0,0 +1,454 @@
+package aim
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+const (
+	// DistributionDefaultBinCount is the number of bins used to bin raw values, when it is not provided.
+	DistributionDefaultBinCount = 64
+	// DistributionMaxBinCount is the maximum number of bins of the distribution.
+	DistributionMaxBinCount = 512
+)
+
+func LogRunDistributions(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunDistributions namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunDistributionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	distributions := make([]database.Distribution, len(req.Records))
+	for i, record := range req.Records {
+		distribution, err := newDistribution(&record)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+		distributions[i] = distribution
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	timestamp := time.Now().UnixMilli()
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// records are grouped by sequence, the record logged for the same step once again replaces the previous one.
+		type sequenceKey struct {
+			key       string
+			contextID uint
+		}
+		var (
+			sequences []sequenceKey
+			records   = map[sequenceKey][]database.Distribution{}
+			steps     = map[sequenceKey]map[int64]int{}
+		)
+		for i, record := range req.Records {
+			context, err := getOrCreateContext(tx, record.Context)
+			if err != nil {
+				return err
+			}
+
+			distribution := distributions[i]
+			distribution.RunID = run.ID
+			distribution.ContextID = context.ID
+			if distribution.Timestamp == 0 {
+				distribution.Timestamp = timestamp
+			}
+
+			sequence := sequenceKey{key: distribution.Key, contextID: distribution.ContextID}
+			if _, ok := records[sequence]; !ok {
+				sequences = append(sequences, sequence)
+				steps[sequence] = map[int64]int{}
+			}
+			if n, ok := steps[sequence][distribution.Step]; ok {
+				records[sequence][n] = distribution
+			} else {
+				steps[sequence][distribution.Step] = len(records[sequence])
+				records[sequence] = append(records[sequence], distribution)
+			}
+		}
+
+		for _, sequence := range sequences {
+			if err := assignDistributionIters(tx, run.ID, sequence.key, sequence.contextID, records[sequence]); err != nil {
+				return err
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{
+					{Name: "key"}, {Name: "run_uuid"}, {Name: "context_id"}, {Name: "step"},
+				},
+				DoUpdates: clause.AssignmentColumns([]string{"timestamp", "low", "high", "bin_count", "weights"}),
+			}).Create(records[sequence]).Error; err != nil {
+				return eris.Wrapf(err, "error creating distributions %q", sequence.key)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to log distributions of run %q: %s", p.ID, err),
+		)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func GetRunDistributionsBatch(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunDistributionsBatch namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	q := struct {
+		RecordRange   string `query:"record_range"`
+		RecordDensity int    `query:"record_density"`
+	}{}
+
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if c.Query("record_density") == "" {
+		q.RecordDensity = 50
+	}
+
+	recordRange, err := parseSequenceRange(q.RecordRange)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("record_range: %s", err))
+	}
+
+	var req request.GetRunSequencesBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	traces := make([]fiber.Map, 0, len(req))
+	for _, sequence := range req {
+		if sequence.Context == nil {
+			sequence.Context = map[string]any{}
+		}
+		data, err := json.Marshal(sequence.Context)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid context: %s", err))
+		}
+
+		var context database.Context
+		if err := database.DB.Where("json = ?", datatypes.JSON(data)).First(&context).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return fmt.Errorf("error getting distributions of run %q: %w", p.ID, err)
+		}
+
+		trace, err := getDistributionTrace(
+			run.ID, sequence.Name, &context, recordRange, q.RecordDensity,
+		)
+		if err != nil {
+			return fmt.Errorf("error getting distributions of run %q: %w", p.ID, err)
+		}
+		if trace != nil {
+			traces = append(traces, trace)
+		}
+	}
+
+	return c.JSON(traces)
+}
+
+// getDistributionTrace returns the trace of the distributions of the sequence inside the requested step range,
+// sampled the same way as metrics are, or nil when the sequence has no distributions.
+func getDistributionTrace(
+	runID, key string, context *database.Context, recordRange sequenceRange, recordDensity int,
+) (fiber.Map, error) {
+	sequence := func() *gorm.DB {
+		return database.DB.
+			Table("distributions").
+			Where("run_uuid = ?", runID).
+			Where("key = ?", key).
+			Where("context_id = ?", context.ID)
+	}
+
+	var total struct {
+		Count   int64
+		MinStep int64
+		MaxStep int64
+	}
+	if err := sequence().
+		Select("COUNT(*) AS count", "MIN(step) AS min_step", "MAX(step) AS max_step").
+		Scan(&total).
+		Error; err != nil {
+		return nil, eris.Wrap(err, "error getting distributions range")
+	}
+	if total.Count == 0 {
+		return nil, nil
+	}
+	recordUsed := recordRange.resolve([2]int64{total.MinStep, total.MaxStep + 1})
+
+	tx := database.DB.
+		Select("distributions.*").
+		Table("distributions").
+		Where("distributions.run_uuid = ?", runID).
+		Where("distributions.key = ?", key).
+		Where("distributions.context_id = ?", context.ID).
+		Where("distributions.step >= ? AND distributions.step < ?", recordUsed[0], recordUsed[1]).
+		Order("distributions.step")
+	if recordDensity > 0 {
+		tx = tx.
+			Joins(
+				"INNER JOIN (?) sequences USING(run_uuid, key, context_id)",
+				sequence().
+					Select(
+						"run_uuid", "key", "context_id", "MIN(iter) AS first_iter",
+						samplingInterval("MAX(iter) - MIN(iter)", recordDensity),
+					).
+					Where("step >= ? AND step < ?", recordUsed[0], recordUsed[1]).
+					Group("run_uuid").
+					Group("key").
+					Group("context_id"),
+			).
+			Where(samplingCondition("distributions.iter - sequences.first_iter", "sequences.interval"))
+	}
+
+	var distributions []database.Distribution
+	if err := tx.Find(&distributions).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting distributions")
+	}
+
+	values := make([]fiber.Map, len(distributions))
+	iters := make([]int64, len(distributions))
+	timestamps := make([]float64, len(distributions))
+	for i, distribution := range distributions {
+		weights, err := decodeWeights(distribution.Weights)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = fiber.Map{
+			"data":      toNumpy(weights),
+			"bin_count": distribution.BinCount,
+			"range":     []float64{distribution.Low, distribution.High},
+		}
+		iters[i] = distribution.Step
+		timestamps[i] = float64(distribution.Timestamp) / 1000
+	}
+
+	contextValue, err := decodeContext(context.Json)
+	if err != nil {
+		return nil, err
+	}
+
+	return fiber.Map{
+		"name":         key,
+		"context":      contextValue,
+		"values":       values,
+		"iters":        iters,
+		"epochs":       iters,
+		"timestamps":   timestamps,
+		"record_range": recordUsed[:],
+	}, nil
+}
+
+// assignDistributionIters assigns the iterations to the distributions of the sequence in the logged order.
+// Distributions replacing the already logged steps keep their iterations.
+func assignDistributionIters(
+	tx *gorm.DB, runID, key string, contextID uint, distributions []database.Distribution,
+) error {
+	steps := make([]int64, len(distributions))
+	for i, distribution := range distributions {
+		steps[i] = distribution.Step
+	}
+
+	var existing []database.Distribution
+	if err := tx.
+		Select("step", "iter").
+		Where("run_uuid = ? AND key = ? AND context_id = ?", runID, key, contextID).
+		Where("step IN ?", steps).
+		Find(&existing).
+		Error; err != nil {
+		return eris.Wrapf(err, "error getting distributions %q", key)
+	}
+	iters := make(map[int64]int64, len(existing))
+	for _, distribution := range existing {
+		iters[distribution.Step] = distribution.Iter
+	}
+
+	var lastIter int64
+	if err := tx.
+		Model(&database.Distribution{}).
+		Select("COALESCE(MAX(iter), 0)").
+		Where("run_uuid = ? AND key = ? AND context_id = ?", runID, key, contextID).
+		Scan(&lastIter).
+		Error; err != nil {
+		return eris.Wrapf(err, "error getting last iteration of distributions %q", key)
+	}
+
+	for i := range distributions {
+		if iter, ok := iters[distributions[i].Step]; ok {
+			distributions[i].Iter = iter
+			continue
+		}
+		lastIter++
+		distributions[i].Iter = lastIter
+	}
+	return nil
+}
+
+// newDistribution validates the logged record and creates the distribution from it.
+func newDistribution(record *request.DistributionRecord) (database.Distribution, error) {
+	distribution := database.Distribution{
+		Key:       record.Name,
+		Step:      record.Step,
+		Timestamp: record.Timestamp,
+	}
+	if record.Name == "" {
+		return distribution, errors.New("record name is required")
+	}
+
+	switch {
+	case len(record.Weights) > 0:
+		if len(record.Weights) > DistributionMaxBinCount {
+			return distribution, fmt.Errorf(
+				"record %q has more than %d bins", record.Name, DistributionMaxBinCount,
+			)
+		}
+		if len(record.Range) != 2 || record.Range[0] >= record.Range[1] {
+			return distribution, fmt.Errorf("record %q has invalid range %v", record.Name, record.Range)
+		}
+		distribution.Low, distribution.High = record.Range[0], record.Range[1]
+		distribution.BinCount = int64(len(record.Weights))
+		distribution.Weights = encodeWeights(record.Weights)
+	case len(record.Values) > 0:
+		binCount := record.BinCount
+		if binCount == 0 {
+			binCount = DistributionDefaultBinCount
+		}
+		if binCount < 0 || binCount > DistributionMaxBinCount {
+			return distribution, fmt.Errorf(
+				"record %q has invalid bin count %d, it should be between 1 and %d",
+				record.Name, binCount, DistributionMaxBinCount,
+			)
+		}
+		weights, low, high := newHistogram(record.Values, binCount)
+		distribution.Low, distribution.High = low, high
+		distribution.BinCount = int64(binCount)
+		distribution.Weights = encodeWeights(weights)
+	default:
+		return distribution, fmt.Errorf("record %q has no data", record.Name)
+	}
+
+	return distribution, nil
+}
+
+// newHistogram bins the values into binCount bins of equal width between the lowest and the highest value
+// the same way as numpy.histogram does, so that the last bin includes the highest value.
+func newHistogram(values []float64, binCount int) ([]float64, float64, float64) {
+	low, high := values[0], values[0]
+	for _, v := range values[1:] {
+		low, high = math.Min(low, v), math.Max(high, v)
+	}
+	if low == high {
+		low, high = low-0.5, high+0.5
+	}
+
+	weights := make([]float64, binCount)
+	for _, v := range values {
+		bin := int((v - low) / (high - low) * float64(binCount))
+		if bin >= binCount {
+			bin = binCount - 1
+		}
+		weights[bin]++
+	}
+	return weights, low, high
+}
+
+// encodeWeights encodes the weights of the bins into little-endian float64 values.
+func encodeWeights(weights []float64) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(weights)*8))
+	for _, w := range weights {
+		//nolint:gosec,errcheck
+		binary.Write(buf, binary.LittleEndian, w)
+	}
+	return buf.Bytes()
+}
+
+// decodeWeights decodes the weights of the bins from little-endian float64 values.
+func decodeWeights(data []byte) ([]float64, error) {
+	weights := make([]float64, len(data)/8)
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, weights); err != nil {
+		return nil, eris.Wrap(err, "error decoding distribution weights")
+	}
+	return weights, nil
+}
+
+// findDistributionSequences returns the distinct distribution sequences of the runs.
+func findDistributionSequences(runIDs []string) ([]runSequence, error) {
+	var sequences []runSequence
+	if len(runIDs) == 0 {
+		return sequences, nil
+	}
+	if err := database.DB.
+		Distinct(
+			"distributions.run_uuid", "'distributions' AS type", "distributions.key AS name",
+			"contexts.json AS context_json",
+		).
+		Table("distributions").
+		Joins("INNER JOIN contexts ON contexts.id = distributions.context_id").
+		Where("distributions.run_uuid IN ?", runIDs).
+		Order("name").
+		Find(&sequences).
+		Error; err != nil {
+		return nil, eris.Wrap(err, "error getting distribution sequences")
+	}
+	return sequences, nil
+}
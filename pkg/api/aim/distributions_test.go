@@ -0,0 +1,102 @@
+package aim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+)
+
+func Test_newHistogram(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		binCount int
+		weights  []float64
+		low      float64
+		high     float64
+	}{
+		{
+			name:     "DifferentValues",
+			values:   []float64{0, 1, 1.5, 2, 3, 4},
+			binCount: 4,
+			weights:  []float64{1, 2, 1, 2},
+			low:      0,
+			high:     4,
+		},
+		{
+			name:     "SameValues",
+			values:   []float64{2, 2, 2},
+			binCount: 2,
+			weights:  []float64{0, 3},
+			low:      1.5,
+			high:     2.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weights, low, high := newHistogram(tt.values, tt.binCount)
+			assert.Equal(t, tt.weights, weights)
+			assert.Equal(t, tt.low, low)
+			assert.Equal(t, tt.high, high)
+		})
+	}
+}
+
+func Test_newDistribution(t *testing.T) {
+	distribution, err := newDistribution(&request.DistributionRecord{
+		Name:    "weights",
+		Step:    2,
+		Weights: []float64{1, 2, 3},
+		Range:   []float64{-1, 1},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), distribution.BinCount)
+	assert.Equal(t, -1.0, distribution.Low)
+	assert.Equal(t, 1.0, distribution.High)
+	weights, err := decodeWeights(distribution.Weights)
+	require.Nil(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, weights)
+
+	distribution, err = newDistribution(&request.DistributionRecord{
+		Name:   "weights",
+		Values: []float64{1, 2, 3},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int64(DistributionDefaultBinCount), distribution.BinCount)
+
+	tests := []struct {
+		name   string
+		record request.DistributionRecord
+		error  string
+	}{
+		{
+			name:   "WithoutName",
+			record: request.DistributionRecord{Values: []float64{1}},
+			error:  "record name is required",
+		},
+		{
+			name:   "WithoutData",
+			record: request.DistributionRecord{Name: "weights"},
+			error:  `record "weights" has no data`,
+		},
+		{
+			name:   "WithInvalidRange",
+			record: request.DistributionRecord{Name: "weights", Weights: []float64{1}, Range: []float64{1, 1}},
+			error:  `record "weights" has invalid range [1 1]`,
+		},
+		{
+			name:   "WithInvalidBinCount",
+			record: request.DistributionRecord{Name: "weights", Values: []float64{1}, BinCount: 1000},
+			error:  `record "weights" has invalid bin count 1000, it should be between 1 and 512`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newDistribution(&tt.record)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package aim
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// figureRecord represents the stored figure along with its context.
+type figureRecord struct {
+	database.Figure
+	Context datatypes.JSON `gorm:"column:context_json"`
+}
+
+func LogRunFigures(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunFigures namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunFiguresRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	for _, record := range req.Records {
+		if record.Name == "" {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "record name is required")
+		}
+		// Plotly figure is always the object with `data` and `layout` properties.
+		var figure map[string]any
+		if err := json.Unmarshal(record.Data, &figure); err != nil || figure == nil {
+			return fiber.NewError(
+				fiber.StatusUnprocessableEntity, fmt.Sprintf("record %q has invalid figure data", record.Name),
+			)
+		}
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	timestamp := time.Now().UnixMilli()
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		for _, record := range req.Records {
+			context, err := getOrCreateContext(tx, record.Context)
+			if err != nil {
+				return err
+			}
+
+			figure := database.Figure{
+				RunID:     run.ID,
+				Name:      record.Name,
+				ContextID: context.ID,
+				Step:      record.Step,
+				Timestamp: record.Timestamp,
+				Data:      datatypes.JSON(record.Data),
+			}
+			if figure.Timestamp == 0 {
+				figure.Timestamp = timestamp
+			}
+			// the figure logged once again for the same step replaces the previous one.
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{
+					{Name: "run_uuid"}, {Name: "name"}, {Name: "context_id"}, {Name: "step"},
+				},
+				DoUpdates: clause.AssignmentColumns([]string{"timestamp", "data"}),
+			}).Create(&figure).Error; err != nil {
+				return eris.Wrapf(err, "error creating record %q", record.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to log figures of run %q: %s", p.ID, err),
+		)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func SearchFigures(c *fiber.Ctx) error {
+	search, err := newSequenceSearch(
+		c, "figures", "figures", "INNER JOIN figures ON figures.run_uuid = runs.run_uuid",
+	)
+	if err != nil {
+		return err
+	}
+
+	runs, totalRuns, err := search.findRuns()
+	if err != nil {
+		return err
+	}
+
+	var records []figureRecord
+	if tx := database.DB.
+		Select("figures.*", "contexts.json AS context_json").
+		Table("figures").
+		Joins("INNER JOIN contexts ON contexts.id = figures.context_id").
+		Where("figures.id IN (?)", search.filter(database.DB.Select("figures.id"))).
+		Order("figures.run_uuid").
+		Order("figures.name").
+		Order("figures.context_id").
+		Order("figures.step").
+		Find(&records); tx.Error != nil {
+		return fmt.Errorf("error searching run figures: %w", tx.Error)
+	}
+
+	runRecords := make(map[string][]figureRecord, len(runs))
+	for _, record := range records {
+		runRecords[record.RunID] = append(runRecords[record.RunID], record)
+	}
+
+	search.stream(c, runs, totalRuns, func(r *database.Run) ([]fiber.Map, fiber.Map, error) {
+		traces, ranges, err := newSequenceTraces(
+			newFigureItems(runRecords[r.ID]), search.recordRange, search.indexRange,
+			search.RecordDensity, search.IndexDensity,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		// figures are logged once per step, so every step has the single value.
+		for _, trace := range traces {
+			steps := trace["values"].([][]any)
+			values := make([]any, len(steps))
+			for i, step := range steps {
+				values[i] = step[0]
+			}
+			trace["values"] = values
+		}
+		return traces, ranges, nil
+	})
+
+	return nil
+}
+
+// newFigureItems converts the records into the items of the sequence traces.
+func newFigureItems(records []figureRecord) []sequenceItem {
+	items := make([]sequenceItem, len(records))
+	for i := range records {
+		record := records[i]
+		items[i] = sequenceItem{
+			Name:      record.Name,
+			ContextID: record.ContextID,
+			Context:   record.Context,
+			Step:      record.Step,
+			Timestamp: record.Timestamp,
+			Value: func() (any, error) {
+				var figure map[string]any
+				if err := json.Unmarshal(record.Data, &figure); err != nil {
+					return nil, eris.Wrapf(err, "error unmarshalling figure of record %q", record.Name)
+				}
+				return fiber.Map{
+					"data": figure,
+				}, nil
+			},
+		}
+	}
+	return items
+}
+
+// findFigureSequences returns the distinct figure sequences of the runs.
+func findFigureSequences(runIDs []string) ([]runSequence, error) {
+	return findTableSequences("figures", "figures", runIDs)
+}
@@ -0,0 +1,482 @@
+package aim
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+const (
+	// logsBatchSize is the number of log lines and records inserted at once.
+	logsBatchSize = 1000
+	// logsTailBatchSize is the maximum number of log lines sent by the tail at once.
+	logsTailBatchSize = 1000
+	// logsTailInterval is the time the tail waits for new log lines of the running run.
+	logsTailInterval = time.Second
+)
+
+// logLevels maps the names of the log levels to the numeric values used by Python logging and Aim.
+var logLevels = map[string]int{
+	"DEBUG":    10,
+	"INFO":     20,
+	"WARNING":  30,
+	"ERROR":    40,
+	"CRITICAL": 50,
+}
+
+func LogRunLogs(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunLogs namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunLogsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	timestamp := time.Now().UnixMilli()
+	lines := make([]database.Log, len(req.Lines))
+	for i, line := range req.Lines {
+		stream := database.LogStream(line.Stream)
+		switch stream {
+		case "":
+			stream = database.LogStreamStdout
+		case database.LogStreamStdout, database.LogStreamStderr:
+		default:
+			return fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid log stream %q", line.Stream))
+		}
+		lines[i] = database.Log{
+			RunID:     run.ID,
+			Stream:    stream,
+			Timestamp: line.Timestamp,
+			Data:      line.Data,
+		}
+		if lines[i].Timestamp == 0 {
+			lines[i].Timestamp = timestamp
+		}
+	}
+
+	if len(lines) > 0 {
+		if err := database.DB.CreateInBatches(&lines, logsBatchSize).Error; err != nil {
+			return fiber.NewError(
+				fiber.StatusInternalServerError, fmt.Sprintf("unable to log lines of run %q: %s", p.ID, err),
+			)
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func LogRunLogRecords(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunLogRecords namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunLogRecordsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	timestamp := time.Now().UnixMilli()
+	records := make([]database.LogRecord, len(req.Records))
+	for i, record := range req.Records {
+		level := logLevels["INFO"]
+		if record.Level != "" {
+			var ok bool
+			if level, ok = logLevels[strings.ToUpper(record.Level)]; !ok {
+				return fiber.NewError(
+					fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid log level %q", record.Level),
+				)
+			}
+		}
+		records[i] = database.LogRecord{
+			RunID:     run.ID,
+			Level:     level,
+			Message:   record.Message,
+			Timestamp: record.Timestamp,
+		}
+		if records[i].Timestamp == 0 {
+			records[i].Timestamp = timestamp
+		}
+		if record.Args != nil {
+			args, err := json.Marshal(record.Args)
+			if err != nil {
+				return fiber.NewError(
+					fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid log record args: %s", err),
+				)
+			}
+			records[i].Args = args
+		}
+	}
+
+	if len(records) > 0 {
+		if err := database.DB.CreateInBatches(&records, logsBatchSize).Error; err != nil {
+			return fiber.NewError(
+				fiber.StatusInternalServerError, fmt.Sprintf("unable to log records of run %q: %s", p.ID, err),
+			)
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func GetRunLogs(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunLogs namespace: %s", ns.Code)
+
+	run, requestedRange, err := parseRunLogsRequest(c, ns.ID)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	if err := database.DB.
+		Model(&database.Log{}).
+		Where("run_uuid = ?", run.ID).
+		Count(&count).
+		Error; err != nil {
+		return fmt.Errorf("error counting logs of run %q: %w", run.ID, err)
+	}
+	recordRange := requestedRange.resolve([2]int64{0, count})
+
+	var lines []database.Log
+	if recordRange[1] > recordRange[0] {
+		if err := database.DB.
+			Where("run_uuid = ?", run.ID).
+			Order("id").
+			Offset(int(recordRange[0])).
+			Limit(int(recordRange[1] - recordRange[0])).
+			Find(&lines).
+			Error; err != nil {
+			return fmt.Errorf("error retrieving logs of run %q: %w", run.ID, err)
+		}
+	}
+
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := func() error {
+			for i, line := range lines {
+				if err := encoding.EncodeTree(w, fiber.Map{
+					strconv.FormatInt(recordRange[0]+int64(i), 10): line.Data,
+				}); err != nil {
+					return err
+				}
+			}
+			return w.Flush()
+		}(); err != nil {
+			log.Errorf("Error encountered in %s %s: error streaming logs: %s", c.Method(), c.Path(), err)
+		}
+	})
+
+	return nil
+}
+
+func GetRunLogRecords(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunLogRecords namespace: %s", ns.Code)
+
+	run, requestedRange, err := parseRunLogsRequest(c, ns.ID)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	if err := database.DB.
+		Model(&database.LogRecord{}).
+		Where("run_uuid = ?", run.ID).
+		Count(&count).
+		Error; err != nil {
+		return fmt.Errorf("error counting log records of run %q: %w", run.ID, err)
+	}
+	recordRange := requestedRange.resolve([2]int64{0, count})
+
+	var records []database.LogRecord
+	if recordRange[1] > recordRange[0] {
+		if err := database.DB.
+			Where("run_uuid = ?", run.ID).
+			Order("id").
+			Offset(int(recordRange[0])).
+			Limit(int(recordRange[1] - recordRange[0])).
+			Find(&records).
+			Error; err != nil {
+			return fmt.Errorf("error retrieving log records of run %q: %w", run.ID, err)
+		}
+	}
+
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := func() error {
+			if err := encoding.EncodeTree(w, fiber.Map{
+				"log_records_count": count,
+			}); err != nil {
+				return err
+			}
+			for i, record := range records {
+				var args any
+				if len(record.Args) > 0 {
+					if err := json.Unmarshal(record.Args, &args); err != nil {
+						return eris.Wrap(err, "error unmarshalling log record args")
+					}
+				}
+				if err := encoding.EncodeTree(w, fiber.Map{
+					strconv.FormatInt(recordRange[0]+int64(i), 10): fiber.Map{
+						"message":   record.Message,
+						"log_level": record.Level,
+						"timestamp": float64(record.Timestamp) / 1000,
+						"args":      args,
+					},
+				}); err != nil {
+					return err
+				}
+			}
+			return w.Flush()
+		}(); err != nil {
+			log.Errorf("Error encountered in %s %s: error streaming log records: %s", c.Method(), c.Path(), err)
+		}
+	})
+
+	return nil
+}
+
+// TailRunLogs streams the log lines of the run as server-sent events. Lines of the running run are
+// sent as soon as they are logged, until the run is finished. The client can resume the tail
+// from the line following the one provided by `Last-Event-ID` header.
+func TailRunLogs(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("tailRunLogs namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var lastID uint64
+	if header := c.Get("Last-Event-ID"); header != "" {
+		if lastID, err = strconv.ParseUint(header, 10, 64); err != nil {
+			return fiber.NewError(
+				fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid Last-Event-ID header %q", header),
+			)
+		}
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := tailRunLogs(w, run.ID, uint(lastID)); err != nil {
+			log.Debugf("tail of run %q logs stopped: %s", run.ID, err)
+		}
+		log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+	})
+
+	return nil
+}
+
+// tailRunLogs writes the events of the log lines following lastID, until the run is not running anymore
+// or the client is disconnected.
+func tailRunLogs(w *bufio.Writer, runID string, lastID uint) error {
+	for {
+		// the status is checked before the lines are fetched,
+		// so that the lines logged right before the run is finished are not missed.
+		var status database.Status
+		if err := database.DB.
+			Model(&database.Run{}).
+			Select("status").
+			Where("run_uuid = ?", runID).
+			Scan(&status).
+			Error; err != nil {
+			return eris.Wrap(err, "error retrieving run status")
+		}
+
+		var lines []database.Log
+		if err := database.DB.
+			Where("run_uuid = ? AND id > ?", runID, lastID).
+			Order("id").
+			Limit(logsTailBatchSize).
+			Find(&lines).
+			Error; err != nil {
+			return eris.Wrap(err, "error retrieving logs")
+		}
+
+		for _, line := range lines {
+			data, err := json.Marshal(fiber.Map{
+				"stream":    line.Stream,
+				"timestamp": float64(line.Timestamp) / 1000,
+				"data":      line.Data,
+			})
+			if err != nil {
+				return eris.Wrap(err, "error marshalling log line")
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: log\ndata: %s\n\n", line.ID, data); err != nil {
+				return err
+			}
+			lastID = line.ID
+		}
+
+		if len(lines) == 0 {
+			if status != database.StatusRunning {
+				if _, err := fmt.Fprint(w, "event: end\ndata: {}\n\n"); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+			// the comment keeps the connection alive and detects disconnected clients.
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if len(lines) < logsTailBatchSize {
+			time.Sleep(logsTailInterval)
+		}
+	}
+}
+
+// parseRunLogsRequest resolves the run of the current namespace from the `id` path parameter
+// along with the requested `record_range` of its logs.
+func parseRunLogsRequest(c *fiber.Ctx, namespaceID uint) (*database.Run, sequenceRange, error) {
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return nil, sequenceRange{}, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	q := struct {
+		RecordRange string `query:"record_range"`
+	}{}
+
+	if err := c.QueryParser(&q); err != nil {
+		return nil, sequenceRange{}, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	recordRange, err := parseSequenceRange(q.RecordRange)
+	if err != nil {
+		return nil, sequenceRange{}, fiber.NewError(
+			fiber.StatusUnprocessableEntity, fmt.Sprintf("record_range: %s", err),
+		)
+	}
+
+	run, err := findRun(namespaceID, p.ID)
+	if err != nil {
+		return nil, sequenceRange{}, fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err),
+		)
+	}
+	if run == nil {
+		return nil, sequenceRange{}, fiber.NewError(
+			fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID),
+		)
+	}
+
+	return run, recordRange, nil
+}
+
+// findLogSequences returns the log and log record sequences of the runs, which have any of them logged.
+func findLogSequences(runIDs []string) ([]runSequence, error) {
+	var sequences []runSequence
+	if len(runIDs) == 0 {
+		return sequences, nil
+	}
+	for _, s := range []struct {
+		table        string
+		sequenceType string
+		name         string
+	}{
+		{table: "logs", sequenceType: "logs", name: "logs"},
+		{table: "log_records", sequenceType: "log_records", name: "__log_records"},
+	} {
+		var found []runSequence
+		if err := database.DB.
+			Distinct(
+				"run_uuid", fmt.Sprintf("'%s' AS type", s.sequenceType), fmt.Sprintf("'%s' AS name", s.name),
+			).
+			Table(s.table).
+			Where("run_uuid IN ?", runIDs).
+			Find(&found).
+			Error; err != nil {
+			return nil, eris.Wrapf(err, "error getting %s sequences", s.sequenceType)
+		}
+		sequences = append(sequences, found...)
+	}
+	return sequences, nil
+}
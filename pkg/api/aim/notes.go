@@ -0,0 +1,332 @@
+package aim
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// noteOwner represents the run or the experiment, which notes belong to.
+type noteOwner struct {
+	column string
+	value  any
+}
+
+// apply sets the owner of the note.
+func (o noteOwner) apply(note *database.Note) {
+	switch v := o.value.(type) {
+	case string:
+		note.RunID = common.GetPointer(v)
+	case int32:
+		note.ExperimentID = common.GetPointer(v)
+	}
+}
+
+func GetRunNotes(c *fiber.Ctx) error {
+	owner, err := getRunNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return getNotes(c, owner)
+}
+
+func CreateRunNote(c *fiber.Ctx) error {
+	owner, err := getRunNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return createNote(c, owner)
+}
+
+func GetRunNote(c *fiber.Ctx) error {
+	owner, err := getRunNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return getNote(c, owner)
+}
+
+func UpdateRunNote(c *fiber.Ctx) error {
+	owner, err := getRunNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return updateNote(c, owner)
+}
+
+func DeleteRunNote(c *fiber.Ctx) error {
+	owner, err := getRunNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return deleteNote(c, owner)
+}
+
+func GetExperimentNotes(c *fiber.Ctx) error {
+	owner, err := getExperimentNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return getNotes(c, owner)
+}
+
+func CreateExperimentNote(c *fiber.Ctx) error {
+	owner, err := getExperimentNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return createNote(c, owner)
+}
+
+func GetExperimentNote(c *fiber.Ctx) error {
+	owner, err := getExperimentNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return getNote(c, owner)
+}
+
+func UpdateExperimentNote(c *fiber.Ctx) error {
+	owner, err := getExperimentNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return updateNote(c, owner)
+}
+
+func DeleteExperimentNote(c *fiber.Ctx) error {
+	owner, err := getExperimentNoteOwner(c)
+	if err != nil {
+		return err
+	}
+	return deleteNote(c, owner)
+}
+
+// getRunNoteOwner resolves the run of the current namespace from the `id` path parameter.
+func getRunNoteOwner(c *fiber.Ctx) (*noteOwner, error) {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return nil, api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("runNote namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return nil, fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err),
+		)
+	}
+	if run == nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	return &noteOwner{column: "run_uuid", value: run.ID}, nil
+}
+
+// getExperimentNoteOwner resolves the experiment of the current namespace from the `id` path parameter.
+func getExperimentNoteOwner(c *fiber.Ctx) (*noteOwner, error) {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return nil, api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("experimentNote namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	id, err := strconv.ParseInt(p.ID, 10, 32)
+	if err != nil {
+		return nil, fiber.NewError(
+			fiber.StatusUnprocessableEntity, fmt.Sprintf("unable to parse experiment id %q: %s", p.ID, err),
+		)
+	}
+
+	if err := database.DB.Select(
+		"experiment_id",
+	).Where(
+		"experiment_id = ? AND namespace_id = ?", id, ns.ID,
+	).First(&database.Experiment{}).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find experiment %q", p.ID))
+		}
+		return nil, fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find experiment %q: %s", p.ID, err),
+		)
+	}
+
+	return &noteOwner{column: "experiment_id", value: int32(id)}, nil
+}
+
+func getNotes(c *fiber.Ctx, owner *noteOwner) error {
+	var notes []database.Note
+	if err := database.DB.
+		Where("NOT is_archived").
+		Where(fmt.Sprintf("%s = ?", owner.column), owner.value).
+		Order("created_at").
+		Find(&notes).
+		Error; err != nil {
+		return fmt.Errorf("error fetching notes: %w", err)
+	}
+
+	resp := make([]response.Note, len(notes))
+	for i, note := range notes {
+		resp[i] = response.Note{
+			ID:        note.ID,
+			Content:   note.Content,
+			CreatedAt: note.CreatedAt,
+			UpdatedAt: note.UpdatedAt,
+		}
+	}
+
+	return c.JSON(resp)
+}
+
+func createNote(c *fiber.Ctx, owner *noteOwner) error {
+	var req request.CreateNoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	note := database.Note{
+		Content: req.Content,
+	}
+	owner.apply(&note)
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&note).Error; err != nil {
+			return err
+		}
+		return createNoteRevision(tx, &note, database.NoteActionCreate)
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error inserting note: %s", err))
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.CreateNote{
+		ID:        note.ID,
+		CreatedAt: note.CreatedAt,
+	})
+}
+
+func getNote(c *fiber.Ctx, owner *noteOwner) error {
+	note, err := findNote(c, owner)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(response.Note{
+		ID:        note.ID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+	})
+}
+
+func updateNote(c *fiber.Ctx, owner *noteOwner) error {
+	note, err := findNote(c, owner)
+	if err != nil {
+		return err
+	}
+
+	var req request.UpdateNoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(note).Update("Content", req.Content).Error; err != nil {
+			return err
+		}
+		return createNoteRevision(tx, note, database.NoteActionUpdate)
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error updating note %q: %s", note.ID, err))
+	}
+
+	return c.JSON(response.Note{
+		ID:        note.ID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+	})
+}
+
+func deleteNote(c *fiber.Ctx, owner *noteOwner) error {
+	note, err := findNote(c, owner)
+	if err != nil {
+		return err
+	}
+
+	// notes are only archived, so that their history is kept.
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(note).Update("IsArchived", true).Error; err != nil {
+			return err
+		}
+		return createNoteRevision(tx, note, database.NoteActionDelete)
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to delete note %q: %s", note.ID, err))
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+// findNote returns the not archived note of the owner from the `note_id` path parameter.
+func findNote(c *fiber.Ctx, owner *noteOwner) (*database.Note, error) {
+	p := struct {
+		NoteID uuid.UUID `params:"note_id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var note database.Note
+	if err := database.DB.
+		Where("NOT is_archived").
+		Where(fmt.Sprintf("%s = ?", owner.column), owner.value).
+		Where("id = ?", p.NoteID).
+		First(&note).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find note %q", p.NoteID))
+		}
+		return nil, fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find note %q: %s", p.NoteID, err),
+		)
+	}
+	return &note, nil
+}
+
+// createNoteRevision records the current content of the note in its history.
+func createNoteRevision(tx *gorm.DB, note *database.Note, action database.NoteAction) error {
+	return tx.Create(&database.NoteRevision{
+		NoteID:  note.ID,
+		Action:  action,
+		Content: note.Content,
+	}).Error
+}
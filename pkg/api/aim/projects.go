@@ -2,13 +2,17 @@ package aim
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
 
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
@@ -82,18 +86,69 @@ func GetProjectActivity(c *fiber.Ctx) error {
 	})
 }
 
-// TODO
 func GetProjectPinnedSequences(c *fiber.Ctx) error {
-	return c.JSON(fiber.Map{
-		"sequences": []string{},
-	})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getProjectPinnedSequences namespace: %s", ns.Code)
+
+	pinned := database.PinnedSequences{
+		Sequences: database.PinnedSequenceList{},
+	}
+	if err := database.DB.
+		Where("namespace_id = ?", ns.ID).
+		Limit(1).
+		Find(&pinned).
+		Error; err != nil {
+		return fmt.Errorf("error fetching pinned sequences: %w", err)
+	}
+
+	return c.JSON(pinned)
 }
 
-// TODO
 func UpdateProjectPinnedSequences(c *fiber.Ctx) error {
-	return c.JSON(fiber.Map{
-		"sequences": []string{},
-	})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateProjectPinnedSequences namespace: %s", ns.Code)
+
+	var req request.UpdateProjectPinnedSequencesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	pinned := database.PinnedSequences{
+		NamespaceID: ns.ID,
+		Sequences:   make(database.PinnedSequenceList, len(req.Sequences)),
+	}
+	for i, sequence := range req.Sequences {
+		if sequence.Name == "" {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "sequence name is required")
+		}
+		if sequence.Context == nil {
+			sequence.Context = map[string]any{}
+		}
+		pinned.Sequences[i] = database.PinnedSequence{
+			Name:    sequence.Name,
+			Context: sequence.Context,
+		}
+	}
+
+	if err := database.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "namespace_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"sequences", "updated_at"}),
+		}).
+		Create(&pinned).
+		Error; err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("error updating pinned sequences: %s", err),
+		)
+	}
+
+	return c.JSON(pinned)
 }
 
 func GetProjectParams(c *fiber.Ctx) error {
@@ -115,8 +170,13 @@ func GetProjectParams(c *fiber.Ctx) error {
 	resp := fiber.Map{}
 
 	if !q.ExcludeParams {
-		var paramKeys []string
-		if tx := database.DB.Distinct().Model(
+		var paramTypes []struct {
+			Key       string
+			ValueType string
+		}
+		if tx := database.DB.Distinct(
+			"key", "value_type",
+		).Model(
 			&database.Param{},
 		).Joins(
 			"JOIN runs USING(run_uuid)",
@@ -125,16 +185,22 @@ func GetProjectParams(c *fiber.Ctx) error {
 			ns.ID,
 		).Where(
 			"runs.lifecycle_stage = ?", database.LifecycleStageActive,
-		).Pluck(
-			"Key", &paramKeys,
+		).Find(
+			&paramTypes,
 		); tx.Error != nil {
 			return fmt.Errorf("error retrieving param keys: %w", tx.Error)
 		}
 
-		params := make(map[string]any, len(paramKeys)+1)
-		for _, p := range paramKeys {
-			params[p] = map[string]string{
-				"__example_type__": "<class 'str'>",
+		// the key logged with the values of different types gets the type, which all of them fit in.
+		keyTypes := make(map[string]string, len(paramTypes))
+		for _, p := range paramTypes {
+			keyTypes[p.Key] = mergeParamTypes(keyTypes[p.Key], p.ValueType)
+		}
+
+		params := make(map[string]any, len(keyTypes)+1)
+		for key, valueType := range keyTypes {
+			params[key] = map[string]string{
+				"__example_type__": paramExampleType(valueType),
 			}
 		}
 
@@ -179,8 +245,59 @@ func GetProjectParams(c *fiber.Ctx) error {
 
 	for _, s := range q.Sequences {
 		switch s {
-		case "images", "texts", "figures", "distributions", "audios":
-			resp[s] = fiber.Map{}
+		case "images", "audios", "distributions", "texts", "figures":
+			tx := database.DB.Joins(
+				"JOIN runs USING(run_uuid)",
+			).Joins(
+				"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+				ns.ID,
+			).Where(
+				"runs.lifecycle_stage = ?", database.LifecycleStageActive,
+			)
+			switch s {
+			case "distributions":
+				tx = tx.Distinct(
+					"distributions.key AS name", "contexts.json AS context_json",
+				).Table(
+					"distributions",
+				).Joins(
+					"INNER JOIN contexts ON contexts.id = distributions.context_id",
+				)
+			case "texts", "figures":
+				tx = tx.Distinct(
+					fmt.Sprintf("%s.name", s), "contexts.json AS context_json",
+				).Table(
+					s,
+				).Joins(
+					fmt.Sprintf("INNER JOIN contexts ON contexts.id = %s.context_id", s),
+				)
+			default:
+				tx = tx.Distinct(
+					"blobs.name", "contexts.json AS context_json",
+				).Table(
+					"blobs",
+				).Joins(
+					"INNER JOIN contexts ON contexts.id = blobs.context_id",
+				).Where(
+					"blobs.type = ?", s,
+				)
+			}
+
+			var sequences []runSequence
+			if tx := tx.Find(&sequences); tx.Error != nil {
+				return fmt.Errorf("error retrieving %s sequences: %w", s, tx.Error)
+			}
+
+			contexts := make(map[string][]map[string]any, len(sequences))
+			for _, sequence := range sequences {
+				context, err := decodeContext(sequence.Context)
+				if err != nil {
+					return fmt.Errorf("error retrieving %s sequences: %w", s, err)
+				}
+				contexts[sequence.Name] = append(contexts[sequence.Name], context)
+			}
+
+			resp[s] = contexts
 		case "metric":
 			var metricKeys []string
 			if tx := database.DB.Distinct().Model(
@@ -215,3 +332,28 @@ func GetProjectParams(c *fiber.Ctx) error {
 func GetProjectStatus(c *fiber.Ctx) error {
 	return c.JSON("up-to-date")
 }
+
+// mergeParamTypes returns the type of the param, which has the values of both provided types.
+func mergeParamTypes(current, valueType string) string {
+	switch {
+	case current == "" || current == valueType:
+		return valueType
+	case slices.Contains([]string{common.ParamTypeInt, common.ParamTypeFloat}, current) &&
+		slices.Contains([]string{common.ParamTypeInt, common.ParamTypeFloat}, valueType):
+		return common.ParamTypeFloat
+	default:
+		return common.ParamTypeString
+	}
+}
+
+// paramExampleType converts the type of the param into the python class name.
+func paramExampleType(valueType string) string {
+	switch valueType {
+	case common.ParamTypeInt, common.ParamTypeFloat, common.ParamTypeBool:
+		return fmt.Sprintf("<class '%s'>", valueType)
+	case common.ParamTypeJSON:
+		return "<class 'dict'>"
+	default:
+		return "<class 'str'>"
+	}
+}
@@ -199,3 +199,28 @@ func eqNilReflect(value interface{}) bool {
 	reflectValue := reflect.ValueOf(value)
 	return reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil()
 }
+
+// Arithmetic is the arithmetic expression over the columns and the values, e.g. `run.duration / 3600`.
+type Arithmetic struct {
+	clause.Expr
+}
+
+// newArithmetic creates the arithmetic expression of the provided operator,
+// keeping the true division and the modulo of the float numbers for both dialects.
+// Like in Python, the result of the modulo has the sign of the divisor. Division and modulo
+// by zero result in NULL for both dialects, so such rows never match the condition.
+func newArithmetic(op string, left, right any, dialector string) Arithmetic {
+	switch op {
+	case "/":
+		return Arithmetic{clause.Expr{SQL: "(1.0 * ? / NULLIF(?, 0.0))", Vars: []any{left, right}}}
+	case "%":
+		sql := "MOD(MOD(?, NULLIF(?, 0)) + ?, NULLIF(?, 0))"
+		if dialector == (postgres.Dialector{}).Name() {
+			sql = "MOD(MOD(CAST(? AS numeric), NULLIF(CAST(? AS numeric), 0)) + CAST(? AS numeric), " +
+				"NULLIF(CAST(? AS numeric), 0))"
+		}
+		return Arithmetic{clause.Expr{SQL: sql, Vars: []any{left, right, right, right}}}
+	default:
+		return Arithmetic{clause.Expr{SQL: "(? " + op + " ?)", Vars: []any{left, right}}}
+	}
+}
@@ -3,6 +3,9 @@ package query
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
@@ -46,6 +50,13 @@ type attributeGetter func(attr string) (any, error)
 
 type subscriptSlicer func(index ast.Slicer) (any, error)
 
+// runTags represents `run.tags` attribute. It can be subscripted by the key of MLflow tag
+// or checked for the name of attached Aim tag with `in` and `not in` operators.
+type runTags struct {
+	subscriptSlicer
+	table string
+}
+
 type join struct {
 	alias string
 	query string
@@ -155,7 +166,14 @@ func (qp *QueryParser) Parse(q string) (ParsedQuery, error) {
 }
 
 func (pq *parsedQuery) Filter(tx *gorm.DB) *gorm.DB {
-	for _, j := range pq.joins {
+	// joins are applied in the stable order to build the same query every time.
+	keys := make([]string, 0, len(pq.joins))
+	for key := range pq.joins {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		j := pq.joins[key]
 		tx.Joins(j.query, j.args...)
 	}
 	if len(pq.conditions) > 0 {
@@ -182,6 +200,8 @@ func (pq *parsedQuery) parseNode(node ast.Expr) (any, error) {
 
 func (pq *parsedQuery) _parseNode(node ast.Expr) (any, error) {
 	switch n := node.(type) {
+	case *ast.BinOp:
+		return pq.parseBinOp(n)
 	case *ast.BoolOp:
 		return pq.parseBoolOp(n)
 	case *ast.Call:
@@ -275,6 +295,53 @@ func (pq *parsedQuery) parseAttribute(node *ast.Attribute) (any, error) {
 	}
 }
 
+func (pq *parsedQuery) parseBinOp(node *ast.BinOp) (any, error) {
+	left, err := pq.parseNode(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := pq.parseNode(node.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	var op string
+	switch node.Op {
+	case ast.Add:
+		op = "+"
+	case ast.Sub:
+		op = "-"
+	case ast.Mult:
+		op = "*"
+	case ast.Div:
+		op = "/"
+	case ast.Modulo:
+		op = "%"
+	default:
+		return nil, fmt.Errorf("unsupported binary operation %q", node.Op)
+	}
+
+	if column, ok := pq.numericColumn(left); ok {
+		left = column
+	}
+	if column, ok := pq.numericColumn(right); ok {
+		right = column
+	}
+	for _, operand := range []any{left, right} {
+		switch operand.(type) {
+		case int, float64, clause.Column, Arithmetic:
+		default:
+			return nil, fmt.Errorf("unsupported type %T for binary operation %q", operand, node.Op)
+		}
+	}
+
+	// constant expressions are evaluated right away with the semantic of Python.
+	if isNumber(left) && isNumber(right) {
+		return evalArithmetic(op, left, right)
+	}
+	return newArithmetic(op, left, right, pq.qp.Dialector), nil
+}
+
 func (pq *parsedQuery) parseBoolOp(node *ast.BoolOp) (any, error) {
 	exprs := make([]clause.Expression, len(node.Values))
 	for i, v := range node.Values {
@@ -329,6 +396,24 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 		if err != nil {
 			return nil, err
 		}
+		// the params are compared with the numbers numerically.
+		if column, ok := pq.numericColumn(left); ok && isNumeric(right) {
+			exprs[i], err = newSqlParamComparison(op, left.(clause.Column), column, right)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		} else if column, ok := pq.numericColumn(right); ok && isNumeric(left) {
+			o, _, _, err := reverseComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i], err = newSqlParamComparison(o, right.(clause.Column), column, left)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 
 		switch left := left.(type) {
 		case clause.Column:
@@ -351,8 +436,26 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 			if err != nil {
 				return nil, err
 			}
+		case Arithmetic:
+			exprs[i], err = newSqlComparison(op, left.Expr, right)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			switch right := right.(type) {
+			case runTags:
+				name, ok := left.(string)
+				if !ok {
+					return nil, errors.New("left parameter has to be a string")
+				}
+				switch op {
+				case ast.In:
+					exprs[i] = right.contains(name)
+				case ast.NotIn:
+					exprs[i] = negativeClause(right.contains(name))
+				default:
+					return nil, fmt.Errorf("unsupported comparison %q", ast.Dump(node))
+				}
 			case clause.Column:
 				switch op {
 				case ast.In:
@@ -384,6 +487,16 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 					}
 					exprs[i] = expression
 				}
+			case Arithmetic:
+				o, l, r, err := reverseComparison(op, left, right.Expr)
+				if err != nil {
+					return nil, err
+				}
+				expression, err := newSqlComparison(o, l, r)
+				if err != nil {
+					return nil, err
+				}
+				exprs[i] = expression
 			case clause.Eq:
 				switch left := left.(type) {
 				case bool:
@@ -480,6 +593,16 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 							Name: fmt.Sprintf("(%s.end_time - %s.start_time) / 1000", table, table),
 							Raw:  true,
 						}, nil
+					case "parent":
+						return pq.tagColumn(table, common.ParentRunIDTagKey), nil
+					case "children":
+						return clause.Column{
+							Name: fmt.Sprintf(
+								"(SELECT COUNT(*) FROM tags WHERE tags.key = '%s' AND tags.value = %s.run_uuid)",
+								common.ParentRunIDTagKey, table,
+							),
+							Raw: true,
+						}, nil
 					case "metrics":
 						return subscriptSlicer(func(s ast.Slicer) (any, error) {
 							switch s := s.(type) {
@@ -512,6 +635,14 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 											name = "last_iter"
 										case "first_step":
 											return 0, nil
+										case "first":
+											name = "first_value"
+										case "min":
+											name = "min_value"
+										case "max":
+											name = "max_value"
+										case "count":
+											name = "count"
 										default:
 											return nil, fmt.Errorf("unsupported metrics attribute %q", attr)
 										}
@@ -528,7 +659,7 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 							}
 						}), nil
 					case "tags":
-						return subscriptSlicer(func(s ast.Slicer) (any, error) {
+						return runTags{table: table, subscriptSlicer: func(s ast.Slicer) (any, error) {
 							switch s := s.(type) {
 							case *ast.Index:
 								v, err := pq.parseNode(s.Value)
@@ -537,30 +668,14 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 								}
 								switch v := v.(type) {
 								case string:
-									j, ok := pq.joins[fmt.Sprintf("tags:%s", v)]
-									if !ok {
-										alias := fmt.Sprintf("tags_%d", len(pq.joins))
-										j = join{
-											alias: alias,
-											query: fmt.Sprintf(
-												"LEFT JOIN tags %s ON %s.run_uuid = %s.run_uuid AND %s.key = ?",
-												alias, table, alias, alias,
-											),
-											args: []any{v},
-										}
-										pq.joins[fmt.Sprintf("tags:%s", v)] = j
-									}
-									return clause.Column{
-										Table: j.alias,
-										Name:  "value",
-									}, nil
+									return pq.tagColumn(table, v), nil
 								default:
 									return nil, fmt.Errorf("unsupported index value type %t", v)
 								}
 							default:
 								return nil, fmt.Errorf("unsupported slicer %q", ast.Dump(s))
 							}
-						}), nil
+						}}, nil
 					default:
 						j, ok := pq.joins[fmt.Sprintf("params:%s", attr)]
 						if !ok {
@@ -638,6 +753,30 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 					}
 				},
 			), nil
+		case "images", "audios", "figures":
+			table, ok := pq.qp.Tables[string(node.Id)]
+			if !ok {
+				return nil, fmt.Errorf("unsupported name identifier %q", node.Id)
+			}
+			return pq.sequenceAttributes(string(node.Id), table), nil
+		case "texts":
+			table, ok := pq.qp.Tables[string(node.Id)]
+			if !ok {
+				return nil, fmt.Errorf("unsupported name identifier %q", node.Id)
+			}
+			attributes := pq.sequenceAttributes(string(node.Id), table)
+			return attributeGetter(
+				func(attr string) (any, error) {
+					// texts can be selected by their content, e.g. `re.search("error", texts.data)`.
+					if attr == "data" {
+						return clause.Column{
+							Table: table,
+							Name:  "data",
+						}, nil
+					}
+					return attributes(attr)
+				},
+			), nil
 		case "re":
 			return attributeGetter(
 				func(attr string) (any, error) {
@@ -690,6 +829,31 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 					}
 				},
 			), nil
+		case "abs":
+			return callable(
+				func(args []ast.Expr) (any, error) {
+					if len(args) != 1 {
+						return nil, errors.New("abs function support exactly one argument")
+					}
+					e, err := pq.parseNode(args[0])
+					if err != nil {
+						return nil, err
+					}
+					switch e := e.(type) {
+					case int:
+						if e < 0 {
+							return -e, nil
+						}
+						return e, nil
+					case float64:
+						return math.Abs(e), nil
+					case clause.Column, Arithmetic:
+						return Arithmetic{clause.Expr{SQL: "ABS(?)", Vars: []any{e}}}, nil
+					default:
+						return nil, fmt.Errorf("unsupported argument type %T for abs function", e)
+					}
+				},
+			), nil
 		case "datetime":
 			return callable(
 				func(args []ast.Expr) (any, error) {
@@ -728,6 +892,77 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 	}
 }
 
+// tagColumn joins the run tag with the provided key, once per key, and returns its value column.
+func (pq *parsedQuery) tagColumn(table, key string) clause.Column {
+	j, ok := pq.joins[fmt.Sprintf("tags:%s", key)]
+	if !ok {
+		alias := fmt.Sprintf("tags_%d", len(pq.joins))
+		j = join{
+			alias: alias,
+			query: fmt.Sprintf(
+				"LEFT JOIN tags %s ON %s.run_uuid = %s.run_uuid AND %s.key = ?",
+				alias, table, alias, alias,
+			),
+			args: []any{key},
+		}
+		pq.joins[fmt.Sprintf("tags:%s", key)] = j
+	}
+	return clause.Column{
+		Table: j.alias,
+		Name:  "value",
+	}
+}
+
+// sequenceAttributes returns the attributes of the sequence records kept in the provided table.
+func (pq *parsedQuery) sequenceAttributes(name, table string) attributeGetter {
+	return func(attr string) (any, error) {
+		switch attr {
+		case "name":
+			return clause.Column{
+				Table: table,
+				Name:  "name",
+			}, nil
+		case "context":
+			return attributeGetter(
+				func(contextKey string) (any, error) {
+					alias := fmt.Sprintf("%s_contexts", name)
+					if _, ok := pq.joins[alias]; !ok {
+						pq.joins[alias] = join{
+							alias: alias,
+							query: fmt.Sprintf(
+								"LEFT JOIN contexts %s ON %s.context_id = %s.id", alias, table, alias,
+							),
+						}
+					}
+					return Json{
+						Column: clause.Column{
+							Table: alias,
+							Name:  "json",
+						},
+						JsonPath:  contextKey,
+						Dialector: pq.qp.Dialector,
+					}, nil
+				},
+			), nil
+		default:
+			return nil, fmt.Errorf("unsupported %s attribute %q", name, attr)
+		}
+	}
+}
+
+// contains returns the condition matching the runs with attached not archived Aim tag of the provided name.
+func (t runTags) contains(name string) clause.Expression {
+	return clause.Expr{
+		SQL: fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM run_shared_tags "+
+				"INNER JOIN shared_tags ON shared_tags.id = run_shared_tags.shared_tag_id "+
+				"WHERE run_shared_tags.run_id = %s.run_uuid AND shared_tags.name = ? AND NOT shared_tags.is_archived)",
+			t.table,
+		),
+		Vars: []any{name},
+	}
+}
+
 func (pq *parsedQuery) parseNameConstant(node *ast.NameConstant) (any, error) {
 	switch node.Value.Type() {
 	case py.NoneTypeType:
@@ -764,6 +999,8 @@ func (pq *parsedQuery) parseSubscript(node *ast.Subscript) (any, error) {
 		switch v := v.(type) {
 		case subscriptSlicer:
 			return v(node.Slice)
+		case runTags:
+			return v.subscriptSlicer(node.Slice)
 		default:
 			return nil, fmt.Errorf("unsupported attribute value %#v", v)
 		}
@@ -784,6 +1021,8 @@ func (pq *parsedQuery) parseUnaryOp(node *ast.UnaryOp) (any, error) {
 			return -e, nil
 		case float64:
 			return -e, nil
+		case clause.Column, Arithmetic:
+			return Arithmetic{clause.Expr{SQL: "(-?)", Vars: []any{e}}}, nil
 		default:
 			return nil, fmt.Errorf("unsupported type %T for unary operation %q", e, node.Op)
 		}
@@ -816,7 +1055,7 @@ func newSqlBoolComparison(op ast.CmpOp, left clause.Eq, right bool) (clause.Expr
 	}
 }
 
-func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expression, error) {
+func newSqlComparison(op ast.CmpOp, left any, right any) (clause.Expression, error) {
 	switch op {
 	case ast.Eq, ast.Is:
 		return clause.Eq{
@@ -871,6 +1110,31 @@ func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expre
 	}
 }
 
+// newSqlParamComparison compares the run param with the number. The numeric params are compared numerically,
+// while the other ones are compared as strings by the negative operators, because they are always different
+// from the number, e.g. `'adam' != 1` is true in Python.
+func newSqlParamComparison(
+	op ast.CmpOp, column clause.Column, numericColumn clause.Column, value any,
+) (clause.Expression, error) {
+	expression, err := newSqlComparison(op, numericColumn, numericValue(value))
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case ast.NotEq, ast.IsNot, ast.NotIn:
+		stringExpression, err := newSqlComparison(op, column, stringValue(value))
+		if err != nil {
+			return nil, err
+		}
+		return clause.Or(
+			expression,
+			clause.And(clause.Eq{Column: numericColumn, Value: nil}, stringExpression),
+		), nil
+	default:
+		return expression, nil
+	}
+}
+
 func newSqlJsonPathComparison(op ast.CmpOp, left Json, right any) (clause.Expression, error) {
 	switch op {
 	case ast.Eq:
@@ -888,7 +1152,7 @@ func newSqlJsonPathComparison(op ast.CmpOp, left Json, right any) (clause.Expres
 	}
 }
 
-func reverseComparison(op ast.CmpOp, left any, right clause.Column) (ast.CmpOp, clause.Column, any, error) {
+func reverseComparison(op ast.CmpOp, left any, right any) (ast.CmpOp, any, any, error) {
 	switch op {
 	case ast.Lt:
 		return ast.Gt, right, left, nil
@@ -905,6 +1169,153 @@ func reverseComparison(op ast.CmpOp, left any, right clause.Column) (ast.CmpOp,
 	}
 }
 
+// numericColumn returns the column keeping the numeric value of the run param,
+// when the provided value is the value column of the run param.
+func (pq *parsedQuery) numericColumn(value any) (clause.Column, bool) {
+	column, ok := value.(clause.Column)
+	if !ok || column.Name != "value" {
+		return clause.Column{}, false
+	}
+	for key, j := range pq.joins {
+		if strings.HasPrefix(key, "params:") && j.alias == column.Table {
+			return clause.Column{
+				Table: column.Table,
+				Name:  "value_numeric",
+			}, true
+		}
+	}
+	return clause.Column{}, false
+}
+
+// isNumeric checks whether the parsed value is the number, the boolean or the list of them.
+func isNumeric(value any) bool {
+	switch value := value.(type) {
+	case int, float64, bool:
+		return true
+	case []any:
+		for _, v := range value {
+			if !isNumeric(v) {
+				return false
+			}
+		}
+		return len(value) > 0
+	default:
+		return false
+	}
+}
+
+// numericValue converts the boolean values into the numbers, the way the run params keep them.
+func numericValue(value any) any {
+	switch value := value.(type) {
+	case bool:
+		if value {
+			return 1
+		}
+		return 0
+	case []any:
+		values := make([]any, len(value))
+		for i, v := range value {
+			values[i] = numericValue(v)
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// stringValue converts the numbers and the booleans into the strings the way Python prints them.
+func stringValue(value any) any {
+	switch value := value.(type) {
+	case bool:
+		if value {
+			return "True"
+		}
+		return "False"
+	case int:
+		return strconv.Itoa(value)
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	case []any:
+		values := make([]any, len(value))
+		for i, v := range value {
+			values[i] = stringValue(v)
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// isNumber checks whether the parsed value is the number constant.
+func isNumber(value any) bool {
+	switch value.(type) {
+	case int, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// evalArithmetic evaluates the arithmetic operation over the number constants the way Python does:
+// the integers are kept unless divided, the remainder has the sign of the divisor.
+func evalArithmetic(op string, left, right any) (any, error) {
+	l, lok := left.(int)
+	r, rok := right.(int)
+	if lok && rok && op != "/" {
+		switch op {
+		case "+":
+			return l + r, nil
+		case "-":
+			return l - r, nil
+		case "*":
+			return l * r, nil
+		case "%":
+			if r == 0 {
+				return nil, errors.New("integer division or modulo by zero")
+			}
+			m := l % r
+			if m != 0 && (m < 0) != (r < 0) {
+				m += r
+			}
+			return m, nil
+		}
+	}
+
+	lf, rf := toFloat(left), toFloat(right)
+	switch op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return lf / rf, nil
+	case "%":
+		if rf == 0 {
+			return nil, errors.New("float modulo by zero")
+		}
+		m := math.Mod(lf, rf)
+		if m != 0 && (m < 0) != (rf < 0) {
+			m += rf
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported binary operation %q", op)
+	}
+}
+
+// toFloat converts the number constant to float64.
+func toFloat(value any) float64 {
+	if v, ok := value.(int); ok {
+		return float64(v)
+	}
+	return value.(float64)
+}
+
 func negativeClause(expression clause.Expression) clause.Expression {
 	return clause.NotConditions{
 		Exprs: []clause.Expression{
@@ -122,6 +122,148 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 				`WHERE ("metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3)`,
 			expectedVars: []interface{}{"my_metric", -1.0, models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestMetricSummary",
+			query: `run.metrics['my_metric'].min > 0 and run.metrics['my_metric'].max < 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (("metrics_0"."min_value" > $2 AND "metrics_0"."max_value" < $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"my_metric", 0, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticMetrics",
+			query: `run.metrics['loss'].last * 2 - run.metrics['loss'].first > 0.1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ((("metrics_0"."value" * $2) - "metrics_0"."first_value") > $3 ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"loss", 2, 0.1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticDuration",
+			query: `run.duration / 3600 > 2`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((1.0 * (runs.end_time - runs.start_time) / 1000 / NULLIF($1, 0.0)) > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{3600, 2, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticAbsAndModulo",
+			query: `abs(run.metrics['loss'].last % 2) == 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (ABS(MOD(MOD(CAST("metrics_0"."value" AS numeric), NULLIF(CAST($2 AS numeric), 0)) ` +
+				`+ CAST($3 AS numeric), NULLIF(CAST($4 AS numeric), 0))) = $5 ` +
+				`AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"loss", 2, 2, 2, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticConstants",
+			query: `7 % -3 * (1 + 1) < -run.metrics['loss'].last`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ((-"metrics_0"."value") > $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"loss", -4, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericComparison",
+			query: `run.lr < 0.01 and run.use_bn == True`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`LEFT JOIN params params_1 ON runs.run_uuid = params_1.run_uuid AND params_1.key = $2 ` +
+				`WHERE (("params_0"."value_numeric" < $3 AND "params_1"."value_numeric" = $4) ` +
+				`AND "runs"."lifecycle_stage" <> $5)`,
+			expectedVars: []interface{}{"lr", "use_bn", 0.01, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericNotEqual",
+			query: `run.lr != 0.01`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" <> $2 OR ` +
+				`("params_0"."value_numeric" IS NULL AND "params_0"."value" <> $3)) ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"lr", 0.01, "0.01", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericNotIn",
+			query: `run.lr not in [1, True]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" NOT IN ($2,$3) OR ` +
+				`("params_0"."value_numeric" IS NULL AND "params_0"."value" NOT IN ($4,$5))) ` +
+				`AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"lr", 1, 1, "1", "True", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamStringComparison",
+			query: `run.lr == '1e-3'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE ("params_0"."value" = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"lr", "1e-3", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamArithmetic",
+			query: `run.epochs * 2 >= 10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" * $2) >= $3 AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"epochs", 2, 10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunParent",
+			query: `run.parent == 'parent'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE ("tags_0"."value" = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"mlflow.parentRunId", "parent", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunWithoutParent",
+			query: `run.parent is None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE ("tags_0"."value" IS NULL AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"mlflow.parentRunId", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunChildren",
+			query: `run.children > 0`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((SELECT COUNT(*) FROM tags WHERE tags.key = 'mlflow.parentRunId' ` +
+				`AND tags.value = runs.run_uuid) > $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{0, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunTagsContains",
+			query: `'my_tag' in run.tags`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((EXISTS (SELECT 1 FROM run_shared_tags ` +
+				`INNER JOIN shared_tags ON shared_tags.id = run_shared_tags.shared_tag_id ` +
+				`WHERE run_shared_tags.run_id = runs.run_uuid AND shared_tags.name = $1 ` +
+				`AND NOT shared_tags.is_archived)) AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"my_tag", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunTagsNotContains",
+			query: `'my_tag' not in run.tags`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (NOT (EXISTS (SELECT 1 FROM run_shared_tags ` +
+				`INNER JOIN shared_tags ON shared_tags.id = run_shared_tags.shared_tag_id ` +
+				`WHERE run_shared_tags.run_id = runs.run_uuid AND shared_tags.name = $1 ` +
+				`AND NOT shared_tags.is_archived)) AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"my_tag", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunTagsKey",
+			query: `run.tags['my_key'] == 'value'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE ("tags_0"."value" = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"my_key", "value", models.LifecycleStageDeleted},
+		},
 		{
 			name:          "TestMetricContext",
 			query:         `metric.context.key1 == 'value1'`,
@@ -140,6 +282,28 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 				`WHERE ("contexts"."json"#>>$1 <> $2 AND "runs"."lifecycle_stage" <> $3)`,
 			expectedVars: []interface{}{"{key1}", "value1", models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestImagesName",
+			query: `images.name == 'samples'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("blobs"."name" = $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"samples", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestAudiosContext",
+			query: `audios.context.subset == 'train'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN contexts audios_contexts ON blobs.context_id = audios_contexts.id ` +
+				`WHERE ("audios_contexts"."json"#>>$1 = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"{subset}", "train", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTextsDataSearch",
+			query: `re.search('error', texts.data) and texts.name == 'generations'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (("texts"."data" ~ $1 AND "texts"."name" = $2) AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"error", "generations", models.LifecycleStageDeleted},
+		},
 	}
 
 	for _, tt := range tests {
@@ -153,6 +317,9 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 					"runs":        "runs",
 					"experiments": "Experiment",
 					"metrics":     "metrics",
+					"images":      "blobs",
+					"audios":      "blobs",
+					"texts":       "texts",
 				},
 				Dialector: postgres.Dialector{}.Name(),
 			}
@@ -263,6 +430,95 @@ func (s *QueryTestSuite) TestSqliteDialector_Ok() {
 				`WHERE ("metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3)`,
 			expectedVars: []interface{}{"my_metric", -1.0, models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestMetricSummary",
+			query: `run.metrics['my_metric'].min > 0 and run.metrics['my_metric'].max < 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (("metrics_0"."min_value" > $2 AND "metrics_0"."max_value" < $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"my_metric", 0, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticMetrics",
+			query: `run.metrics['loss'].last * 2 - run.metrics['loss'].first > 0.1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ((("metrics_0"."value" * $2) - "metrics_0"."first_value") > $3 ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"loss", 2, 0.1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticDuration",
+			query: `run.duration / 3600 > 2`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((1.0 * (runs.end_time - runs.start_time) / 1000 / NULLIF($1, 0.0)) > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{3600, 2, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticAbsAndModulo",
+			query: `abs(run.metrics['loss'].last % 2) == 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (ABS(MOD(MOD("metrics_0"."value", NULLIF($2, 0)) + $3, NULLIF($4, 0))) = $5 ` +
+				`AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"loss", 2, 2, 2, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestArithmeticConstants",
+			query: `7 % -3 * (1 + 1) < -run.metrics['loss'].last`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ((-"metrics_0"."value") > $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"loss", -4, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericComparison",
+			query: `run.lr < 0.01 and run.use_bn == True`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`LEFT JOIN params params_1 ON runs.run_uuid = params_1.run_uuid AND params_1.key = $2 ` +
+				`WHERE (("params_0"."value_numeric" < $3 AND "params_1"."value_numeric" = $4) ` +
+				`AND "runs"."lifecycle_stage" <> $5)`,
+			expectedVars: []interface{}{"lr", "use_bn", 0.01, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericNotEqual",
+			query: `run.lr != 0.01`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" <> $2 OR ` +
+				`("params_0"."value_numeric" IS NULL AND "params_0"."value" <> $3)) ` +
+				`AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"lr", 0.01, "0.01", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamNumericNotIn",
+			query: `run.lr not in [1, True]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" NOT IN ($2,$3) OR ` +
+				`("params_0"."value_numeric" IS NULL AND "params_0"."value" NOT IN ($4,$5))) ` +
+				`AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"lr", 1, 1, "1", "True", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamStringComparison",
+			query: `run.lr == '1e-3'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE ("params_0"."value" = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"lr", "1e-3", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamArithmetic",
+			query: `run.epochs * 2 >= 10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE (("params_0"."value_numeric" * $2) >= $3 AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []interface{}{"epochs", 2, 10, models.LifecycleStageDeleted},
+		},
 		{
 			name:          "TestMetricContext",
 			query:         `metric.context.key1 == 'value1'`,
@@ -328,6 +584,21 @@ func (s *QueryTestSuite) Test_Error() {
 			query:         `metric.context.parent.nested == 'value1'`,
 			expectedError: SyntaxError{},
 		},
+		{
+			name:          "TestArithmeticDivisionByZero",
+			query:         `run.metrics['loss'].last > 1 / 0`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestArithmeticUnsupportedOperand",
+			query:         `run.name + 'suffix' == 'run'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestArithmeticUnsupportedOperation",
+			query:         `run.metrics['loss'].last // 2 > 1`,
+			expectedError: SyntaxError{},
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -0,0 +1,22 @@
+package request
+
+// LogRunBlobsRequest is a request struct for `POST /runs/:id/images/log-batch`
+// and `POST /runs/:id/audios/log-batch` endpoints.
+type LogRunBlobsRequest struct {
+	Records []BlobRecord `json:"records"`
+}
+
+// BlobRecord is a partial request object for LogRunBlobsRequest.
+// Data holds base64 encoded content of the image or audio.
+type BlobRecord struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Index     int64          `json:"index"`
+	Timestamp int64          `json:"timestamp"`
+	Caption   string         `json:"caption"`
+	Format    string         `json:"format"`
+	Width     int64          `json:"width"`
+	Height    int64          `json:"height"`
+	Data      []byte         `json:"data"`
+}
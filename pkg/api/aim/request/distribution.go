@@ -0,0 +1,20 @@
+package request
+
+// LogRunDistributionsRequest is a request struct for `POST /runs/:id/distributions/log-batch` endpoint.
+type LogRunDistributionsRequest struct {
+	Records []DistributionRecord `json:"records"`
+}
+
+// DistributionRecord is a partial request object for LogRunDistributionsRequest.
+// The histogram is provided either pre-binned, as Weights of the bins of equal width inside Range,
+// or as raw Values, which are binned by the server into BinCount bins.
+type DistributionRecord struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Timestamp int64          `json:"timestamp"`
+	Weights   []float64      `json:"weights"`
+	Range     []float64      `json:"range"`
+	Values    []float64      `json:"values"`
+	BinCount  int            `json:"bin_count"`
+}
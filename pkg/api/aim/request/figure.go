@@ -0,0 +1,18 @@
+package request
+
+import "encoding/json"
+
+// LogRunFiguresRequest is a request struct for `POST /runs/:id/figures/log-batch` endpoint.
+type LogRunFiguresRequest struct {
+	Records []FigureRecord `json:"records"`
+}
+
+// FigureRecord is a partial request object for LogRunFiguresRequest.
+// Data holds Plotly figure json.
+type FigureRecord struct {
+	Name      string          `json:"name"`
+	Context   map[string]any  `json:"context"`
+	Step      int64           `json:"step"`
+	Timestamp int64           `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
@@ -0,0 +1,26 @@
+package request
+
+// LogRunLogsRequest is a request struct for `POST /runs/:id/logs/log-batch` endpoint.
+type LogRunLogsRequest struct {
+	Lines []LogLine `json:"lines"`
+}
+
+// LogLine is a partial request object for LogRunLogsRequest.
+type LogLine struct {
+	Stream    string `json:"stream"`
+	Timestamp int64  `json:"timestamp"`
+	Data      string `json:"data"`
+}
+
+// LogRunLogRecordsRequest is a request struct for `POST /runs/:id/log-records/log-batch` endpoint.
+type LogRunLogRecordsRequest struct {
+	Records []LogRecord `json:"records"`
+}
+
+// LogRecord is a partial request object for LogRunLogRecordsRequest.
+type LogRecord struct {
+	Level     string         `json:"level"`
+	Message   string         `json:"message"`
+	Timestamp int64          `json:"timestamp"`
+	Args      map[string]any `json:"args"`
+}
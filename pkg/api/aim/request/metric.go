@@ -31,3 +31,11 @@ type SearchMetricsRequest struct {
 	SkipSystem     bool   `query:"skip_system"`
 	ReportProgress bool   `query:"report_progress"`
 }
+
+// AggregateMetricsRequest is a request struct for `GET /runs/search/metric/aggregate/` endpoint.
+type AggregateMetricsRequest struct {
+	Query        string   `query:"q"`
+	Steps        int      `query:"p"`
+	GroupBy      []string `query:"group_by"`
+	Aggregations []string `query:"aggregation"`
+}
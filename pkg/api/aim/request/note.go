@@ -0,0 +1,12 @@
+package request
+
+// CreateNoteRequest is a request struct for `POST /runs/:id/note` and `POST /experiments/:id/note` endpoints.
+type CreateNoteRequest struct {
+	Content string `json:"content"`
+}
+
+// UpdateNoteRequest is a request struct for `PUT /runs/:id/note/:note_id`
+// and `PUT /experiments/:id/note/:note_id` endpoints.
+type UpdateNoteRequest struct {
+	Content string `json:"content"`
+}
@@ -0,0 +1,12 @@
+package request
+
+// UpdateProjectPinnedSequencesRequest is a request struct for `POST /projects/pinned-sequences` endpoint.
+type UpdateProjectPinnedSequencesRequest struct {
+	Sequences []ProjectPinnedSequence `json:"sequences"`
+}
+
+// ProjectPinnedSequence represents a single pinned sequence.
+type ProjectPinnedSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
@@ -0,0 +1,23 @@
+package request
+
+// GetRunSequencesBatchRequest is a request object for `POST /runs/:id/images/get-batch`,
+// `POST /runs/:id/audios/get-batch` and `POST /runs/:id/distributions/get-batch` endpoints.
+type GetRunSequencesBatchRequest []RunSequence
+
+// RunSequence is a partial request object for GetRunSequencesBatchRequest.
+type RunSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
+// SearchSequencesRequest is a request struct for `GET /runs/search/images/`, `GET /runs/search/audios/`,
+// `GET /runs/search/texts/` and `GET /runs/search/figures/` endpoints.
+type SearchSequencesRequest struct {
+	Query          string `query:"q"`
+	RecordRange    string `query:"record_range"`
+	RecordDensity  int    `query:"record_density"`
+	IndexRange     string `query:"index_range"`
+	IndexDensity   int    `query:"index_density"`
+	SkipSystem     bool   `query:"skip_system"`
+	ReportProgress bool   `query:"report_progress"`
+}
@@ -0,0 +1,16 @@
+package request
+
+// LogRunTextsRequest is a request struct for `POST /runs/:id/texts/log-batch` endpoint.
+type LogRunTextsRequest struct {
+	Records []TextRecord `json:"records"`
+}
+
+// TextRecord is a partial request object for LogRunTextsRequest.
+type TextRecord struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Index     int64          `json:"index"`
+	Timestamp int64          `json:"timestamp"`
+	Data      string         `json:"data"`
+}
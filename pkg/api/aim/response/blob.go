@@ -0,0 +1,26 @@
+package response
+
+// GetRunBlobsBatch represents the response json for `POST /runs/:id/images/get-batch`
+// and `POST /runs/:id/audios/get-batch` endpoints.
+type GetRunBlobsBatch []BlobTrace
+
+// BlobTrace is a partial response object for GetRunBlobsBatch.
+type BlobTrace struct {
+	Name        string         `json:"name"`
+	Context     map[string]any `json:"context"`
+	Values      [][]BlobValue  `json:"values"`
+	Iters       []int64        `json:"iters"`
+	Timestamps  []float64      `json:"timestamps"`
+	RecordRange []int64        `json:"record_range"`
+	IndexRange  []int64        `json:"index_range"`
+}
+
+// BlobValue is a partial response object for BlobTrace.
+type BlobValue struct {
+	Caption string `json:"caption"`
+	Format  string `json:"format"`
+	BlobURI string `json:"blob_uri"`
+	Index   int64  `json:"index"`
+	Width   int64  `json:"width"`
+	Height  int64  `json:"height"`
+}
@@ -0,0 +1,29 @@
+package response
+
+// GetRunDistributionsBatch represents the response json for `POST /runs/:id/distributions/get-batch` endpoint.
+type GetRunDistributionsBatch []DistributionTrace
+
+// DistributionTrace is a partial response object for GetRunDistributionsBatch.
+type DistributionTrace struct {
+	Name        string              `json:"name"`
+	Context     map[string]any      `json:"context"`
+	Values      []DistributionValue `json:"values"`
+	Iters       []int64             `json:"iters"`
+	Timestamps  []float64           `json:"timestamps"`
+	RecordRange []int64             `json:"record_range"`
+}
+
+// DistributionValue is a partial response object for DistributionTrace.
+type DistributionValue struct {
+	Data     Numpy     `json:"data"`
+	BinCount int64     `json:"bin_count"`
+	Range    []float64 `json:"range"`
+}
+
+// Numpy represents numpy encoded array.
+type Numpy struct {
+	Type  string `json:"type"`
+	DType string `json:"dtype"`
+	Shape int    `json:"shape"`
+	Blob  []byte `json:"blob"`
+}
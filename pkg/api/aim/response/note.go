@@ -0,0 +1,21 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Note represents the response json for the note endpoints.
+type Note struct {
+	ID        uuid.UUID `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateNote represents the response json for the CreateRunNote and CreateExperimentNote endpoints.
+type CreateNote struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
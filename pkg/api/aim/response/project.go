@@ -22,3 +22,14 @@ type ProjectParamsResponse struct {
 	Metric map[string][]struct{}  `json:"metric"`
 	Params map[string]interface{} `json:"params"`
 }
+
+// ProjectPinnedSequencesResponse is a response object for `GET aim/projects/pinned-sequences` endpoint.
+type ProjectPinnedSequencesResponse struct {
+	Sequences []ProjectPinnedSequence `json:"sequences"`
+}
+
+// ProjectPinnedSequence represents a single pinned sequence.
+type ProjectPinnedSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
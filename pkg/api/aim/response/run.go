@@ -19,15 +19,34 @@ type GetRunInfoParams struct {
 
 // GetRunInfoTraces is a partial response object for GetRunInfo.
 type GetRunInfoTraces struct {
-	Tags   map[string]string        `json:"tags"`
-	Metric []GetRunInfoTracesMetric `json:"metric"`
+	Tags          map[string]string          `json:"tags"`
+	Metric        []GetRunInfoTracesMetric   `json:"metric"`
+	Images        []GetRunInfoTracesSequence `json:"images"`
+	Audios        []GetRunInfoTracesSequence `json:"audios"`
+	Distributions []GetRunInfoTracesSequence `json:"distributions"`
+	Texts         []GetRunInfoTracesSequence `json:"texts"`
+	Figures       []GetRunInfoTracesSequence `json:"figures"`
+	Logs          []GetRunInfoTracesSequence `json:"logs"`
+	LogRecords    []GetRunInfoTracesSequence `json:"log_records"`
 }
 
 // GetRunInfoTracesMetric is a partial response object for GetRunInfoTraces.
+// Values are empty, when they are NaN.
 type GetRunInfoTracesMetric struct {
-	Name      string          `json:"name"`
-	Context   json.RawMessage `json:"context"`
-	LastValue float64         `json:"last_value"`
+	Name       string          `json:"name"`
+	Context    json.RawMessage `json:"context"`
+	LastValue  *float64        `json:"last_value"`
+	FirstValue *float64        `json:"first_value"`
+	MinValue   *float64        `json:"min_value"`
+	MaxValue   *float64        `json:"max_value"`
+	Count      int64           `json:"count"`
+	LastStep   int64           `json:"last_step"`
+}
+
+// GetRunInfoTracesSequence is a partial response object for GetRunInfoTraces.
+type GetRunInfoTracesSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
 }
 
 // GetRunInfoProps is a partial response object for GetRunInfo.
@@ -35,7 +54,7 @@ type GetRunInfoProps struct {
 	Name         string               `json:"name"`
 	Description  string               `json:"description"`
 	Experiment   GetRunInfoExperiment `json:"experiment"`
-	Tags         []string             `json:"tags"`
+	Tags         []RunTag             `json:"tags"`
 	CreationTime float64              `json:"creation_time"`
 	EndTime      float64              `json:"end_time"`
 	Archived     bool                 `json:"archived"`
@@ -0,0 +1,61 @@
+package response
+
+import (
+	"github.com/google/uuid"
+)
+
+// GetTag represents the response json for the GetTag endpoint.
+type GetTag struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	Archived    bool      `json:"archived"`
+	RunCount    int64     `json:"run_count"`
+}
+
+// GetTags is the response struct for the GetTags endpoint (slice of GetTag).
+type GetTags []GetTag
+
+// RunTag represents a tag attached to a run in the run properties.
+type RunTag struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+}
+
+// CreateTag represents the response json for the CreateTag endpoint.
+type CreateTag struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+// GetTagRuns represents the response json for the GetTagRuns endpoint.
+type GetTagRuns struct {
+	ID   uuid.UUID `json:"id"`
+	Runs []TagRun  `json:"runs"`
+}
+
+// TagRun represents a run the tag is attached to.
+type TagRun struct {
+	ID           string               `json:"run_id"`
+	Name         string               `json:"name"`
+	Experiment   GetRunInfoExperiment `json:"experiment"`
+	CreationTime float64              `json:"creation_time"`
+	EndTime      float64              `json:"end_time"`
+}
+
+// AddRunTag represents the response json for the AddRunTag endpoint.
+type AddRunTag struct {
+	ID     string    `json:"id"`
+	TagID  uuid.UUID `json:"tag_id"`
+	Status string    `json:"status"`
+}
+
+// DeleteRunTag represents the response json for the DeleteRunTag endpoint.
+type DeleteRunTag struct {
+	ID      string `json:"id"`
+	Removed bool   `json:"removed"`
+	Status  string `json:"status"`
+}
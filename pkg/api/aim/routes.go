@@ -2,9 +2,16 @@ package aim
 
 import (
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
 )
 
-func AddRoutes(r fiber.Router) {
+func AddRoutes(
+	r fiber.Router, config *config.ServiceConfig, artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) {
+	blobs := newBlobStorage(config, artifactStorageFactory)
+
 	apps := r.Group("apps")
 	apps.Get("/", GetApps)
 	apps.Post("/", CreateApp)
@@ -26,6 +33,11 @@ func AddRoutes(r fiber.Router) {
 	experiments.Get("/:id/runs/", GetExperimentRuns)
 	experiments.Delete("/:id/", DeleteExperiment)
 	experiments.Put("/:id/", UpdateExperiment)
+	experiments.Get("/:id/note/", GetExperimentNotes)
+	experiments.Post("/:id/note/", CreateExperimentNote)
+	experiments.Get("/:id/note/:note_id/", GetExperimentNote)
+	experiments.Put("/:id/note/:note_id/", UpdateExperimentNote)
+	experiments.Delete("/:id/note/:note_id/", DeleteExperimentNote)
 
 	projects := r.Group("/projects")
 	projects.Get("/", GetProject)
@@ -40,15 +52,48 @@ func AddRoutes(r fiber.Router) {
 	runs.Get("/search/run/", SearchRuns)
 	runs.Get("/search/metric/", SearchMetrics)
 	runs.Post("/search/metric/align/", SearchAlignedMetrics)
+	runs.Get("/search/metric/aggregate/", AggregateMetrics)
+	runs.Get("/search/images/", SearchImages)
+	runs.Get("/search/audios/", SearchAudios)
+	runs.Get("/search/texts/", SearchTexts)
+	runs.Get("/search/figures/", SearchFigures)
+	runs.Post("/images/get-batch/", blobs.GetImagesBatch)
+	runs.Post("/audios/get-batch/", blobs.GetAudiosBatch)
 	runs.Get("/:id/info/", GetRunInfo)
 	runs.Post("/:id/metric/get-batch/", GetRunMetrics)
+	runs.Post("/:id/images/get-batch/", GetRunImagesBatch)
+	runs.Post("/:id/audios/get-batch/", GetRunAudiosBatch)
+	runs.Post("/:id/distributions/get-batch/", GetRunDistributionsBatch)
+	runs.Get("/:id/logs/", GetRunLogs)
+	runs.Get("/:id/logs/tail/", TailRunLogs)
+	runs.Get("/:id/log-records/", GetRunLogRecords)
+	runs.Post("/:id/images/log-batch/", blobs.LogRunImages)
+	runs.Post("/:id/audios/log-batch/", blobs.LogRunAudios)
+	runs.Post("/:id/distributions/log-batch/", LogRunDistributions)
+	runs.Post("/:id/texts/log-batch/", LogRunTexts)
+	runs.Post("/:id/figures/log-batch/", LogRunFigures)
+	runs.Post("/:id/logs/log-batch/", LogRunLogs)
+	runs.Post("/:id/log-records/log-batch/", LogRunLogRecords)
 	runs.Put("/:id/", UpdateRun)
 	runs.Delete("/:id/", DeleteRun)
 	runs.Post("/delete-batch/", DeleteBatch)
 	runs.Post("/archive-batch/", ArchiveBatch)
+	runs.Post("/:id/tags/new/", AddRunTag)
+	runs.Delete("/:id/tags/:tag_id/", DeleteRunTag)
+	runs.Get("/:id/note/", GetRunNotes)
+	runs.Post("/:id/note/", CreateRunNote)
+	runs.Get("/:id/note/:note_id/", GetRunNote)
+	runs.Put("/:id/note/:note_id/", UpdateRunNote)
+	runs.Delete("/:id/note/:note_id/", DeleteRunNote)
 
 	tags := r.Group("/tags")
 	tags.Get("/", GetTags)
+	tags.Post("/", CreateTag)
+	tags.Get("/search/", SearchTags)
+	tags.Get("/:id/", GetTag)
+	tags.Put("/:id/", UpdateTag)
+	tags.Delete("/:id/", DeleteTag)
+	tags.Get("/:id/runs/", GetTagRuns)
 
 	r.Use(func(c *fiber.Ctx) error {
 		return fiber.ErrNotFound
@@ -22,6 +22,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
@@ -63,7 +64,8 @@ func GetRunInfo(c *fiber.Ctx) error {
 			),
 		).
 		Preload("Params").
-		Preload("Tags")
+		Preload("Tags").
+		Preload("SharedTags", "NOT is_archived")
 
 	if len(q.Sequences) == 0 {
 		q.Sequences = []string{
@@ -84,9 +86,7 @@ func GetRunInfo(c *fiber.Ctx) error {
 		case "audios", "distributions", "figures", "images", "log_records", "logs", "texts":
 			traces[s] = []fiber.Map{}
 		case "metric":
-			tx.Preload("LatestMetrics", func(db *gorm.DB) *gorm.DB {
-				return db.Select("RunID", "Key", "ContextID")
-			}).Preload("LatestMetrics.Context")
+			tx.Preload("LatestMetrics.Context")
 		default:
 			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%q is not a valid Sequence", s))
 		}
@@ -110,7 +110,7 @@ func GetRunInfo(c *fiber.Ctx) error {
 			"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 			"name": r.Experiment.Name,
 		},
-		"tags":          []string{}, // TODO insert real tags
+		"tags":          convertSharedTags(r.SharedTags),
 		"creation_time": float64(r.StartTime.Int64) / 1000,
 		"end_time":      float64(r.EndTime.Int64) / 1000,
 		"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -128,10 +128,23 @@ func GetRunInfo(c *fiber.Ctx) error {
 
 	metrics := make([]fiber.Map, len(r.LatestMetrics))
 	for i, m := range r.LatestMetrics {
+		// NaN values can't be encoded into json, so they are left empty.
+		var lastValue, firstValue *float64
+		if !m.IsNan {
+			lastValue = common.GetPointer(m.Value)
+		}
+		if !m.FirstIsNan {
+			firstValue = common.GetPointer(m.FirstValue)
+		}
 		metric := fiber.Map{
-			"name":       m.Key,
-			"context":    fiber.Map{},
-			"last_value": 0.1,
+			"name":        m.Key,
+			"context":     fiber.Map{},
+			"last_value":  lastValue,
+			"first_value": firstValue,
+			"min_value":   m.MinValue,
+			"max_value":   m.MaxValue,
+			"count":       m.Count,
+			"last_step":   m.LastIter,
 		}
 		if m.Context != nil {
 			metric["context"] = m.Context.Json
@@ -140,6 +153,16 @@ func GetRunInfo(c *fiber.Ctx) error {
 	}
 	traces["metric"] = metrics
 
+	runSequences, err := findRunSequences([]string{r.ID})
+	if err != nil {
+		return fmt.Errorf("error retrieving sequences of run %q: %w", p.ID, err)
+	}
+	for sequenceType, sequenceTraces := range runSequences[r.ID] {
+		if _, ok := traces[sequenceType]; ok {
+			traces[sequenceType] = sequenceTraces
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"params": params,
 		"traces": traces,
@@ -283,6 +306,7 @@ func GetRunsActive(c *fiber.Ctx) error {
 			),
 		).
 		Preload("LatestMetrics.Context").
+		Preload("SharedTags", "NOT is_archived").
 		Limit(50).
 		Order("start_time DESC").
 		Find(&runs).Error; err != nil {
@@ -301,7 +325,7 @@ func GetRunsActive(c *fiber.Ctx) error {
 						"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 						"name": r.Experiment.Name,
 					},
-					"tags":          []string{}, // TODO insert real tags
+					"tags":          convertSharedTags(r.SharedTags),
 					"creation_time": float64(r.StartTime.Int64) / 1000,
 					"end_time":      float64(r.EndTime.Int64) / 1000,
 					"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -310,20 +334,11 @@ func GetRunsActive(c *fiber.Ctx) error {
 
 				metrics := make([]fiber.Map, len(r.LatestMetrics))
 				for i, m := range r.LatestMetrics {
-					v := m.Value
-					if m.IsNan {
-						v = math.NaN()
-					}
+					lastValue := newMetricLastValue(&m)
+					lastValue["context"] = fiber.Map{}
 					data := fiber.Map{
-						"name": m.Key,
-						"last_value": fiber.Map{
-							"dtype":      "float",
-							"first_step": 0,
-							"last_step":  m.LastIter,
-							"last":       v,
-							"version":    2,
-							"context":    fiber.Map{},
-						},
+						"name":       m.Key,
+						"last_value": lastValue,
 					}
 					if m.Context != nil {
 						// to be properly decoded by AIM UI, json should be represented as a key:value object.
@@ -449,6 +464,7 @@ func SearchRuns(c *fiber.Ctx) error {
 				&models.Experiment{NamespaceID: ns.ID},
 			),
 		).
+		Preload("SharedTags", "NOT is_archived").
 		Order("row_num DESC")
 
 	if q.Limit > 0 {
@@ -484,6 +500,17 @@ func SearchRuns(c *fiber.Ctx) error {
 
 	log.Debugf("Found %d runs", len(runs))
 
+	var runSequences map[string]map[string][]fiber.Map
+	if !q.ExcludeTraces {
+		runIDs := make([]string, len(runs))
+		for i, r := range runs {
+			runIDs[i] = r.ID
+		}
+		if runSequences, err = findRunSequences(runIDs); err != nil {
+			return fmt.Errorf("error searching runs: %w", err)
+		}
+	}
+
 	c.Set("Content-Type", "application/octet-stream")
 	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
 		start := time.Now()
@@ -497,7 +524,7 @@ func SearchRuns(c *fiber.Ctx) error {
 							"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 							"name": r.Experiment.Name,
 						},
-						"tags":          []string{}, // TODO insert real tags
+						"tags":          convertSharedTags(r.SharedTags),
 						"creation_time": float64(r.StartTime.Int64) / 1000,
 						"end_time":      float64(r.EndTime.Int64) / 1000,
 						"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -508,20 +535,10 @@ func SearchRuns(c *fiber.Ctx) error {
 				if !q.ExcludeTraces {
 					metrics := make([]fiber.Map, len(r.LatestMetrics))
 					for i, m := range r.LatestMetrics {
-						v := m.Value
-						if m.IsNan {
-							v = math.NaN()
-						}
 						data := fiber.Map{
-							"name": m.Key,
-							"last_value": fiber.Map{
-								"dtype":      "float",
-								"first_step": 0,
-								"last_step":  m.LastIter,
-								"last":       v,
-								"version":    2,
-							},
-							"context": fiber.Map{},
+							"name":       m.Key,
+							"last_value": newMetricLastValue(&m),
+							"context":    fiber.Map{},
 						}
 						if m.Context != nil {
 							// to be properly decoded by AIM UI, json should be represented as a key:value object.
@@ -533,9 +550,17 @@ func SearchRuns(c *fiber.Ctx) error {
 						}
 						metrics[i] = data
 					}
-					run["traces"] = fiber.Map{
+					traces := fiber.Map{
 						"metric": metrics,
 					}
+					for _, sequenceType := range []string{"images", "audios", "distributions", "texts", "figures"} {
+						sequenceTraces, ok := runSequences[r.ID][sequenceType]
+						if !ok {
+							sequenceTraces = []fiber.Map{}
+						}
+						traces[sequenceType] = sequenceTraces
+					}
+					run["traces"] = traces
 				}
 
 				if !q.ExcludeParams {
@@ -666,6 +691,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 		).
 		Preload("Params").
 		Preload("Tags").
+		Preload("SharedTags", "NOT is_archived").
 		Where("run_uuid IN (?)", pq.Filter(database.DB.
 			Select("runs.run_uuid").
 			Table("runs").
@@ -693,7 +719,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 					"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 					"name": r.Experiment.Name,
 				},
-				"tags":          []string{}, // TODO insert real tags
+				"tags":          convertSharedTags(r.SharedTags),
 				"creation_time": float64(r.StartTime.Int64) / 1000,
 				"end_time":      float64(r.EndTime.Int64) / 1000,
 				"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -731,7 +757,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 					"runs.run_uuid",
 					"runs.row_num",
 					"latest_metrics.key",
-					fmt.Sprintf("(latest_metrics.last_iter + 1)/ %f AS interval", float32(q.Steps)),
+					samplingInterval("latest_metrics.last_iter", q.Steps),
 				).
 				Table("runs").
 				Joins(
@@ -742,7 +768,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 				Joins("LEFT JOIN latest_metrics USING(run_uuid)")),
 		).
 		Joins("LEFT JOIN contexts AS c ON c.id = metrics.context_id").
-		Where("MOD(metrics.iter + 1 + runmetrics.interval / 2, runmetrics.interval) < 1").
+		Where(samplingCondition("metrics.iter", "runmetrics.interval")).
 		Order("runmetrics.row_num DESC").
 		Order("metrics.key").
 		Order("metrics.iter")
@@ -1160,20 +1186,6 @@ func UpdateRun(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run '%s'", params.ID))
 	}
 
-	if updateRequest.Archived != nil {
-		if *updateRequest.Archived {
-			if err := runRepository.Archive(c.Context(), run); err != nil {
-				return fiber.NewError(fiber.StatusInternalServerError,
-					fmt.Sprintf("unable to archive/restore run %q: %s", params.ID, err))
-			}
-		} else {
-			if err := runRepository.Restore(c.Context(), run); err != nil {
-				return fiber.NewError(fiber.StatusInternalServerError,
-					fmt.Sprintf("unable to archive/restore run %q: %s", params.ID, err))
-			}
-		}
-	}
-
 	if updateRequest.Name != nil {
 		run.Name = *updateRequest.Name
 		// TODO:DSuhinin - transaction?
@@ -1188,6 +1200,22 @@ func UpdateRun(c *fiber.Ctx) error {
 		}
 	}
 
+	// archive and restore go through the batch methods, so that child runs follow their parent.
+	// it has to happen after the name update, which otherwise would write back the stale lifecycle stage.
+	if updateRequest.Archived != nil {
+		if *updateRequest.Archived {
+			if err := runRepository.ArchiveBatch(c.Context(), ns.ID, []string{run.ID}); err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError,
+					fmt.Sprintf("unable to archive/restore run %q: %s", params.ID, err))
+			}
+		} else {
+			if err := runRepository.RestoreBatch(c.Context(), ns.ID, []string{run.ID}); err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError,
+					fmt.Sprintf("unable to archive/restore run %q: %s", params.ID, err))
+			}
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"id":     params.ID,
 		"status": "OK",
@@ -1245,6 +1273,37 @@ func DeleteBatch(c *fiber.Ctx) error {
 	})
 }
 
+// convertRunProps converts the run into the props representation of Aim UI.
+func convertRunProps(r *database.Run) fiber.Map {
+	return fiber.Map{
+		"name":        r.Name,
+		"description": nil,
+		"experiment": fiber.Map{
+			"id":   fmt.Sprintf("%d", *r.Experiment.ID),
+			"name": r.Experiment.Name,
+		},
+		"tags":          convertSharedTags(r.SharedTags),
+		"creation_time": float64(r.StartTime.Int64) / 1000,
+		"end_time":      float64(r.EndTime.Int64) / 1000,
+		"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
+		"active":        r.Status == database.StatusRunning,
+	}
+}
+
+// convertRunParams converts the params and the tags of the run into the params representation of Aim UI.
+func convertRunParams(r *database.Run) fiber.Map {
+	params := make(fiber.Map, len(r.Params)+1)
+	for _, p := range r.Params {
+		params[p.Key] = p.Value
+	}
+	tags := make(map[string]string, len(r.Tags))
+	for _, t := range r.Tags {
+		tags[t.Key] = t.Value
+	}
+	params["tags"] = tags
+	return params
+}
+
 func toNumpy(values []float64) fiber.Map {
 	buf := bytes.NewBuffer(make([]byte, 0, len(values)*8))
 	for _, v := range values {
@@ -1264,3 +1323,33 @@ func toNumpy(values []float64) fiber.Map {
 		"blob":  buf.Bytes(),
 	}
 }
+
+// newMetricLastValue converts the latest metric into the `last_value` of the metric trace,
+// which keeps the summary of the metric values along with the last one.
+func newMetricLastValue(m *database.LatestMetric) fiber.Map {
+	last, first := m.Value, m.FirstValue
+	if m.IsNan {
+		last = math.NaN()
+	}
+	if m.FirstIsNan {
+		first = math.NaN()
+	}
+	lastValue := fiber.Map{
+		"dtype":      "float",
+		"first_step": 0,
+		"last_step":  m.LastIter,
+		"last":       last,
+		"first":      first,
+		"min":        nil,
+		"max":        nil,
+		"count":      m.Count,
+		"version":    2,
+	}
+	if m.MinValue != nil {
+		lastValue["min"] = *m.MinValue
+	}
+	if m.MaxValue != nil {
+		lastValue["max"] = *m.MaxValue
+	}
+	return lastValue
+}
@@ -0,0 +1,488 @@
+package aim
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// runSequence represents the name and the context of the sequence of the run,
+// where Type is the sequence type used by Aim UI, like `images` or `distributions`.
+type runSequence struct {
+	RunID   string `gorm:"column:run_uuid"`
+	Type    string
+	Name    string
+	Context datatypes.JSON `gorm:"column:context_json"`
+}
+
+// sequenceItem represents the record of the sequence logged at a single step and index.
+// Value converts the record into the trace value, it is called only for the records included into the trace.
+type sequenceItem struct {
+	Name      string
+	ContextID uint
+	Context   datatypes.JSON
+	Step      int64
+	Index     int64
+	Timestamp int64
+	Value     func() (any, error)
+}
+
+// sequenceSearch represents the parsed request of `GET /runs/search/<sequence>/` endpoints.
+type sequenceSearch struct {
+	request.SearchSequencesRequest
+	sequenceType string
+	namespaceID  uint
+	recordRange  sequenceRange
+	indexRange   sequenceRange
+	query        query.ParsedQuery
+	join         string
+	joinArgs     []any
+}
+
+// sequenceRange represents `start:stop` range of steps or indices of sequence records.
+// Missing boundaries are taken from the total range of the records.
+type sequenceRange struct {
+	start *int64
+	stop  *int64
+}
+
+// parseSequenceRange parses `start:stop` range, where both boundaries are optional.
+func parseSequenceRange(value string) (sequenceRange, error) {
+	var r sequenceRange
+	if value == "" {
+		return r, nil
+	}
+
+	start, stop, ok := strings.Cut(value, ":")
+	if !ok {
+		return r, fmt.Errorf("invalid range %q", value)
+	}
+	if start != "" {
+		v, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+		if err != nil {
+			return r, fmt.Errorf("invalid range %q: %w", value, err)
+		}
+		r.start = &v
+	}
+	if stop != "" {
+		v, err := strconv.ParseInt(strings.TrimSpace(stop), 10, 64)
+		if err != nil {
+			return r, fmt.Errorf("invalid range %q: %w", value, err)
+		}
+		r.stop = &v
+	}
+	return r, nil
+}
+
+// resolve returns the range limited by the provided total range.
+func (r sequenceRange) resolve(total [2]int64) [2]int64 {
+	resolved := total
+	if r.start != nil && *r.start > total[0] {
+		resolved[0] = *r.start
+	}
+	if r.stop != nil && *r.stop < total[1] {
+		resolved[1] = *r.stop
+	}
+	return resolved
+}
+
+// sampleIndices selects up to density evenly distributed indices out of n.
+// Non-positive density disables sampling.
+func sampleIndices(n, density int) []int {
+	count := n
+	if density > 0 && density < n {
+		count = density
+	}
+
+	indices := make([]int, count)
+	for i := range indices {
+		indices[i] = i * n / count
+	}
+	return indices
+}
+
+// samplingInterval returns the `interval` column, which makes samplingCondition select
+// approximately `steps` iterations out of the `lastIter + 1` ones of the sequence.
+func samplingInterval(lastIter string, steps int) string {
+	return fmt.Sprintf("(%s + 1)/ %f AS interval", lastIter, float32(steps))
+}
+
+// samplingCondition returns the condition selecting evenly distributed iterations of the sequence
+// according to the interval column provided by samplingInterval.
+func samplingCondition(iter, interval string) string {
+	return fmt.Sprintf("MOD(%s + 1 + %s / 2, %s) < 1", iter, interval, interval)
+}
+
+// getOrCreateContext returns the stored context with the provided value, creating it when needed.
+func getOrCreateContext(tx *gorm.DB, value map[string]any) (*database.Context, error) {
+	if value == nil {
+		value = map[string]any{}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, eris.Wrap(err, "error marshalling context")
+	}
+
+	context := database.Context{
+		Json: data,
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "json"}},
+		UpdateAll: true,
+	}).Create(&context).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating context")
+	}
+	return &context, nil
+}
+
+// decodeContext converts stored context into the object, which can be encoded for Aim UI.
+func decodeContext(data datatypes.JSON) (map[string]any, error) {
+	context := map[string]any{}
+	if len(data) == 0 {
+		return context, nil
+	}
+	if err := json.Unmarshal(data, &context); err != nil {
+		return nil, eris.Wrap(err, "error unmarshalling `context` json to `fiber.Map` object")
+	}
+	return context, nil
+}
+
+// findRunSequences returns the traces overview of the image, audio, distribution, text, figure and log sequences
+// of the runs grouped by run and sequence type.
+func findRunSequences(runIDs []string) (map[string]map[string][]fiber.Map, error) {
+	var sequences []runSequence
+	for _, find := range []func([]string) ([]runSequence, error){
+		findBlobSequences,
+		findDistributionSequences,
+		findTextSequences,
+		findFigureSequences,
+		findLogSequences,
+	} {
+		found, err := find(runIDs)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, found...)
+	}
+
+	result := map[string]map[string][]fiber.Map{}
+	for _, sequence := range sequences {
+		context, err := decodeContext(sequence.Context)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result[sequence.RunID]; !ok {
+			result[sequence.RunID] = map[string][]fiber.Map{}
+		}
+		result[sequence.RunID][sequence.Type] = append(result[sequence.RunID][sequence.Type], fiber.Map{
+			"name":    sequence.Name,
+			"context": context,
+		})
+	}
+	return result, nil
+}
+
+// findTableSequences returns the distinct sequences of the runs, which records are kept in the provided table.
+func findTableSequences(table, sequenceType string, runIDs []string) ([]runSequence, error) {
+	var sequences []runSequence
+	if len(runIDs) == 0 {
+		return sequences, nil
+	}
+	if err := database.DB.
+		Distinct(
+			fmt.Sprintf("%s.run_uuid", table), fmt.Sprintf("'%s' AS type", sequenceType),
+			fmt.Sprintf("%s.name", table), "contexts.json AS context_json",
+		).
+		Table(table).
+		Joins(fmt.Sprintf("INNER JOIN contexts ON contexts.id = %s.context_id", table)).
+		Where(fmt.Sprintf("%s.run_uuid IN ?", table), runIDs).
+		Order("name").
+		Find(&sequences).
+		Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting %s sequences", sequenceType)
+	}
+	return sequences, nil
+}
+
+// newSequenceTraces groups the records of the run, ordered by sequence, step and index, into the traces.
+// It returns the traces along with the total and the used ranges of all of them.
+func newSequenceTraces(
+	items []sequenceItem, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) ([]fiber.Map, fiber.Map, error) {
+	var (
+		traces      []fiber.Map
+		recordTotal [2]int64
+		indexTotal  [2]int64
+	)
+	for start, end := 0, 0; start < len(items); start = end {
+		for end = start + 1; end < len(items); end++ {
+			if items[start].Name != items[end].Name || items[start].ContextID != items[end].ContextID {
+				break
+			}
+		}
+
+		trace, traceRecordTotal, traceIndexTotal, err := newSequenceTrace(
+			items[start:end], recordRange, indexRange, recordDensity, indexDensity,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(traces) == 0 {
+			recordTotal, indexTotal = traceRecordTotal, traceIndexTotal
+		} else {
+			recordTotal = [2]int64{min(recordTotal[0], traceRecordTotal[0]), max(recordTotal[1], traceRecordTotal[1])}
+			indexTotal = [2]int64{min(indexTotal[0], traceIndexTotal[0]), max(indexTotal[1], traceIndexTotal[1])}
+		}
+		traces = append(traces, trace)
+	}
+
+	recordUsed, indexUsed := recordRange.resolve(recordTotal), indexRange.resolve(indexTotal)
+	return traces, fiber.Map{
+		"record_range_total": recordTotal[:],
+		"record_range_used":  recordUsed[:],
+		"index_range_total":  indexTotal[:],
+		"index_range_used":   indexUsed[:],
+	}, nil
+}
+
+// newSequenceTrace converts the records of a single sequence, ordered by step and index, into the trace.
+// Only the records inside the requested ranges are included, sampled according to the requested densities.
+// It returns the trace along with the total ranges of the records.
+func newSequenceTrace(
+	items []sequenceItem, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) (fiber.Map, [2]int64, [2]int64, error) {
+	recordTotal := [2]int64{items[0].Step, items[len(items)-1].Step + 1}
+	indexTotal := [2]int64{0, 0}
+	for _, item := range items {
+		indexTotal[1] = max(indexTotal[1], item.Index+1)
+	}
+	recordUsed, indexUsed := recordRange.resolve(recordTotal), indexRange.resolve(indexTotal)
+
+	// group the records inside the requested ranges by step.
+	var steps [][]sequenceItem
+	for _, item := range items {
+		if item.Step < recordUsed[0] || item.Step >= recordUsed[1] ||
+			item.Index < indexUsed[0] || item.Index >= indexUsed[1] {
+			continue
+		}
+		if len(steps) == 0 || steps[len(steps)-1][0].Step != item.Step {
+			steps = append(steps, nil)
+		}
+		steps[len(steps)-1] = append(steps[len(steps)-1], item)
+	}
+
+	stepIndices := sampleIndices(len(steps), recordDensity)
+	values := make([][]any, len(stepIndices))
+	iters := make([]int64, len(stepIndices))
+	timestamps := make([]float64, len(stepIndices))
+	for i, stepIndex := range stepIndices {
+		step := steps[stepIndex]
+		itemIndices := sampleIndices(len(step), indexDensity)
+		values[i] = make([]any, len(itemIndices))
+		for j, itemIndex := range itemIndices {
+			value, err := step[itemIndex].Value()
+			if err != nil {
+				return nil, recordTotal, indexTotal, err
+			}
+			values[i][j] = value
+		}
+		iters[i] = step[0].Step
+		timestamps[i] = float64(step[0].Timestamp) / 1000
+	}
+
+	context, err := decodeContext(items[0].Context)
+	if err != nil {
+		return nil, recordTotal, indexTotal, err
+	}
+
+	return fiber.Map{
+		"name":         items[0].Name,
+		"context":      context,
+		"values":       values,
+		"iters":        iters,
+		"epochs":       iters,
+		"timestamps":   timestamps,
+		"record_range": recordUsed[:],
+		"index_range":  indexUsed[:],
+	}, recordTotal, indexTotal, nil
+}
+
+// newSequenceSearch parses the request of the search of the sequence, which records are kept in the table
+// joined to the runs by the provided join.
+func newSequenceSearch(
+	c *fiber.Ctx, sequenceType, table, join string, joinArgs ...any,
+) (*sequenceSearch, error) {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return nil, api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("search %s namespace: %s", sequenceType, ns.Code)
+
+	s := sequenceSearch{
+		sequenceType: sequenceType,
+		namespaceID:  ns.ID,
+		join:         join,
+		joinArgs:     joinArgs,
+	}
+	if err = c.QueryParser(&s.SearchSequencesRequest); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if c.Query("report_progress") == "" {
+		s.ReportProgress = true
+	}
+
+	if c.Query("record_density") == "" {
+		s.RecordDensity = 50
+	}
+
+	if c.Query("index_density") == "" {
+		s.IndexDensity = 5
+	}
+
+	if s.recordRange, err = parseSequenceRange(s.RecordRange); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("record_range: %s", err))
+	}
+
+	if s.indexRange, err = parseSequenceRange(s.IndexRange); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("index_range: %s", err))
+	}
+
+	tzOffset, err := strconv.Atoi(c.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "experiments",
+			sequenceType:  table,
+		},
+		TzOffset:  tzOffset,
+		Dialector: database.DB.Dialector.Name(),
+	}
+	if s.query, err = qp.Parse(s.Query); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// filter selects the records of the namespace runs matching the query.
+func (s *sequenceSearch) filter(tx *gorm.DB) *gorm.DB {
+	return s.query.Filter(tx.
+		Table("runs").
+		Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			s.namespaceID,
+		).
+		Joins(s.join, s.joinArgs...))
+}
+
+// findRuns returns the namespace runs having the records matching the query along with the total number of runs.
+func (s *sequenceSearch) findRuns() ([]database.Run, int64, error) {
+	var totalRuns int64
+	if tx := database.DB.Model(&database.Run{}).Count(&totalRuns); tx.Error != nil {
+		return nil, 0, fmt.Errorf("error searching run %s: %w", s.sequenceType, tx.Error)
+	}
+
+	var runs []database.Run
+	if tx := database.DB.
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID", "Name",
+			).Where(&models.Experiment{NamespaceID: s.namespaceID}),
+		).
+		Preload("Params").
+		Preload("Tags").
+		Preload("SharedTags", "NOT is_archived").
+		Where("run_uuid IN (?)", s.filter(database.DB.Select("runs.run_uuid"))).
+		Order("runs.row_num DESC").
+		Find(&runs); tx.Error != nil {
+		return nil, 0, fmt.Errorf("error searching run %s: %w", s.sequenceType, tx.Error)
+	}
+	return runs, totalRuns, nil
+}
+
+// stream streams the runs along with their traces created by newTraces.
+func (s *sequenceSearch) stream(
+	c *fiber.Ctx, runs []database.Run, totalRuns int64,
+	newTraces func(r *database.Run) ([]fiber.Map, fiber.Map, error),
+) {
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			for i, r := range runs {
+				traces, ranges, err := newTraces(&r)
+				if err != nil {
+					return err
+				}
+
+				if err := encoding.EncodeTree(w, fiber.Map{
+					r.ID: fiber.Map{
+						"ranges": ranges,
+						"params": convertRunParams(&r),
+						"props":  convertRunProps(&r),
+						"traces": traces,
+					},
+				}); err != nil {
+					return err
+				}
+
+				if s.ReportProgress {
+					if err := encoding.EncodeTree(w, fiber.Map{
+						fmt.Sprintf("progress_%d", i): []int64{totalRuns - int64(r.RowNum), totalRuns},
+					}); err != nil {
+						return err
+					}
+				}
+
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+
+			if s.ReportProgress {
+				if err := encoding.EncodeTree(w, fiber.Map{
+					fmt.Sprintf("progress_%d", len(runs)): []int64{totalRuns, totalRuns},
+				}); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+
+			return nil
+		}(); err != nil {
+			log.Errorf(
+				"Error encountered in %s %s: error streaming %s: %s", c.Method(), c.Path(), s.sequenceType, err,
+			)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+	})
+}
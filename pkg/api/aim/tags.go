@@ -1,7 +1,525 @@
 package aim
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// tagWithRunCount is a database.SharedTag together with the number of attached runs.
+type tagWithRunCount struct {
+	database.SharedTag
+	RunCount int64
+}
 
 func GetTags(c *fiber.Ctx) error {
-	return c.JSON([]string{})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTags namespace: %s", ns.Code)
+
+	tags, err := findTags(database.DB.Where("shared_tags.namespace_id = ?", ns.ID))
+	if err != nil {
+		return fmt.Errorf("error fetching tags: %w", err)
+	}
+
+	return c.JSON(tags)
+}
+
+func SearchTags(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchTags namespace: %s", ns.Code)
+
+	q := struct {
+		Query string `query:"q"`
+	}{}
+
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tags, err := findTags(database.DB.
+		Where("shared_tags.namespace_id = ?", ns.ID).
+		Where("LOWER(shared_tags.name) LIKE ?", "%"+strings.ToLower(q.Query)+"%"),
+	)
+	if err != nil {
+		return fmt.Errorf("error searching tags: %w", err)
+	}
+
+	return c.JSON(tags)
+}
+
+func CreateTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createTag namespace: %s", ns.Code)
+
+	var req request.CreateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if req.Name == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "tag name is required")
+	}
+
+	tag, err := findTagByName(ns.ID, req.Name)
+	if err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", req.Name, err),
+		)
+	}
+	if tag != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("tag %q already exists", req.Name))
+	}
+
+	tag = &database.SharedTag{
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+		NamespaceID: ns.ID,
+	}
+	if err := database.DB.
+		Create(tag).
+		Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error inserting tag: %s", err))
+	}
+
+	return c.JSON(response.CreateTag{
+		ID:     tag.ID,
+		Status: "OK",
+	})
+}
+
+func GetTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tags, err := findTags(database.DB.
+		Where("shared_tags.namespace_id = ?", ns.ID).
+		Where("shared_tags.id = ?", p.ID),
+	)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", p.ID, err))
+	}
+	if len(tags) == 0 {
+		return fiber.ErrNotFound
+	}
+
+	return c.JSON(tags[0])
+}
+
+func UpdateTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.UpdateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := findTagByID(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", p.ID, err))
+	}
+	if tag == nil {
+		return fiber.ErrNotFound
+	}
+
+	updates := map[string]any{}
+	if req.Name != nil && *req.Name != tag.Name {
+		if *req.Name == "" {
+			return fiber.NewError(fiber.StatusBadRequest, "tag name is required")
+		}
+		existing, err := findTagByName(ns.ID, *req.Name)
+		if err != nil {
+			return fiber.NewError(
+				fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", *req.Name, err),
+			)
+		}
+		if existing != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("tag %q already exists", *req.Name))
+		}
+		updates["Name"] = *req.Name
+	}
+	if req.Color != nil {
+		updates["Color"] = *req.Color
+	}
+	if req.Description != nil {
+		updates["Description"] = *req.Description
+	}
+	if req.Archived != nil {
+		updates["IsArchived"] = *req.Archived
+	}
+
+	if len(updates) > 0 {
+		if err := database.DB.
+			Model(tag).
+			Updates(updates).
+			Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error updating tag %q: %s", p.ID, err))
+		}
+	}
+
+	return c.JSON(response.CreateTag{
+		ID:     tag.ID,
+		Status: "OK",
+	})
+}
+
+func DeleteTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := findTagByID(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", p.ID, err))
+	}
+	if tag == nil {
+		return fiber.ErrNotFound
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM run_shared_tags WHERE shared_tag_id = ?", tag.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(tag).Error
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to delete tag %q: %s", p.ID, err))
+	}
+
+	return c.JSON(fiber.Map{
+		"id":     p.ID,
+		"status": "OK",
+	})
+}
+
+func GetTagRuns(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTagRuns namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := findTagByID(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", p.ID, err))
+	}
+	if tag == nil {
+		return fiber.ErrNotFound
+	}
+
+	var runs []database.Run
+	if err := database.DB.
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID", "Name",
+			).Where(
+				&models.Experiment{NamespaceID: ns.ID},
+			),
+		).
+		Joins("INNER JOIN run_shared_tags ON run_shared_tags.run_id = runs.run_uuid").
+		Where("run_shared_tags.shared_tag_id = ?", tag.ID).
+		Order("runs.row_num DESC").
+		Find(&runs).
+		Error; err != nil {
+		return fmt.Errorf("error fetching runs of tag %q: %w", p.ID, err)
+	}
+
+	resp := response.GetTagRuns{
+		ID:   tag.ID,
+		Runs: make([]response.TagRun, len(runs)),
+	}
+	for i, r := range runs {
+		resp.Runs[i] = response.TagRun{
+			ID:   r.ID,
+			Name: r.Name,
+			Experiment: response.GetRunInfoExperiment{
+				ID:   fmt.Sprintf("%d", *r.Experiment.ID),
+				Name: r.Experiment.Name,
+			},
+			CreationTime: float64(r.StartTime.Int64) / 1000,
+			EndTime:      float64(r.EndTime.Int64) / 1000,
+		}
+	}
+
+	return c.JSON(resp)
+}
+
+// AddRunTag attaches the tag with the provided name to the run. The tag is created when it doesn't exist yet.
+func AddRunTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("addRunTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.AddRunTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if req.TagName == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "tag name is required")
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	tag, err := findTagByName(ns.ID, req.TagName)
+	if err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", req.TagName, err),
+		)
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if tag == nil {
+			tag = &database.SharedTag{
+				Name:        req.TagName,
+				NamespaceID: ns.ID,
+			}
+			if err := tx.Create(tag).Error; err != nil {
+				return err
+			}
+		}
+		// the tag exists at this point, so only the join table has to be updated.
+		return tx.Model(run).Omit("SharedTags.*").Association("SharedTags").Append(tag)
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError,
+			fmt.Sprintf("unable to add tag %q to run %q: %s", req.TagName, p.ID, err),
+		)
+	}
+
+	return c.JSON(response.AddRunTag{
+		ID:     run.ID,
+		TagID:  tag.ID,
+		Status: "OK",
+	})
+}
+
+// DeleteRunTag detaches the tag from the run. The tag itself is kept.
+func DeleteRunTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteRunTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID    string    `params:"id"`
+		TagID uuid.UUID `params:"tag_id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	tag, err := findTagByID(ns.ID, p.TagID)
+	if err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", p.TagID, err),
+		)
+	}
+	if tag == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find tag %q", p.TagID))
+	}
+
+	if err := database.DB.
+		Model(run).
+		Association("SharedTags").
+		Delete(tag); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError,
+			fmt.Sprintf("unable to remove tag %q from run %q: %s", p.TagID, p.ID, err),
+		)
+	}
+
+	return c.JSON(response.DeleteRunTag{
+		ID:      run.ID,
+		Removed: true,
+		Status:  "OK",
+	})
+}
+
+// findTags returns the tags matching the query together with the number of attached runs.
+func findTags(tx *gorm.DB) (response.GetTags, error) {
+	var tags []tagWithRunCount
+	if err := tx.
+		Model(&database.SharedTag{}).
+		Select(
+			"shared_tags.*, " +
+				"(SELECT COUNT(*) FROM run_shared_tags WHERE run_shared_tags.shared_tag_id = shared_tags.id) AS run_count",
+		).
+		Order("shared_tags.name").
+		Find(&tags).
+		Error; err != nil {
+		return nil, err
+	}
+
+	resp := make(response.GetTags, len(tags))
+	for i, tag := range tags {
+		resp[i] = response.GetTag{
+			ID:          tag.ID,
+			Name:        tag.Name,
+			Color:       tag.Color,
+			Description: tag.Description,
+			Archived:    tag.IsArchived,
+			RunCount:    tag.RunCount,
+		}
+	}
+	return resp, nil
+}
+
+// findTagByID returns the tag of the namespace with the provided id or nil, when it doesn't exist.
+func findTagByID(namespaceID uint, id uuid.UUID) (*database.SharedTag, error) {
+	var tag database.SharedTag
+	if err := database.DB.
+		Where("namespace_id = ?", namespaceID).
+		Where("id = ?", id).
+		First(&tag).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// findTagByName returns the tag of the namespace with the provided name or nil, when it doesn't exist.
+func findTagByName(namespaceID uint, name string) (*database.SharedTag, error) {
+	var tag database.SharedTag
+	if err := database.DB.
+		Where("namespace_id = ?", namespaceID).
+		Where("name = ?", name).
+		First(&tag).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// findRun returns the run of the namespace with the provided id or nil, when it doesn't exist.
+func findRun(namespaceID uint, id string) (*database.Run, error) {
+	var run database.Run
+	if err := database.DB.
+		Select("runs.run_uuid", "runs.artifact_uri").
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID",
+			).Where(
+				&models.Experiment{NamespaceID: namespaceID},
+			),
+		).
+		Where("runs.run_uuid = ?", id).
+		First(&run).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &run, nil
+}
+
+// convertSharedTags converts the tags attached to a run into the run properties representation.
+// Maps are used, so that the result can be streamed with encoding.EncodeTree as well.
+func convertSharedTags(tags []database.SharedTag) []fiber.Map {
+	result := make([]fiber.Map, len(tags))
+	for i, tag := range tags {
+		result[i] = fiber.Map{
+			"id":          tag.ID.String(),
+			"name":        tag.Name,
+			"color":       tag.Color,
+			"description": tag.Description,
+		}
+	}
+	return result
 }
@@ -0,0 +1,172 @@
+package aim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// textRecord represents the stored text record along with its context.
+type textRecord struct {
+	database.Text
+	Context datatypes.JSON `gorm:"column:context_json"`
+}
+
+func LogRunTexts(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logRunTexts namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.LogRunTextsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	for _, record := range req.Records {
+		if record.Name == "" {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "record name is required")
+		}
+	}
+
+	run, err := findRun(ns.ID, p.ID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find run %q: %s", p.ID, err))
+	}
+	if run == nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run %q", p.ID))
+	}
+
+	timestamp := time.Now().UnixMilli()
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		for _, record := range req.Records {
+			context, err := getOrCreateContext(tx, record.Context)
+			if err != nil {
+				return err
+			}
+
+			text := database.Text{
+				RunID:     run.ID,
+				Name:      record.Name,
+				ContextID: context.ID,
+				Step:      record.Step,
+				Index:     record.Index,
+				Timestamp: record.Timestamp,
+				Data:      record.Data,
+			}
+			if text.Timestamp == 0 {
+				text.Timestamp = timestamp
+			}
+			// the text logged once again for the same step and index replaces the previous one.
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{
+					{Name: "run_uuid"}, {Name: "name"}, {Name: "context_id"}, {Name: "step"}, {Name: "idx"},
+				},
+				DoUpdates: clause.AssignmentColumns([]string{"timestamp", "data"}),
+			}).Create(&text).Error; err != nil {
+				return eris.Wrapf(err, "error creating record %q", record.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to log texts of run %q: %s", p.ID, err),
+		)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": "OK",
+	})
+}
+
+func SearchTexts(c *fiber.Ctx) error {
+	search, err := newSequenceSearch(
+		c, "texts", "texts", "INNER JOIN texts ON texts.run_uuid = runs.run_uuid",
+	)
+	if err != nil {
+		return err
+	}
+
+	runs, totalRuns, err := search.findRuns()
+	if err != nil {
+		return err
+	}
+
+	// texts are filtered one by one, so that the query can select them by their content.
+	var records []textRecord
+	if tx := database.DB.
+		Select("texts.*", "contexts.json AS context_json").
+		Table("texts").
+		Joins("INNER JOIN contexts ON contexts.id = texts.context_id").
+		Where("texts.id IN (?)", search.filter(database.DB.Select("texts.id"))).
+		Order("texts.run_uuid").
+		Order("texts.name").
+		Order("texts.context_id").
+		Order("texts.step").
+		Order("texts.idx").
+		Find(&records); tx.Error != nil {
+		return fmt.Errorf("error searching run texts: %w", tx.Error)
+	}
+
+	runRecords := make(map[string][]textRecord, len(runs))
+	for _, record := range records {
+		runRecords[record.RunID] = append(runRecords[record.RunID], record)
+	}
+
+	search.stream(c, runs, totalRuns, func(r *database.Run) ([]fiber.Map, fiber.Map, error) {
+		return newSequenceTraces(
+			newTextItems(runRecords[r.ID]), search.recordRange, search.indexRange,
+			search.RecordDensity, search.IndexDensity,
+		)
+	})
+
+	return nil
+}
+
+// newTextItems converts the records into the items of the sequence traces.
+func newTextItems(records []textRecord) []sequenceItem {
+	items := make([]sequenceItem, len(records))
+	for i := range records {
+		record := records[i]
+		items[i] = sequenceItem{
+			Name:      record.Name,
+			ContextID: record.ContextID,
+			Context:   record.Context,
+			Step:      record.Step,
+			Index:     record.Index,
+			Timestamp: record.Timestamp,
+			Value: func() (any, error) {
+				return fiber.Map{
+					"data":  record.Data,
+					"index": record.Index,
+				}, nil
+			},
+		}
+	}
+	return items
+}
+
+// findTextSequences returns the distinct text sequences of the runs.
+func findTextSequences(runIDs []string) ([]runSequence, error) {
+	return findTableSequences("texts", "texts", runIDs)
+}
@@ -29,3 +29,69 @@ func (r GetArtifactRequest) GetRunID() string {
 	}
 	return r.RunUUID
 }
+
+// DownloadArtifactsRequest is a request object for `GET /mlflow/artifacts/download` endpoint.
+type DownloadArtifactsRequest struct {
+	Path    string `query:"path"`
+	RunID   string `query:"run_id"`
+	RunUUID string `query:"run_uuid"`
+	Format  string `query:"format"`
+}
+
+// GetRunID returns RunID if available, otherwise RunUUID.
+func (r DownloadArtifactsRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
+// ListProxiedArtifactsRequest is a request object for `GET /mlflow-artifacts/artifacts` endpoint.
+type ListProxiedArtifactsRequest struct {
+	Path string `query:"path"`
+}
+
+// ProxiedArtifactRequest is a request object for `GET|PUT|DELETE /mlflow-artifacts/artifacts/{path}` endpoints.
+type ProxiedArtifactRequest struct {
+	Path string
+}
+
+// CreateMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/create/{path}` endpoint.
+type CreateMultipartUploadRequest struct {
+	Path     string `json:"path"`
+	NumParts int    `json:"num_parts"`
+}
+
+// UploadMultipartUploadPartRequest is a request object for `PUT /mlflow-artifacts/mpu/upload/{path}` endpoint.
+type UploadMultipartUploadPartRequest struct {
+	Path       string `query:"-"`
+	Size       int64  `query:"-"`
+	UploadID   string `query:"upload_id"`
+	PartNumber int    `query:"part_number"`
+}
+
+// ListMultipartUploadPartsRequest is a request object for `GET /mlflow-artifacts/mpu/parts/{path}` endpoint.
+type ListMultipartUploadPartsRequest struct {
+	Path     string `query:"-"`
+	UploadID string `query:"upload_id"`
+}
+
+// MultipartUploadPartPartialRequest is a partial request object for CompleteMultipartUploadRequest.
+type MultipartUploadPartPartialRequest struct {
+	PartNumber int    `json:"part_number"`
+	ETag       string `json:"etag"`
+	URL        string `json:"url"`
+}
+
+// CompleteMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/complete/{path}` endpoint.
+type CompleteMultipartUploadRequest struct {
+	Path     string                              `json:"path"`
+	UploadID string                              `json:"upload_id"`
+	Parts    []MultipartUploadPartPartialRequest `json:"parts"`
+}
+
+// AbortMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/abort/{path}` endpoint.
+type AbortMultipartUploadRequest struct {
+	Path     string `json:"path"`
+	UploadID string `json:"upload_id"`
+}
@@ -1,5 +1,13 @@
 package request
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+)
+
 type ViewType string
 
 const (
@@ -11,3 +19,23 @@ const (
 type PageToken struct {
 	Offset int32 `json:"offset"`
 }
+
+// DecodePageToken decodes offset from `page_token` request parameter.
+func DecodePageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	var token PageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	).Decode(&token); err != nil {
+		return 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	if token.Offset < 0 {
+		return 0, api.NewInvalidParameterValueError("invalid page_token '%s': negative offset", pageToken)
+	}
+	return int(token.Offset), nil
+}
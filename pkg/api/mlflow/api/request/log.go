@@ -63,3 +63,25 @@ type LogBatchRequest struct {
 	Params  []ParamPartialRequest  `json:"params,omitempty"`
 	Metrics []MetricPartialRequest `json:"metrics,omitempty"`
 }
+
+// DatasetPartialRequest is a partial request object for DatasetInputPartialRequest.
+type DatasetPartialRequest struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema"`
+	Profile    string `json:"profile"`
+}
+
+// DatasetInputPartialRequest is a partial request object for LogInputsRequest.
+type DatasetInputPartialRequest struct {
+	Tags    []TagPartialRequest   `json:"tags"`
+	Dataset DatasetPartialRequest `json:"dataset"`
+}
+
+// LogInputsRequest is a request object for `POST mlflow/runs/log-inputs` endpoint.
+type LogInputsRequest struct {
+	RunID    string                       `json:"run_id"`
+	Datasets []DatasetInputPartialRequest `json:"datasets"`
+}
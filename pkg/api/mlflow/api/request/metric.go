@@ -1,10 +1,25 @@
 package request
 
+// SamplingMethod represents the downsampling method of metric histories.
+type SamplingMethod string
+
+// Supported list of SamplingMethod.
+const (
+	SamplingMethodLTTB   SamplingMethod = "lttb"
+	SamplingMethodMinMax SamplingMethod = "minmax"
+)
+
 // GetMetricHistoryRequest is a request object for `GET /mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryRequest struct {
-	RunID     string `query:"run_id"`
-	RunUUID   string `query:"run_uuid"`
-	MetricKey string `query:"metric_key"`
+	RunID      string         `query:"run_id"`
+	RunUUID    string         `query:"run_uuid"`
+	MetricKey  string         `query:"metric_key"`
+	MaxResults int32          `query:"max_results"`
+	PageToken  string         `query:"page_token"`
+	NumPoints  int32          `query:"num_points"`
+	Sampling   SamplingMethod `query:"sampling"`
+	StartStep  *int64         `query:"start_step"`
+	EndStep    *int64         `query:"end_step"`
 }
 
 // GetRunID returns Run RunID.
@@ -17,9 +32,13 @@ func (r GetMetricHistoryRequest) GetRunID() string {
 
 // GetMetricHistoryBulkRequest is a request object for `GET /mlflow/metrics/get-history-bulk` endpoint.
 type GetMetricHistoryBulkRequest struct {
-	RunIDs     []string `query:"run_id"`
-	MetricKey  string   `query:"metric_key"`
-	MaxResults int      `query:"max_results"`
+	RunIDs     []string       `query:"run_id"`
+	MetricKey  string         `query:"metric_key"`
+	MaxResults int            `query:"max_results"`
+	NumPoints  int32          `query:"num_points"`
+	Sampling   SamplingMethod `query:"sampling"`
+	StartStep  *int64         `query:"start_step"`
+	EndStep    *int64         `query:"end_step"`
 }
 
 // GetMetricHistoriesRequest is a request object for `POST /mlflow/metrics/get-histories` endpoint.
@@ -30,4 +49,8 @@ type GetMetricHistoriesRequest struct {
 	ViewType      ViewType          `json:"run_view_type"`
 	MaxResults    int32             `json:"max_results"`
 	Context       map[string]string `json:"context"`
+	NumPoints     int32             `json:"num_points"`
+	Sampling      SamplingMethod    `json:"sampling"`
+	StartStep     *int64            `json:"start_step"`
+	EndStep       *int64            `json:"end_step"`
 }
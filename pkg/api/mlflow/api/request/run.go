@@ -20,6 +20,11 @@ func (r GetRunRequest) GetRunID() string {
 	return r.RunUUID
 }
 
+// GetRunTreeRequest is a request object for `GET /mlflow/runs/get-tree` endpoint.
+type GetRunTreeRequest struct {
+	RunID string `query:"run_id"`
+}
+
 // CreateRunRequest is a request object for `POST /mlflow/runs/create` endpoint.
 type CreateRunRequest struct {
 	ExperimentID string                 `json:"experiment_id"`
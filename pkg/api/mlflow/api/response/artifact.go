@@ -1,6 +1,13 @@
 package response
 
-import "github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
 
 // FilePartialResponse is a partial response object for different responses.
 type FilePartialResponse struct {
@@ -34,3 +41,105 @@ func NewListArtifactsResponse(
 
 	return &response
 }
+
+// ListProxiedArtifactsResponse is a response object for `GET /mlflow-artifacts/artifacts` endpoint.
+type ListProxiedArtifactsResponse struct {
+	Files []FilePartialResponse `json:"files"`
+}
+
+// NewListProxiedArtifactsResponse creates new instance of ListProxiedArtifactsResponse.
+// Unlike `artifacts/list` endpoint, MLflow expects only the base names of the listed objects here.
+func NewListProxiedArtifactsResponse(artifacts []storage.ArtifactObject) *ListProxiedArtifactsResponse {
+	response := ListProxiedArtifactsResponse{
+		Files: make([]FilePartialResponse, len(artifacts)),
+	}
+
+	for i, artifact := range artifacts {
+		response.Files[i] = FilePartialResponse{
+			Path:     filepath.Base(artifact.GetPath()),
+			IsDir:    artifact.IsDirectory(),
+			FileSize: artifact.GetSize(),
+		}
+	}
+
+	return &response
+}
+
+// MultipartUploadCredentialPartialResponse is a partial response object for CreateMultipartUploadResponse.
+type MultipartUploadCredentialPartialResponse struct {
+	PartNumber int               `json:"part_number"`
+	URL        string            `json:"url"`
+	Headers    map[string]string `json:"headers"`
+}
+
+// CreateMultipartUploadResponse is a response object for `POST /mlflow-artifacts/mpu/create/{path}` endpoint.
+type CreateMultipartUploadResponse struct {
+	UploadID    string                                     `json:"upload_id"`
+	Credentials []MultipartUploadCredentialPartialResponse `json:"credentials"`
+}
+
+// NewCreateMultipartUploadResponse creates new instance of CreateMultipartUploadResponse.
+// Parts, which storage can't provide direct url for, have to be uploaded through the `proxyURL`.
+func NewCreateMultipartUploadResponse(
+	upload *storage.MultipartUpload, proxyURL string,
+) *CreateMultipartUploadResponse {
+	response := CreateMultipartUploadResponse{
+		UploadID:    upload.UploadID,
+		Credentials: make([]MultipartUploadCredentialPartialResponse, len(upload.Credentials)),
+	}
+
+	for i, credential := range upload.Credentials {
+		response.Credentials[i] = MultipartUploadCredentialPartialResponse{
+			PartNumber: credential.PartNumber,
+			URL:        credential.URL,
+			Headers:    credential.Headers,
+		}
+		if credential.URL == "" {
+			response.Credentials[i].URL = fmt.Sprintf(
+				"%s?%s", proxyURL, url.Values{
+					"upload_id":   []string{upload.UploadID},
+					"part_number": []string{strconv.Itoa(credential.PartNumber)},
+				}.Encode(),
+			)
+		}
+		if response.Credentials[i].Headers == nil {
+			response.Credentials[i].Headers = map[string]string{}
+		}
+	}
+
+	return &response
+}
+
+// MultipartUploadPartPartialResponse is a partial response object for different multipart upload responses.
+type MultipartUploadPartPartialResponse struct {
+	PartNumber int    `json:"part_number"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+}
+
+// NewMultipartUploadPartPartialResponse creates new instance of MultipartUploadPartPartialResponse.
+func NewMultipartUploadPartPartialResponse(part *storage.MultipartUploadPart) *MultipartUploadPartPartialResponse {
+	return &MultipartUploadPartPartialResponse{
+		PartNumber: part.PartNumber,
+		ETag:       part.ETag,
+		Size:       part.Size,
+	}
+}
+
+// ListMultipartUploadPartsResponse is a response object for `GET /mlflow-artifacts/mpu/parts/{path}` endpoint.
+type ListMultipartUploadPartsResponse struct {
+	Parts []MultipartUploadPartPartialResponse `json:"parts"`
+}
+
+// NewListMultipartUploadPartsResponse creates new instance of ListMultipartUploadPartsResponse.
+func NewListMultipartUploadPartsResponse(parts []storage.MultipartUploadPart) *ListMultipartUploadPartsResponse {
+	response := ListMultipartUploadPartsResponse{
+		Parts: make([]MultipartUploadPartPartialResponse, len(parts)),
+	}
+
+	for i, part := range parts {
+		response.Parts[i] = *NewMultipartUploadPartPartialResponse(&part)
+	}
+
+	return &response
+}
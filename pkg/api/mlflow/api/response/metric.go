@@ -30,13 +30,25 @@ type MetricPartialResponse struct {
 
 // GetMetricHistoryResponse is a response object for `GET mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryResponse struct {
-	Metrics []MetricPartialResponse `json:"metrics"`
+	Metrics       []MetricPartialResponse `json:"metrics"`
+	NextPageToken string                  `json:"next_page_token,omitempty"`
 }
 
 // NewMetricHistoryResponse creates new GetMetricHistoryResponse object.
-func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryResponse, error) {
+// Zero limit means that the whole history has been requested, so there is no next page.
+func NewMetricHistoryResponse(metrics []models.Metric, limit, offset int) (*GetMetricHistoryResponse, error) {
+	hasMore := limit > 0 && len(metrics) > limit
+	token, err := newNextPageToken(hasMore, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if hasMore {
+		metrics = metrics[:limit]
+	}
+
 	resp := GetMetricHistoryResponse{
-		Metrics: make([]MetricPartialResponse, len(metrics)),
+		Metrics:       make([]MetricPartialResponse, len(metrics)),
+		NextPageToken: token,
 	}
 
 	for n, m := range metrics {
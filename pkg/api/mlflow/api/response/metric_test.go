@@ -14,6 +14,8 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 	testData := []struct {
 		name             string
 		metrics          []models.Metric
+		limit            int
+		offset           int
 		expectedResponse *GetMetricHistoryResponse
 	}{
 		{
@@ -98,11 +100,43 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithNextPage",
+			metrics: []models.Metric{
+				{
+					Key:       "key",
+					Value:     1.1,
+					Timestamp: 1234567890,
+					Step:      1,
+				},
+				{
+					Key:       "key",
+					Value:     2.2,
+					Timestamp: 1234567891,
+					Step:      2,
+				},
+			},
+			limit:  1,
+			offset: 1,
+			expectedResponse: &GetMetricHistoryResponse{
+				Metrics: []MetricPartialResponse{
+					{
+						Key:       "key",
+						Timestamp: 1234567890,
+						Step:      1,
+						Value:     1.1,
+						Context:   map[string]any{},
+					},
+				},
+				// {"offset":2}
+				NextPageToken: "eyJvZmZzZXQiOjJ9",
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse, err := NewMetricHistoryResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryResponse(tt.metrics, tt.limit, tt.offset)
 			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
@@ -0,0 +1,120 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelVersionTagPartialResponse is a partial response object for different responses.
+type ModelVersionTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ModelVersionPartialResponse is a partial response object for different responses.
+type ModelVersionPartialResponse struct {
+	Name                 string                           `json:"name"`
+	Version              string                           `json:"version"`
+	CreationTimestamp    int64                            `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                            `json:"last_updated_timestamp"`
+	UserID               string                           `json:"user_id,omitempty"`
+	CurrentStage         string                           `json:"current_stage"`
+	Description          string                           `json:"description,omitempty"`
+	Source               string                           `json:"source"`
+	RunID                string                           `json:"run_id,omitempty"`
+	Status               string                           `json:"status"`
+	StatusMessage        string                           `json:"status_message,omitempty"`
+	Tags                 []ModelVersionTagPartialResponse `json:"tags,omitempty"`
+	RunLink              string                           `json:"run_link,omitempty"`
+	Aliases              []string                         `json:"aliases,omitempty"`
+}
+
+// ModelVersionResponse is a response object for `POST /mlflow/model-versions/create`,
+// `GET /mlflow/model-versions/get`, `PATCH /mlflow/model-versions/update`,
+// `POST /mlflow/model-versions/transition-stage` and `GET /mlflow/registered-models/alias` endpoints.
+type ModelVersionResponse struct {
+	ModelVersion *ModelVersionPartialResponse `json:"model_version"`
+}
+
+// NewModelVersionResponse creates new ModelVersionResponse object.
+func NewModelVersionResponse(
+	registeredModel *models.RegisteredModel, modelVersion *models.ModelVersion,
+) *ModelVersionResponse {
+	return &ModelVersionResponse{
+		ModelVersion: NewModelVersionPartialResponse(registeredModel, modelVersion),
+	}
+}
+
+// SearchModelVersionsResponse is a response object for `GET /mlflow/model-versions/search` endpoint.
+type SearchModelVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+	NextPageToken string                         `json:"next_page_token,omitempty"`
+}
+
+// NewSearchModelVersionsResponse creates new SearchModelVersionsResponse object.
+func NewSearchModelVersionsResponse(
+	modelVersions []models.ModelVersion, limit, offset int,
+) (*SearchModelVersionsResponse, error) {
+	token, err := newNextPageToken(len(modelVersions) > limit, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(modelVersions) > limit {
+		modelVersions = modelVersions[:limit]
+	}
+
+	resp := SearchModelVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, len(modelVersions)),
+		NextPageToken: token,
+	}
+	for n, modelVersion := range modelVersions {
+		//nolint:gosec
+		resp.ModelVersions[n] = NewModelVersionPartialResponse(&modelVersion.RegisteredModel, &modelVersion)
+	}
+	return &resp, nil
+}
+
+// GetModelVersionDownloadURIResponse is a response object for
+// `GET /mlflow/model-versions/get-download-uri` endpoint.
+type GetModelVersionDownloadURIResponse struct {
+	ArtifactURI string `json:"artifact_uri"`
+}
+
+// NewGetModelVersionDownloadURIResponse creates new GetModelVersionDownloadURIResponse object.
+func NewGetModelVersionDownloadURIResponse(modelVersion *models.ModelVersion) *GetModelVersionDownloadURIResponse {
+	return &GetModelVersionDownloadURIResponse{
+		ArtifactURI: modelVersion.Source,
+	}
+}
+
+// NewModelVersionPartialResponse is a helper function to build ModelVersionPartialResponse,
+// which is a part of the most of registry responses.
+func NewModelVersionPartialResponse(
+	registeredModel *models.RegisteredModel, modelVersion *models.ModelVersion,
+) *ModelVersionPartialResponse {
+	var tags []ModelVersionTagPartialResponse
+	for _, tag := range modelVersion.Tags {
+		tags = append(tags, ModelVersionTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+
+	return &ModelVersionPartialResponse{
+		Name:                 registeredModel.Name,
+		Version:              fmt.Sprint(modelVersion.Version),
+		CreationTimestamp:    modelVersion.CreationTime.Int64,
+		LastUpdatedTimestamp: modelVersion.LastUpdatedTime.Int64,
+		UserID:               modelVersion.UserID,
+		CurrentStage:         string(modelVersion.CurrentStage),
+		Description:          modelVersion.Description,
+		Source:               modelVersion.Source,
+		RunID:                modelVersion.RunID,
+		Status:               modelVersion.Status,
+		StatusMessage:        modelVersion.StatusMessage,
+		Tags:                 tags,
+		RunLink:              modelVersion.RunLink,
+		Aliases:              registeredModel.VersionAliases(modelVersion.Version),
+	}
+}
@@ -0,0 +1,150 @@
+package response
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// RegisteredModelTagPartialResponse is a partial response object for different responses.
+type RegisteredModelTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisteredModelAliasPartialResponse is a partial response object for different responses.
+type RegisteredModelAliasPartialResponse struct {
+	Alias   string `json:"alias"`
+	Version string `json:"version"`
+}
+
+// RegisteredModelPartialResponse is a partial response object for different responses.
+type RegisteredModelPartialResponse struct {
+	Name                 string                                `json:"name"`
+	CreationTimestamp    int64                                 `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                                 `json:"last_updated_timestamp"`
+	Description          string                                `json:"description,omitempty"`
+	LatestVersions       []*ModelVersionPartialResponse        `json:"latest_versions,omitempty"`
+	Tags                 []RegisteredModelTagPartialResponse   `json:"tags,omitempty"`
+	Aliases              []RegisteredModelAliasPartialResponse `json:"aliases,omitempty"`
+}
+
+// RegisteredModelResponse is a response object for `POST /mlflow/registered-models/create`,
+// `GET /mlflow/registered-models/get`, `POST /mlflow/registered-models/rename` and
+// `PATCH /mlflow/registered-models/update` endpoints.
+type RegisteredModelResponse struct {
+	RegisteredModel *RegisteredModelPartialResponse `json:"registered_model"`
+}
+
+// NewRegisteredModelResponse creates new RegisteredModelResponse object.
+func NewRegisteredModelResponse(registeredModel *models.RegisteredModel) *RegisteredModelResponse {
+	return &RegisteredModelResponse{
+		RegisteredModel: NewRegisteredModelPartialResponse(registeredModel),
+	}
+}
+
+// SearchRegisteredModelsResponse is a response object for `GET /mlflow/registered-models/search` endpoint.
+type SearchRegisteredModelsResponse struct {
+	RegisteredModels []*RegisteredModelPartialResponse `json:"registered_models"`
+	NextPageToken    string                            `json:"next_page_token,omitempty"`
+}
+
+// NewSearchRegisteredModelsResponse creates new SearchRegisteredModelsResponse object.
+func NewSearchRegisteredModelsResponse(
+	registeredModels []models.RegisteredModel, limit, offset int,
+) (*SearchRegisteredModelsResponse, error) {
+	token, err := newNextPageToken(len(registeredModels) > limit, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(registeredModels) > limit {
+		registeredModels = registeredModels[:limit]
+	}
+
+	resp := SearchRegisteredModelsResponse{
+		RegisteredModels: make([]*RegisteredModelPartialResponse, len(registeredModels)),
+		NextPageToken:    token,
+	}
+	for n, registeredModel := range registeredModels {
+		//nolint:gosec
+		resp.RegisteredModels[n] = NewRegisteredModelPartialResponse(&registeredModel)
+	}
+	return &resp, nil
+}
+
+// GetLatestVersionsResponse is a response object for `POST /mlflow/registered-models/get-latest-versions` endpoint.
+type GetLatestVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+}
+
+// NewGetLatestVersionsResponse creates new GetLatestVersionsResponse object.
+func NewGetLatestVersionsResponse(
+	registeredModel *models.RegisteredModel, modelVersions []models.ModelVersion,
+) *GetLatestVersionsResponse {
+	resp := GetLatestVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, len(modelVersions)),
+	}
+	for n, modelVersion := range modelVersions {
+		//nolint:gosec
+		resp.ModelVersions[n] = NewModelVersionPartialResponse(registeredModel, &modelVersion)
+	}
+	return &resp
+}
+
+// NewRegisteredModelPartialResponse is a helper function to build RegisteredModelPartialResponse,
+// which is shared between the most of registered model responses.
+func NewRegisteredModelPartialResponse(registeredModel *models.RegisteredModel) *RegisteredModelPartialResponse {
+	var tags []RegisteredModelTagPartialResponse
+	for _, tag := range registeredModel.Tags {
+		tags = append(tags, RegisteredModelTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+
+	var aliases []RegisteredModelAliasPartialResponse
+	for _, alias := range registeredModel.Aliases {
+		aliases = append(aliases, RegisteredModelAliasPartialResponse{
+			Alias:   alias.Alias,
+			Version: fmt.Sprint(alias.Version),
+		})
+	}
+
+	var latestVersions []*ModelVersionPartialResponse
+	for _, modelVersion := range registeredModel.LatestVersions() {
+		//nolint:gosec
+		latestVersions = append(latestVersions, NewModelVersionPartialResponse(registeredModel, &modelVersion))
+	}
+
+	return &RegisteredModelPartialResponse{
+		Name:                 registeredModel.Name,
+		CreationTimestamp:    registeredModel.CreationTime.Int64,
+		LastUpdatedTimestamp: registeredModel.LastUpdatedTime.Int64,
+		Description:          registeredModel.Description,
+		LatestVersions:       latestVersions,
+		Tags:                 tags,
+		Aliases:              aliases,
+	}
+}
+
+// newNextPageToken encodes `nextPageToken` value, when there are more results to fetch.
+func newNextPageToken(hasMore bool, limit, offset int) (string, error) {
+	if !hasMore {
+		return "", nil
+	}
+	var token strings.Builder
+	if err := json.NewEncoder(
+		base64.NewEncoder(base64.StdEncoding, &token),
+	).Encode(request.PageToken{
+		Offset: int32(offset + limit),
+	}); err != nil {
+		return "", eris.Wrap(err, "error encoding 'nextPageToken' value")
+	}
+	return token.String(), nil
+}
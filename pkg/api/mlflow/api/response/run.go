@@ -27,10 +27,20 @@ type RunParamPartialResponse struct {
 
 // RunMetricPartialResponse is a partial response object for different responses.
 type RunMetricPartialResponse struct {
-	Key       string `json:"key"`
-	Value     any    `json:"value"`
-	Timestamp int64  `json:"timestamp"`
-	Step      int64  `json:"step"`
+	Key       string                           `json:"key"`
+	Value     any                              `json:"value"`
+	Timestamp int64                            `json:"timestamp"`
+	Step      int64                            `json:"step"`
+	Summary   *RunMetricSummaryPartialResponse `json:"summary,omitempty"`
+}
+
+// RunMetricSummaryPartialResponse is a partial response object for RunMetricPartialResponse.
+// Min and Max are empty, when only NaN values were logged.
+type RunMetricSummaryPartialResponse struct {
+	First any      `json:"first"`
+	Min   *float64 `json:"min"`
+	Max   *float64 `json:"max"`
+	Count int64    `json:"count"`
 }
 
 // RunDataPartialResponse is a partial response object for different responses.
@@ -54,10 +64,32 @@ type RunInfoPartialResponse struct {
 	LifecycleStage string `json:"lifecycle_stage"`
 }
 
+// DatasetPartialResponse is a partial response object for different responses.
+type DatasetPartialResponse struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema,omitempty"`
+	Profile    string `json:"profile,omitempty"`
+}
+
+// DatasetInputPartialResponse is a partial response object for different responses.
+type DatasetInputPartialResponse struct {
+	Tags    []RunTagPartialResponse `json:"tags,omitempty"`
+	Dataset DatasetPartialResponse  `json:"dataset"`
+}
+
+// RunInputsPartialResponse is a partial response object for different responses.
+type RunInputsPartialResponse struct {
+	DatasetInputs []DatasetInputPartialResponse `json:"dataset_inputs,omitempty"`
+}
+
 // RunPartialResponse is a partial response object for different responses.
 type RunPartialResponse struct {
-	Info RunInfoPartialResponse `json:"info"`
-	Data RunDataPartialResponse `json:"data"`
+	Info   RunInfoPartialResponse   `json:"info"`
+	Data   RunDataPartialResponse   `json:"data"`
+	Inputs RunInputsPartialResponse `json:"inputs"`
 }
 
 // CreateRunResponse is a response object for `POST mlflow/runs/create` endpoint.
@@ -130,6 +162,71 @@ func NewGetRunResponse(run *models.Run) *GetRunResponse {
 	}
 }
 
+// RunTreeNodePartialResponse is a partial response object for GetRunTreeResponse.
+type RunTreeNodePartialResponse struct {
+	Info              RunInfoPartialResponse       `json:"info"`
+	ChildStatusCounts map[string]int               `json:"child_status_counts,omitempty"`
+	Children          []RunTreeNodePartialResponse `json:"children,omitempty"`
+}
+
+// GetRunTreeResponse is a response object for `GET mlflow/runs/get-tree` endpoint.
+type GetRunTreeResponse struct {
+	Run RunTreeNodePartialResponse `json:"run"`
+}
+
+// NewGetRunTreeResponse creates new GetRunTreeResponse object.
+// descendants are attached to their parents using `mlflow.parentRunId` tag.
+func NewGetRunTreeResponse(run *models.Run, descendants []models.Run) *GetRunTreeResponse {
+	children := map[string][]*models.Run{}
+	for i := range descendants {
+		for _, tag := range descendants[i].Tags {
+			if tag.Key == common.ParentRunIDTagKey {
+				children[tag.Value] = append(children[tag.Value], &descendants[i])
+				break
+			}
+		}
+	}
+	return &GetRunTreeResponse{
+		Run: newRunTreeNodePartialResponse(run, children, map[string]struct{}{}),
+	}
+}
+
+// newRunTreeNodePartialResponse recursively builds the tree node of the run and counts statuses of all its descendants.
+func newRunTreeNodePartialResponse(
+	run *models.Run, children map[string][]*models.Run, visited map[string]struct{},
+) RunTreeNodePartialResponse {
+	visited[run.ID] = struct{}{}
+	node := RunTreeNodePartialResponse{
+		Info: RunInfoPartialResponse{
+			ID:             run.ID,
+			UUID:           run.ID,
+			Name:           run.Name,
+			ExperimentID:   fmt.Sprint(run.ExperimentID),
+			UserID:         run.UserID,
+			Status:         string(run.Status),
+			StartTime:      run.StartTime.Int64,
+			EndTime:        run.EndTime.Int64,
+			ArtifactURI:    run.ArtifactURI,
+			LifecycleStage: string(run.LifecycleStage),
+		},
+	}
+	for _, child := range children[run.ID] {
+		if _, ok := visited[child.ID]; ok {
+			continue
+		}
+		childNode := newRunTreeNodePartialResponse(child, children, visited)
+		if node.ChildStatusCounts == nil {
+			node.ChildStatusCounts = map[string]int{}
+		}
+		node.ChildStatusCounts[childNode.Info.Status]++
+		for status, count := range childNode.ChildStatusCounts {
+			node.ChildStatusCounts[status] += count
+		}
+		node.Children = append(node.Children, childNode)
+	}
+	return node
+}
+
 // SearchRunsResponse is a response object for `POST mlflow/runs/search` endpoint.
 type SearchRunsResponse struct {
 	Runs          []*RunPartialResponse `json:"runs"`
@@ -178,6 +275,17 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 		if m.IsNan {
 			metrics[n].Value = common.NANValue
 		}
+		if m.Count > 0 {
+			metrics[n].Summary = &RunMetricSummaryPartialResponse{
+				First: m.FirstValue,
+				Min:   m.MinValue,
+				Max:   m.MaxValue,
+				Count: m.Count,
+			}
+			if m.FirstIsNan {
+				metrics[n].Summary.First = common.NANValue
+			}
+		}
 	}
 
 	params := make([]RunParamPartialResponse, len(run.Params))
@@ -202,6 +310,27 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 		}
 	}
 
+	var datasetInputs []DatasetInputPartialResponse
+	for _, input := range run.Inputs {
+		datasetInput := DatasetInputPartialResponse{
+			Dataset: DatasetPartialResponse{
+				Name:       input.Dataset.Name,
+				Digest:     input.Dataset.Digest,
+				SourceType: input.Dataset.SourceType,
+				Source:     input.Dataset.Source,
+				Schema:     input.Dataset.Schema,
+				Profile:    input.Dataset.Profile,
+			},
+		}
+		for _, tag := range input.Tags {
+			datasetInput.Tags = append(datasetInput.Tags, RunTagPartialResponse{
+				Key:   tag.Key,
+				Value: tag.Value,
+			})
+		}
+		datasetInputs = append(datasetInputs, datasetInput)
+	}
+
 	return &RunPartialResponse{
 		Info: RunInfoPartialResponse{
 			ID:             run.ID,
@@ -220,5 +349,8 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 			Params:  params,
 			Tags:    tags,
 		},
+		Inputs: RunInputsPartialResponse{
+			DatasetInputs: datasetInputs,
+		},
 	}
 }
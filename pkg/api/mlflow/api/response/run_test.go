@@ -138,13 +138,18 @@ func TestNewRunPartialResponse(t *testing.T) {
 				},
 				LatestMetrics: []models.LatestMetric{
 					{
-						Key:       "Key",
-						Value:     123,
-						Timestamp: 1234567890,
-						Step:      1,
-						IsNan:     false,
-						RunID:     "",
-						LastIter:  0,
+						Key:        "Key",
+						Value:      123,
+						Timestamp:  1234567890,
+						Step:       1,
+						IsNan:      false,
+						RunID:      "",
+						LastIter:   0,
+						MinValue:   common.GetPointer(-1.5),
+						MaxValue:   common.GetPointer(123.0),
+						FirstValue: 0,
+						FirstIsNan: true,
+						Count:      3,
 					},
 				},
 			},
@@ -168,6 +173,12 @@ func TestNewRunPartialResponse(t *testing.T) {
 							Value:     float64(123),
 							Timestamp: 1234567890,
 							Step:      1,
+							Summary: &RunMetricSummaryPartialResponse{
+								First: common.NANValue,
+								Min:   common.GetPointer(-1.5),
+								Max:   common.GetPointer(123.0),
+								Count: 3,
+							},
 						},
 					},
 					Params: []RunParamPartialResponse{{
@@ -246,3 +257,46 @@ func TestNewRunPartialResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestNewGetRunTreeResponse(t *testing.T) {
+	parentTag := func(id string) []models.Tag {
+		return []models.Tag{{Key: "key", Value: "value"}, {Key: common.ParentRunIDTagKey, Value: id}}
+	}
+	resp := NewGetRunTreeResponse(
+		&models.Run{ID: "root", Name: "root", Status: models.StatusRunning, ExperimentID: 1},
+		[]models.Run{
+			{ID: "child1", Status: models.StatusFinished, ExperimentID: 1, Tags: parentTag("root")},
+			{ID: "child2", Status: models.StatusRunning, ExperimentID: 1, Tags: parentTag("root")},
+			{ID: "grandchild", Status: models.StatusFailed, ExperimentID: 1, Tags: parentTag("child1")},
+		},
+	)
+
+	assert.Equal(t, &GetRunTreeResponse{
+		Run: RunTreeNodePartialResponse{
+			Info: RunInfoPartialResponse{
+				ID: "root", UUID: "root", Name: "root", ExperimentID: "1", Status: "RUNNING",
+			},
+			ChildStatusCounts: map[string]int{"FINISHED": 1, "RUNNING": 1, "FAILED": 1},
+			Children: []RunTreeNodePartialResponse{
+				{
+					Info: RunInfoPartialResponse{
+						ID: "child1", UUID: "child1", ExperimentID: "1", Status: "FINISHED",
+					},
+					ChildStatusCounts: map[string]int{"FAILED": 1},
+					Children: []RunTreeNodePartialResponse{
+						{
+							Info: RunInfoPartialResponse{
+								ID: "grandchild", UUID: "grandchild", ExperimentID: "1", Status: "FAILED",
+							},
+						},
+					},
+				},
+				{
+					Info: RunInfoPartialResponse{
+						ID: "child2", UUID: "child2", ExperimentID: "1", Status: "RUNNING",
+					},
+				},
+			},
+		},
+	}, resp)
+}
@@ -11,3 +11,17 @@ const (
 const (
 	DescriptionTagKey = "mlflow.note.content"
 )
+
+// Constants for run tags keys.
+const (
+	ParentRunIDTagKey = "mlflow.parentRunId"
+)
+
+// Constants for the types of the run param values.
+const (
+	ParamTypeInt    = "int"
+	ParamTypeFloat  = "float"
+	ParamTypeBool   = "bool"
+	ParamTypeString = "str"
+	ParamTypeJSON   = "json"
+)
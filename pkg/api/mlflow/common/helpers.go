@@ -1,9 +1,12 @@
 package common
 
 import (
+	"encoding/json"
+	"math"
 	"mime"
 	"path"
 	"slices"
+	"strconv"
 )
 
 // textTypes used by GetContentType.
@@ -52,3 +55,24 @@ func GetContentType(filename string) string {
 	}
 	return "application/octet-stream"
 }
+
+// InferParamType infers the type of the run param value, which is always logged as a string.
+// The numeric value is returned for the int, float and bool values to compare them as numbers.
+func InferParamType(value string) (string, *float64) {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ParamTypeInt, GetPointer(float64(i))
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return ParamTypeFloat, GetPointer(f)
+	}
+	switch value {
+	case "true", "True":
+		return ParamTypeBool, GetPointer(1.0)
+	case "false", "False":
+		return ParamTypeBool, GetPointer(0.0)
+	}
+	if len(value) > 0 && (value[0] == '{' || value[0] == '[') && json.Valid([]byte(value)) {
+		return ParamTypeJSON, nil
+	}
+	return ParamTypeString, nil
+}
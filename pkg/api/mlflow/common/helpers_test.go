@@ -39,3 +39,29 @@ func TestGetContentType(t *testing.T) {
 		assert.Equal(t, tt.expected, result, "Unexpected content type for filename: %s", tt.filename)
 	}
 }
+
+func TestInferParamType(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		valueType    string
+		valueNumeric *float64
+	}{
+		{name: "Int", value: "-42", valueType: ParamTypeInt, valueNumeric: GetPointer(-42.0)},
+		{name: "Float", value: "1e-3", valueType: ParamTypeFloat, valueNumeric: GetPointer(0.001)},
+		{name: "NaN", value: "nan", valueType: ParamTypeString},
+		{name: "Bool", value: "True", valueType: ParamTypeBool, valueNumeric: GetPointer(1.0)},
+		{name: "JSON", value: `{"layers": [1, 2]}`, valueType: ParamTypeJSON},
+		{name: "InvalidJSON", value: `[1, 2`, valueType: ParamTypeString},
+		{name: "String", value: "adam", valueType: ParamTypeString},
+		{name: "Empty", value: "", valueType: ParamTypeString},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valueType, valueNumeric := InferParamType(tt.value)
+			assert.Equal(t, tt.valueType, valueType)
+			assert.Equal(t, tt.valueNumeric, valueNumeric)
+		})
+	}
+}
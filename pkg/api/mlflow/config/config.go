@@ -5,12 +5,25 @@ import (
 	"path"
 	"path/filepath"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/spf13/viper"
 )
 
+// ProxiedArtifactsScheme is the scheme of artifact locations, which are served by the `mlflow-artifacts` proxy.
+const ProxiedArtifactsScheme = "mlflow-artifacts"
+
+// maxPresignedURLExpiration is the longest lifetime of presigned urls, which is accepted by S3 and Google Storage.
+const maxPresignedURLExpiration = 7 * 24 * time.Hour
+
+// supportedArtifactSchemes are the schemes of artifact locations, which are backed by the artifact storages.
+var supportedArtifactSchemes = []string{"", "file", "s3", "gs", "wasbs", "az"}
+
+// azureArtifactSchemes are the schemes, which keep the container name in the user part of the artifact location.
+var azureArtifactSchemes = []string{"wasbs", "az"}
+
 // ServiceConfig represents main service configuration.
 type ServiceConfig struct {
 	DevMode               bool
@@ -18,13 +31,25 @@ type ServiceConfig struct {
 	AuthUsername          string
 	AuthPassword          string
 	DefaultArtifactRoot   string
+	ServeArtifacts        bool
+	ArtifactsDestination  string
+	PresignedRedirect     bool
+	PresignedURLExpiry    time.Duration
+	ArchiveMaxSize        int64
+	ArtifactsCacheDir     string
+	ArtifactsCacheMaxSize int64
 	S3EndpointURI         string
 	GSEndpointURI         string
+	AzureEndpointURI      string
+	AzureConnectionString string
+	AzureSASToken         string
 	DatabaseURI           string
 	DatabaseReset         bool
 	DatabasePoolMax       int
 	DatabaseMigrate       bool
 	DatabaseSlowThreshold time.Duration
+	GCInterval            time.Duration
+	GCRetention           time.Duration
 }
 
 // NewServiceConfig creates new instance of ServiceConfig.
@@ -35,13 +60,25 @@ func NewServiceConfig() *ServiceConfig {
 		AuthUsername:          viper.GetString("auth-username"),
 		AuthPassword:          viper.GetString("auth-password"),
 		DefaultArtifactRoot:   viper.GetString("default-artifact-root"),
+		ServeArtifacts:        viper.GetBool("serve-artifacts"),
+		ArtifactsDestination:  viper.GetString("artifacts-destination"),
+		PresignedRedirect:     viper.GetBool("artifacts-presigned-redirect"),
+		PresignedURLExpiry:    viper.GetDuration("artifacts-presigned-url-expiry"),
+		ArchiveMaxSize:        viper.GetInt64("artifacts-archive-max-size"),
+		ArtifactsCacheDir:     viper.GetString("artifacts-cache-dir"),
+		ArtifactsCacheMaxSize: viper.GetInt64("artifacts-cache-max-size"),
 		S3EndpointURI:         viper.GetString("s3-endpoint-uri"),
 		GSEndpointURI:         viper.GetString("gs-endpoint-uri"),
+		AzureEndpointURI:      viper.GetString("azure-endpoint-uri"),
+		AzureConnectionString: viper.GetString("azure-storage-connection-string"),
+		AzureSASToken:         viper.GetString("azure-storage-sas-token"),
 		DatabaseURI:           viper.GetString("database-uri"),
 		DatabaseReset:         viper.GetBool("database-reset"),
 		DatabasePoolMax:       viper.GetInt("database-pool-max"),
 		DatabaseMigrate:       viper.GetBool("database-migrate"),
 		DatabaseSlowThreshold: viper.GetDuration("database-slow-threshold"),
+		GCInterval:            viper.GetDuration("gc-interval"),
+		GCRetention:           viper.GetDuration("gc-retention"),
 	}
 }
 
@@ -64,32 +101,112 @@ func (c *ServiceConfig) validateConfiguration() error {
 		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
 	}
 
-	if parsed.User != nil || parsed.RawQuery != "" || parsed.RawFragment != "" {
+	if !isValidArtifactRoot(parsed) {
 		return eris.New("incorrect format of 'default-artifact-root' flag")
 	}
 
-	if !slices.Contains([]string{"", "file", "s3", "gs"}, parsed.Scheme) {
+	supportedSchemes := slices.Clone(supportedArtifactSchemes)
+	if c.ServeArtifacts {
+		supportedSchemes = append(supportedSchemes, ProxiedArtifactsScheme)
+	}
+	if !slices.Contains(supportedSchemes, parsed.Scheme) {
 		return eris.New("unsupported schema of 'default-artifact-root' flag")
 	}
 
+	// 2. validate ArtifactsDestination configuration parameter, which is used only to serve artifacts.
+	if c.ServeArtifacts {
+		parsed, err := url.Parse(c.ArtifactsDestination)
+		if err != nil {
+			return eris.Wrap(err, "error parsing 'artifacts-destination' flag")
+		}
+
+		if !isValidArtifactRoot(parsed) {
+			return eris.New("incorrect format of 'artifacts-destination' flag")
+		}
+
+		if !slices.Contains(supportedArtifactSchemes, parsed.Scheme) {
+			return eris.New("unsupported schema of 'artifacts-destination' flag")
+		}
+	}
+
+	// 3. validate PresignedURLExpiry configuration parameter, which is used only to redirect downloads.
+	if c.PresignedRedirect && (c.PresignedURLExpiry <= 0 || c.PresignedURLExpiry > maxPresignedURLExpiration) {
+		return eris.Errorf(
+			"'artifacts-presigned-url-expiry' flag has to be positive and not longer than %s", maxPresignedURLExpiration,
+		)
+	}
+
+	// 4. validate ArchiveMaxSize configuration parameter, zero value disables the limit.
+	if c.ArchiveMaxSize < 0 {
+		return eris.New("'artifacts-archive-max-size' flag can't be negative")
+	}
+
+	// 5. validate ArtifactsCacheMaxSize configuration parameter, which is used only when the cache is enabled.
+	if c.ArtifactsCacheDir != "" && c.ArtifactsCacheMaxSize <= 0 {
+		return eris.New("'artifacts-cache-max-size' flag has to be positive")
+	}
+
 	return nil
 }
 
+// isValidArtifactRoot checks that artifact root contains neither query nor fragment.
+// User part is allowed only for Azure, where it holds the container name, e.g. wasbs://container@account/path.
+func isValidArtifactRoot(parsed *url.URL) bool {
+	if parsed.User != nil {
+		if _, ok := parsed.User.Password(); ok || !slices.Contains(azureArtifactSchemes, parsed.Scheme) {
+			return false
+		}
+	}
+	return parsed.RawQuery == "" && parsed.RawFragment == ""
+}
+
+// ResolveArtifactURI converts `mlflow-artifacts:/path` uri into actual uri inside the `artifacts-destination`.
+// Any other uri is returned untouched.
+func (c *ServiceConfig) ResolveArtifactURI(artifactURI string) (string, error) {
+	if !strings.HasPrefix(artifactURI, ProxiedArtifactsScheme+":") {
+		return artifactURI, nil
+	}
+	if !c.ServeArtifacts {
+		return "", eris.New("artifacts serving is disabled")
+	}
+	u, err := url.Parse(artifactURI)
+	if err != nil {
+		return "", err
+	}
+	return url.JoinPath(c.ArtifactsDestination, u.Path)
+}
+
 // normalizeConfiguration normalizes service configuration parameters.
 func (c *ServiceConfig) normalizeConfiguration() error {
-	parsed, err := url.Parse(c.DefaultArtifactRoot)
+	defaultArtifactRoot, err := normalizeArtifactRoot(c.DefaultArtifactRoot)
 	if err != nil {
-		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
+		return eris.Wrap(err, "error normalizing 'default-artifact-root' flag")
+	}
+	c.DefaultArtifactRoot = defaultArtifactRoot
+
+	if c.ServeArtifacts {
+		artifactsDestination, err := normalizeArtifactRoot(c.ArtifactsDestination)
+		if err != nil {
+			return eris.Wrap(err, "error normalizing 'artifacts-destination' flag")
+		}
+		c.ArtifactsDestination = artifactsDestination
+	}
+	return nil
+}
+
+// normalizeArtifactRoot converts local artifact root into absolute path and keeps remote ones untouched.
+func normalizeArtifactRoot(artifactRoot string) (string, error) {
+	parsed, err := url.Parse(artifactRoot)
+	if err != nil {
+		return "", eris.Wrapf(err, "error parsing artifact root: %s", artifactRoot)
 	}
 	switch parsed.Scheme {
-	case "s3", "gs":
-		return nil
 	case "", "file":
 		absoluteArtifactRoot, err := filepath.Abs(path.Join(parsed.Host, parsed.Path))
 		if err != nil {
-			return eris.Wrapf(err, "error getting absolute path for 'default-artifact-root': %s", c.DefaultArtifactRoot)
+			return "", eris.Wrapf(err, "error getting absolute path for artifact root: %s", artifactRoot)
 		}
-		c.DefaultArtifactRoot = absoluteArtifactRoot
+		return absoluteArtifactRoot, nil
 	}
-	return nil
+	return artifactRoot, nil
 }
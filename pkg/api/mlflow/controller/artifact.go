@@ -2,9 +2,15 @@ package controller
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -15,6 +21,8 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 )
 
@@ -60,35 +68,452 @@ func (c Controller) GetArtifact(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	if artifact.PresignedURL != "" {
+		return ctx.Redirect(artifact.PresignedURL, fiber.StatusFound)
+	}
 
-	filename := filepath.Base(req.Path)
-	ctx.Set("Content-Type", common.GetContentType(filename))
-	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
-	ctx.Set("X-Content-Type-Options", "nosniff")
-	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
-		//nolint:errcheck
-		defer artifact.Close()
+	return serveArtifact(ctx, req.Path, artifact)
+}
+
+// DownloadArtifacts handles `GET /artifacts/download` endpoint.
+func (c Controller) DownloadArtifacts(ctx *fiber.Ctx) error {
+	req := request.DownloadArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("downloadArtifacts request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("downloadArtifacts namespace: %s", ns.Code)
 
+	archive, err := c.artifactService.DownloadArtifacts(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, archive.ContentType())
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s", archive.Name))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// archive is written on the fly, so neither its size is known nor the errors can be reported to the client.
+	// request context must not be used by the stream writer, that's why everything needed is copied upfront.
+	method, path := strings.Clone(ctx.Method()), strings.Clone(ctx.Path())
+	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		start := time.Now()
-		if err := func() error {
-			bytesWritten, err := io.CopyBuffer(w, artifact, make([]byte, 4096))
-			if err != nil {
-				return eris.Wrap(err, "error copying artifact Reader to output stream")
-			}
-			if err := w.Flush(); err != nil {
-				return eris.Wrap(err, "error flushing output stream")
-			}
-			log.Debugf("GetArtifact wrote bytes to output stream: %d", bytesWritten)
-			return nil
-		}(); err != nil {
-			log.Errorf(
-				"error encountered in %s %s: error streaming artifact: %s",
-				ctx.Method(),
-				ctx.Path(),
-				err,
-			)
+		if err := archive.Write(context.Background(), w); err != nil {
+			log.Errorf("error encountered in %s %s: error streaming artifact archive: %s", method, path, err)
+		} else if err := w.Flush(); err != nil {
+			log.Errorf("error encountered in %s %s: error flushing output stream: %s", method, path, err)
 		}
-		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+		log.Infof("body - %s %s %s", time.Since(start), method, path)
 	})
 	return nil
 }
+
+// ListProxiedArtifacts handles `GET /mlflow-artifacts/artifacts` endpoint.
+func (c Controller) ListProxiedArtifacts(ctx *fiber.Ctx) error {
+	req := request.ListProxiedArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("listProxiedArtifacts request: %#v", req)
+
+	artifacts, err := c.artifactService.ListProxiedArtifacts(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListProxiedArtifactsResponse(artifacts)
+	log.Debugf("listProxiedArtifacts response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DownloadProxiedArtifact handles `GET /mlflow-artifacts/artifacts/{path}` endpoint.
+func (c Controller) DownloadProxiedArtifact(ctx *fiber.Ctx) error {
+	req, err := newProxiedArtifactRequest(ctx)
+	if err != nil {
+		return err
+	}
+	log.Debugf("downloadProxiedArtifact request: %#v", req)
+
+	artifact, err := c.artifactService.GetProxiedArtifact(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+	if artifact.PresignedURL != "" {
+		return ctx.Redirect(artifact.PresignedURL, fiber.StatusFound)
+	}
+
+	return serveArtifact(ctx, req.Path, artifact)
+}
+
+// UploadProxiedArtifact handles `PUT /mlflow-artifacts/artifacts/{path}` endpoint.
+func (c Controller) UploadProxiedArtifact(ctx *fiber.Ctx) error {
+	req, err := newProxiedArtifactRequest(ctx)
+	if err != nil {
+		return err
+	}
+	log.Debugf("uploadProxiedArtifact request: %#v", req)
+
+	body, _ := getUploadedBody(ctx)
+	if err := c.artifactService.PutProxiedArtifact(ctx.Context(), req, body); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// DeleteProxiedArtifact handles `DELETE /mlflow-artifacts/artifacts/{path}` endpoint.
+func (c Controller) DeleteProxiedArtifact(ctx *fiber.Ctx) error {
+	req, err := newProxiedArtifactRequest(ctx)
+	if err != nil {
+		return err
+	}
+	log.Debugf("deleteProxiedArtifact request: %#v", req)
+
+	if err := c.artifactService.DeleteProxiedArtifact(ctx.Context(), req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// CreateMultipartUpload handles `POST /mlflow-artifacts/mpu/create/{path}` endpoint.
+func (c Controller) CreateMultipartUpload(ctx *fiber.Ctx) error {
+	req := request.CreateMultipartUploadRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("createMultipartUpload request: %#v", req)
+
+	upload, err := c.artifactService.CreateMultipartUpload(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	// parts, which can't be uploaded directly into the storage, go through `mpu/upload` endpoint.
+	proxyURL, err := url.Parse(ctx.BaseURL() + ctx.OriginalURL())
+	if err != nil {
+		return api.NewInternalError("unable to parse request url: %s", err)
+	}
+	proxyURL.RawQuery = ""
+	proxyURL.Path = strings.Replace(proxyURL.Path, "/mpu/create/", "/mpu/upload/", 1)
+	proxyURL.RawPath = strings.Replace(proxyURL.RawPath, "/mpu/create/", "/mpu/upload/", 1)
+
+	resp := response.NewCreateMultipartUploadResponse(upload, proxyURL.String())
+	log.Debugf("createMultipartUpload response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// UploadMultipartUploadPart handles `PUT /mlflow-artifacts/mpu/upload/{path}` endpoint.
+func (c Controller) UploadMultipartUploadPart(ctx *fiber.Ctx) error {
+	req := request.UploadMultipartUploadPartRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	body, size := getUploadedBody(ctx)
+	req.Path, req.Size = path, size
+	log.Debugf("uploadMultipartUploadPart request: %#v", req)
+
+	part, err := c.artifactService.UploadMultipartUploadPart(ctx.Context(), &req, body)
+	if err != nil {
+		return err
+	}
+
+	// the same header as storages return, so clients are able to handle presigned and proxied urls equally.
+	ctx.Set(fiber.HeaderETag, part.ETag)
+	resp := response.NewMultipartUploadPartPartialResponse(part)
+	log.Debugf("uploadMultipartUploadPart response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// ListMultipartUploadParts handles `GET /mlflow-artifacts/mpu/parts/{path}` endpoint.
+func (c Controller) ListMultipartUploadParts(ctx *fiber.Ctx) error {
+	req := request.ListMultipartUploadPartsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("listMultipartUploadParts request: %#v", req)
+
+	parts, err := c.artifactService.ListMultipartUploadParts(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListMultipartUploadPartsResponse(parts)
+	log.Debugf("listMultipartUploadParts response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// CompleteMultipartUpload handles `POST /mlflow-artifacts/mpu/complete/{path}` endpoint.
+func (c Controller) CompleteMultipartUpload(ctx *fiber.Ctx) error {
+	req := request.CompleteMultipartUploadRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("completeMultipartUpload request: %#v", req)
+
+	if err := c.artifactService.CompleteMultipartUpload(ctx.Context(), &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// AbortMultipartUpload handles `POST /mlflow-artifacts/mpu/abort/{path}` endpoint.
+func (c Controller) AbortMultipartUpload(ctx *fiber.Ctx) error {
+	req := request.AbortMultipartUploadRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("abortMultipartUpload request: %#v", req)
+
+	if err := c.artifactService.AbortMultipartUpload(ctx.Context(), &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// newProxiedArtifactRequest builds request.ProxiedArtifactRequest from the wildcard part of the route.
+func newProxiedArtifactRequest(ctx *fiber.Ctx) (*request.ProxiedArtifactRequest, error) {
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &request.ProxiedArtifactRequest{
+		Path: path,
+	}, nil
+}
+
+// getProxiedArtifactPath returns decoded artifact path from the wildcard part of the route.
+func getProxiedArtifactPath(ctx *fiber.Ctx) (string, error) {
+	path, err := url.PathUnescape(ctx.Params("*"))
+	if err != nil {
+		return "", api.NewBadRequestError("unable to decode artifact path: %s", err)
+	}
+	return path, nil
+}
+
+// getUploadedBody returns the body of the upload request and its size from `Content-Length` header,
+// or -1 when the size is unknown. The streamed body is passed into the storage as it is, without buffering.
+func getUploadedBody(ctx *fiber.Ctx) (io.Reader, int64) {
+	stream := ctx.Request().BodyStream()
+	if stream == nil {
+		body := ctx.Body()
+		return bytes.NewReader(body), int64(len(body))
+	}
+	if size := ctx.Request().Header.ContentLength(); size >= 0 {
+		return stream, int64(size)
+	}
+	return stream, -1
+}
+
+// serveArtifact writes artifact content into the response.
+// `Range`, `If-Range`, `If-None-Match` and `If-Modified-Since` request headers are honored,
+// so clients are able to resume downloads and to seek in large artifacts.
+func serveArtifact(ctx *fiber.Ctx, path string, artifact *artifact.Artifact) error {
+	filename := filepath.Base(path)
+	ctx.Set(fiber.HeaderContentType, common.GetContentType(filename))
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	ctx.Set(fiber.HeaderAcceptRanges, "bytes")
+	if artifact.Stat.ETag != "" {
+		ctx.Set(fiber.HeaderETag, artifact.Stat.ETag)
+	}
+	if !artifact.Stat.LastModified.IsZero() {
+		ctx.Set(fiber.HeaderLastModified, artifact.Stat.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if isArtifactNotModified(ctx, artifact.Stat) {
+		ctx.Context().Response.ResetBody()
+		return ctx.SendStatus(fiber.StatusNotModified)
+	}
+
+	size := artifact.Stat.Size
+	offset, length, ok := int64(0), size, false
+	if rangeHeader := ctx.Get(fiber.HeaderRange); rangeHeader != "" && isArtifactRangeApplicable(ctx, artifact.Stat) {
+		offset, length, ok = parseArtifactRange(rangeHeader, size)
+		if !ok {
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", size))
+			return ctx.SendStatus(fiber.StatusRequestedRangeNotSatisfiable)
+		}
+		if length < 0 {
+			// multiple or malformed ranges are ignored and the whole content is returned.
+			offset, length, ok = 0, size, false
+		}
+	}
+
+	var reader io.ReadCloser
+	var err error
+	if ok {
+		reader, err = artifact.ReadRange(ctx.Context(), offset, length)
+		if err != nil {
+			return err
+		}
+		ctx.Status(fiber.StatusPartialContent)
+		ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, size))
+	} else {
+		reader, err = artifact.Read(ctx.Context())
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.Context().Response.SetBodyStream(&artifactStream{
+		reader: reader,
+		method: ctx.Method(),
+		path:   ctx.Path(),
+		start:  time.Now(),
+	}, int(length))
+	return nil
+}
+
+// isArtifactNotModified checks `If-None-Match` and `If-Modified-Since` request headers against the artifact.
+// The same as net/http does, `If-Modified-Since` is ignored when `If-None-Match` is provided.
+func isArtifactNotModified(ctx *fiber.Ctx, stat *storage.ArtifactObjectStat) bool {
+	if ifNoneMatch := ctx.Get(fiber.HeaderIfNoneMatch); ifNoneMatch != "" {
+		if stat.ETag == "" {
+			return false
+		}
+		for _, etag := range strings.Split(ifNoneMatch, ",") {
+			etag = strings.TrimSpace(etag)
+			if etag == "*" || strings.TrimPrefix(etag, "W/") == strings.TrimPrefix(stat.ETag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+	if ifModifiedSince := ctx.Get(fiber.HeaderIfModifiedSince); ifModifiedSince != "" && !stat.LastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		// http dates have only seconds precision.
+		return !stat.LastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// isArtifactRangeApplicable checks `If-Range` request header, the range is applied only to the same artifact.
+func isArtifactRangeApplicable(ctx *fiber.Ctx, stat *storage.ArtifactObjectStat) bool {
+	ifRange := ctx.Get(fiber.HeaderIfRange)
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) {
+		// only strong comparison is allowed for `If-Range`.
+		return stat.ETag != "" && !strings.HasPrefix(stat.ETag, "W/") && ifRange == stat.ETag
+	}
+	since, err := http.ParseTime(ifRange)
+	if err != nil || stat.LastModified.IsZero() {
+		return false
+	}
+	return stat.LastModified.Truncate(time.Second).Equal(since)
+}
+
+// parseArtifactRange parses a single `bytes` range of the `Range` request header.
+// It returns negative length when the range has to be ignored
+// and false when the range can't be satisfied for the artifact of provided size.
+func parseArtifactRange(header string, size int64) (int64, int64, bool) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, -1, true
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, -1, true
+	}
+
+	// suffix range, e.g. `bytes=-500` requests the last 500 bytes.
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, -1, true
+		}
+		if suffix == 0 || size == 0 {
+			return 0, 0, false
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, suffix, true
+	}
+
+	offset, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, -1, true
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < offset {
+			return 0, -1, true
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	if offset >= size {
+		return 0, 0, false
+	}
+	return offset, end - offset + 1, true
+}
+
+// artifactStream wraps artifact content, so streaming time is logged when the response is written.
+type artifactStream struct {
+	reader  io.ReadCloser
+	method  string
+	path    string
+	start   time.Time
+	written int64
+}
+
+// Read implements io.Reader interface.
+func (s *artifactStream) Read(p []byte) (int, error) {
+	n, err := s.reader.Read(p)
+	s.written += int64(n)
+	if err != nil && err != io.EOF {
+		log.Errorf(
+			"error encountered in %s %s: error streaming artifact: %s",
+			s.method,
+			s.path,
+			eris.Wrap(err, "error reading artifact content"),
+		)
+	}
+	return n, err
+}
+
+// Close implements io.Closer interface.
+func (s *artifactStream) Close() error {
+	log.Debugf("streamArtifact wrote bytes to output stream: %d", s.written)
+	log.Infof("body - %s %s %s", time.Since(s.start), s.method, s.path)
+	return s.reader.Close()
+}
@@ -32,14 +32,14 @@ func (c Controller) GetMetricHistory(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting namespace from context")
 	}
 	log.Debugf("getMetricHistory namespace: %s", ns.Code)
-	metrics, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
+	metrics, limit, offset, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := response.NewMetricHistoryResponse(metrics)
+	resp, err := response.NewMetricHistoryResponse(metrics, limit, offset)
 	if err != nil {
-		return err
+		return api.NewInternalError("unable to build get-history response: %s", err)
 	}
 	log.Debugf("getMetricHistory response: %#v", resp)
 
@@ -85,18 +85,18 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("getMetricHistories namespace: %s", ns.Code)
 
-	rows, iterator, err := c.metricService.GetMetricHistories(ctx.Context(), ns, &req)
+	iterator, err := c.metricService.GetMetricHistories(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
-	if err := rows.Err(); err != nil {
+	if err := iterator.Err(); err != nil {
 		return api.NewInternalError("error getting query result: %s", err)
 	}
 
 	ctx.Set("Content-Type", "application/octet-stream")
 	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
 		//nolint:errcheck
-		defer rows.Close()
+		defer iterator.Close()
 
 		start := time.Now()
 		if err := func() error {
@@ -119,9 +119,9 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 			b := array.NewRecordBuilder(pool, schema)
 			defer b.Release()
 
-			for i := 0; rows.Next(); i++ {
+			for i := 0; iterator.Next(); i++ {
 				var m database.Metric
-				if err := iterator(rows, &m); err != nil {
+				if err := iterator.Scan(&m); err != nil {
 					return eris.Wrap(err, "error reading metric from iterator")
 				}
 				b.Field(0).(*array.StringBuilder).Append(m.RunID)
@@ -144,6 +144,9 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 					}
 				}
 			}
+			if err := iterator.Err(); err != nil {
+				return eris.Wrap(err, "error iterating over metrics")
+			}
 			if b.Field(0).Len() > 0 {
 				if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
 					return fmt.Errorf("unable to write Arrow record batch: %w", err)
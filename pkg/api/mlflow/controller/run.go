@@ -86,6 +86,32 @@ func (c Controller) GetRun(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// GetRunTree handles `GET /runs/get-tree` endpoint.
+func (c Controller) GetRunTree(ctx *fiber.Ctx) error {
+	req := request.GetRunTreeRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+
+	log.Debugf("getRunTree request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunTree namespace: %s", ns.Code)
+
+	run, descendants, err := c.runService.GetRunTree(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetRunTreeResponse(run, descendants)
+	log.Debugf("getRunTree response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // SearchRuns handles `POST /runs/search` endpoint.
 func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 	var req request.SearchRunsRequest
@@ -260,6 +286,27 @@ func (c Controller) DeleteRunTag(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// LogInputs handles `POST /runs/log-inputs` endpoint.
+func (c Controller) LogInputs(ctx *fiber.Ctx) error {
+	var req request.LogInputsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("logInputs request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logInputs namespace: %s", ns.Code)
+
+	if err := c.runService.LogInputs(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
 // LogBatch handles `POST /runs/log-batch` endpoint.
 func (c Controller) LogBatch(ctx *fiber.Ctx) error {
 	var req request.LogBatchRequest
@@ -45,7 +45,7 @@ func ConvertCreateExperimentToDBModel(req *request.CreateExperimentRequest) (*mo
 			return nil, eris.Wrap(err, "error parsing artifact location")
 		}
 		switch u.Scheme {
-		case "s3":
+		case "s3", "wasbs", "az":
 			experiment.ArtifactLocation = strings.TrimRight(u.String(), "/")
 		default:
 			// TODO:DSuhinin - default case right now has to satisfy Python integration tests.
@@ -15,10 +15,13 @@ import (
 
 // ConvertLogParamRequestToDBModel converts request.LogParamRequest into actual models.Param model.
 func ConvertLogParamRequestToDBModel(runID string, req *request.LogParamRequest) *models.Param {
+	valueType, valueNumeric := common.InferParamType(req.Value)
 	return &models.Param{
-		Key:   req.Key,
-		Value: req.Value,
-		RunID: runID,
+		Key:          req.Key,
+		Value:        req.Value,
+		RunID:        runID,
+		ValueType:    valueType,
+		ValueNumeric: valueNumeric,
 	}
 }
 
@@ -28,10 +31,13 @@ func ConvertLogBatchRequestToDBModel(
 ) ([]models.Metric, []models.Param, []models.Tag, error) {
 	params := make([]models.Param, len(req.Params))
 	for i, param := range req.Params {
+		valueType, valueNumeric := common.InferParamType(param.Value)
 		params[i] = models.Param{
-			Key:   param.Key,
-			Value: param.Value,
-			RunID: runID,
+			Key:          param.Key,
+			Value:        param.Value,
+			RunID:        runID,
+			ValueType:    valueType,
+			ValueNumeric: valueNumeric,
 		}
 	}
 
@@ -82,3 +88,29 @@ func ConvertLogBatchRequestToDBModel(
 	}
 	return metrics, params, tags, nil
 }
+
+// ConvertLogInputsRequestToDBModel converts request.LogInputsRequest into actual []models.Input models.
+func ConvertLogInputsRequestToDBModel(runID string, req *request.LogInputsRequest) []models.Input {
+	inputs := make([]models.Input, len(req.Datasets))
+	for i, datasetInput := range req.Datasets {
+		inputs[i] = models.Input{
+			RunID: runID,
+			Dataset: models.Dataset{
+				Name:       datasetInput.Dataset.Name,
+				Digest:     datasetInput.Dataset.Digest,
+				SourceType: datasetInput.Dataset.SourceType,
+				Source:     datasetInput.Dataset.Source,
+				Schema:     datasetInput.Dataset.Schema,
+				Profile:    datasetInput.Dataset.Profile,
+			},
+			Tags: make([]models.InputTag, len(datasetInput.Tags)),
+		}
+		for n, tag := range datasetInput.Tags {
+			inputs[i].Tags[n] = models.InputTag{
+				Key:   tag.Key,
+				Value: tag.Value,
+			}
+		}
+	}
+	return inputs
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
@@ -21,6 +22,13 @@ func TestConvertLogParamRequestToDBModel_Ok(t *testing.T) {
 	assert.Equal(t, "key", result.Key)
 	assert.Equal(t, "value", result.Value)
 	assert.Equal(t, "run_id", result.RunID)
+	assert.Equal(t, common.ParamTypeString, result.ValueType)
+	assert.Nil(t, result.ValueNumeric)
+
+	req.Value = "0.5"
+	result = ConvertLogParamRequestToDBModel("run_id", &req)
+	assert.Equal(t, common.ParamTypeFloat, result.ValueType)
+	assert.Equal(t, common.GetPointer(0.5), result.ValueNumeric)
 }
 
 func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
@@ -62,9 +70,10 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 			},
 			expectedParams: []models.Param{
 				{
-					RunID: "run_id",
-					Key:   "key",
-					Value: "value",
+					RunID:     "run_id",
+					Key:       "key",
+					Value:     "value",
+					ValueType: common.ParamTypeString,
 				},
 			},
 			expectedMetrics: []models.Metric{
@@ -108,9 +117,10 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 			},
 			expectedParams: []models.Param{
 				{
-					RunID: "run_id",
-					Key:   "key",
-					Value: "value",
+					RunID:     "run_id",
+					Key:       "key",
+					Value:     "value",
+					ValueType: common.ParamTypeString,
 				},
 			},
 			expectedMetrics: []models.Metric{
@@ -155,9 +165,10 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 			},
 			expectedParams: []models.Param{
 				{
-					RunID: "run_id",
-					Key:   "key",
-					Value: "value",
+					RunID:     "run_id",
+					Key:       "key",
+					Value:     "value",
+					ValueType: common.ParamTypeString,
 				},
 			},
 			expectedMetrics: []models.Metric{
@@ -201,9 +212,10 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 			},
 			expectedParams: []models.Param{
 				{
-					RunID: "run_id",
-					Key:   "key",
-					Value: "value",
+					RunID:     "run_id",
+					Key:       "key",
+					Value:     "value",
+					ValueType: common.ParamTypeString,
 				},
 			},
 			expectedMetrics: []models.Metric{
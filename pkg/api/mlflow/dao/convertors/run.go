@@ -17,6 +17,8 @@ const (
 	TagKeyRunName    = "mlflow.runName"
 	TagKeySourceName = "mlflow.source.name"
 	TagKeySourceType = "mlflow.source.type"
+	// TagKeyDatasetContext is the input tag key, which MLflow uses to store dataset context (training, testing, etc).
+	TagKeyDatasetContext = "mlflow.data.context"
 )
 
 // ConvertCreateRunRequestToDBModel converts request.CreateRunRequest into actual models.Run model.
@@ -25,3 +25,27 @@ func ConvertSetExperimentTagRequestToDBModel(
 		ExperimentID: experimentID,
 	}
 }
+
+// ConvertSetRegisteredModelTagRequestToDBModel converts
+// request.SetRegisteredModelTagRequest into actual models.RegisteredModelTag model.
+func ConvertSetRegisteredModelTagRequestToDBModel(
+	registeredModel *models.RegisteredModel, req *request.SetRegisteredModelTagRequest,
+) *models.RegisteredModelTag {
+	return &models.RegisteredModelTag{
+		Key:               req.Key,
+		Value:             req.Value,
+		RegisteredModelID: registeredModel.ID,
+	}
+}
+
+// ConvertSetModelVersionTagRequestToDBModel converts
+// request.SetModelVersionTagRequest into actual models.ModelVersionTag model.
+func ConvertSetModelVersionTagRequestToDBModel(
+	modelVersion *models.ModelVersion, req *request.SetModelVersionTagRequest,
+) *models.ModelVersionTag {
+	return &models.ModelVersionTag{
+		Key:            req.Key,
+		Value:          req.Value,
+		ModelVersionID: modelVersion.ID,
+	}
+}
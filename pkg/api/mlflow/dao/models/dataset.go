@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Dataset represents model to work with `datasets` table.
+type Dataset struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ExperimentID int32     `gorm:"not null;index:,unique,composite:dataset"`
+	Name         string    `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string    `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string    `gorm:"type:varchar(36);not null"`
+	Source       string    `gorm:"type:text;not null"`
+	Schema       string    `gorm:"type:text"`
+	Profile      string    `gorm:"type:text"`
+}
+
+// BeforeCreate generates a new ID for the dataset, if it hasn't been provided.
+func (d *Dataset) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// Input represents model to work with `inputs` table, which links datasets to the runs.
+type Input struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	DatasetID uuid.UUID  `gorm:"type:uuid;not null;index:,unique,composite:input"`
+	Dataset   Dataset    `gorm:"constraint:OnDelete:CASCADE"`
+	RunID     string     `gorm:"column:run_uuid;not null;index:,unique,composite:input"`
+	Tags      []InputTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate generates a new ID for the input, if it hasn't been provided.
+func (i *Input) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// InputTag represents model to work with `input_tags` table.
+type InputTag struct {
+	Key     string    `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string    `gorm:"type:varchar(500);not null"`
+	InputID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
@@ -16,16 +16,26 @@ type Metric struct {
 }
 
 // LatestMetric represents model to work with `last_metrics` table.
+// Along with the last value, it keeps the summary of all the values logged for the key:
+// the first value (the one with the lowest step and timestamp), the number of values
+// and the min and max of them, NaN values aside.
 type LatestMetric struct {
-	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
-	Value     float64 `gorm:"type:double precision;not null"`
-	Timestamp int64
-	Step      int64  `gorm:"not null"`
-	IsNan     bool   `gorm:"not null"`
-	RunID     string `gorm:"column:run_uuid;not null;primaryKey;index"`
-	LastIter  int64
-	ContextID *uint
-	Context   *Context
+	Key            string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          float64 `gorm:"type:double precision;not null"`
+	Timestamp      int64
+	Step           int64  `gorm:"not null"`
+	IsNan          bool   `gorm:"not null"`
+	RunID          string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	LastIter       int64
+	MinValue       *float64 `gorm:"type:double precision"`
+	MaxValue       *float64 `gorm:"type:double precision"`
+	FirstValue     float64  `gorm:"type:double precision;not null;default:0"`
+	FirstIsNan     bool     `gorm:"not null;default:false"`
+	FirstStep      int64    `gorm:"not null;default:0"`
+	FirstTimestamp int64    `gorm:"not null;default:0"`
+	Count          int64    `gorm:"not null;default:0"`
+	ContextID      *uint
+	Context        *Context
 }
 
 // Context represents model to work with `contexts` table.
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ModelVersionStage represents stage of the model version.
+type ModelVersionStage string
+
+// Supported list of model version stages.
+const (
+	ModelVersionStageNone       ModelVersionStage = "None"
+	ModelVersionStageStaging    ModelVersionStage = "Staging"
+	ModelVersionStageProduction ModelVersionStage = "Production"
+	ModelVersionStageArchived   ModelVersionStage = "Archived"
+	// ModelVersionStageDeletedInternal marks soft deleted model versions, so their numbers never get reused.
+	ModelVersionStageDeletedInternal ModelVersionStage = "Deleted_Internal"
+)
+
+// ModelVersionStatusReady is the only status FastTrackML assigns to the model version,
+// because registration happens synchronously.
+const ModelVersionStatusReady = "READY"
+
+// ModelVersionStages is the list of stages which could be requested by the user.
+var ModelVersionStages = []ModelVersionStage{
+	ModelVersionStageNone,
+	ModelVersionStageStaging,
+	ModelVersionStageProduction,
+	ModelVersionStageArchived,
+}
+
+// NewModelVersionStage returns canonical ModelVersionStage for provided case-insensitive value.
+func NewModelVersionStage(stage string) (ModelVersionStage, bool) {
+	for _, s := range ModelVersionStages {
+		if strings.EqualFold(string(s), stage) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// ModelVersion represents model to work with `model_versions` table.
+type ModelVersion struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;index:,unique,composite:version"`
+	RegisteredModel   RegisteredModel
+	Version           int32             `gorm:"not null;index:,unique,composite:version"`
+	Description       string            `gorm:"type:varchar(5000)"`
+	UserID            string            `gorm:"type:varchar(256)"`
+	CurrentStage      ModelVersionStage `gorm:"type:varchar(20);index"`
+	Source            string            `gorm:"type:varchar(500)"`
+	RunID             string            `gorm:"type:varchar(32);index"`
+	RunLink           string            `gorm:"type:varchar(500)"`
+	Status            string            `gorm:"type:varchar(20)"`
+	StatusMessage     string            `gorm:"type:varchar(500)"`
+	CreationTime      sql.NullInt64     `gorm:"type:bigint"`
+	LastUpdatedTime   sql.NullInt64     `gorm:"type:bigint"`
+	Tags              []ModelVersionTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate generates a new ID for the model version, if it hasn't been provided.
+func (mv *ModelVersion) BeforeCreate(tx *gorm.DB) error {
+	if mv.ID == uuid.Nil {
+		mv.ID = uuid.New()
+	}
+	return nil
+}
+
+// ModelVersionTag represents model to work with `model_version_tags` table.
+type ModelVersionTag struct {
+	Key            string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string    `gorm:"type:varchar(5000)"`
+	ModelVersionID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
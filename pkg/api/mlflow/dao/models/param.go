@@ -1,8 +1,11 @@
 package models
 
 // Param represents model to work with `params` table.
+// The value is kept as it has been logged, along with its inferred type.
 type Param struct {
-	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
-	Value string `gorm:"type:varchar(500);not null"`
-	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	Key          string   `gorm:"type:varchar(250);not null;primaryKey"`
+	Value        string   `gorm:"type:varchar(500);not null"`
+	RunID        string   `gorm:"column:run_uuid;not null;primaryKey;index"`
+	ValueType    string   `gorm:"type:varchar(8);not null;default:'str'"`
+	ValueNumeric *float64 `gorm:"type:double precision"`
 }
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RegisteredModel represents model to work with `registered_models` table.
+type RegisteredModel struct {
+	ID              uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	Name            string                 `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string                 `gorm:"type:varchar(5000)"`
+	CreationTime    sql.NullInt64          `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64          `gorm:"type:bigint"`
+	NamespaceID     uint                   `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace              `gorm:"constraint:OnDelete:CASCADE"`
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate generates a new ID for the registered model, if it hasn't been provided.
+func (m *RegisteredModel) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// RegisteredModelTag represents model to work with `registered_model_tags` table.
+type RegisteredModelTag struct {
+	Key               string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string    `gorm:"type:varchar(5000)"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+// RegisteredModelAlias represents model to work with `registered_model_aliases` table.
+type RegisteredModelAlias struct {
+	Alias             string    `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int32     `gorm:"not null"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+// LatestVersions returns the latest version in each of the requested stages. When no stages
+// are requested, the latest version in every stage is returned.
+func (m RegisteredModel) LatestVersions(stages ...ModelVersionStage) []ModelVersion {
+	if len(stages) == 0 {
+		stages = ModelVersionStages
+	}
+	latest := make(map[ModelVersionStage]ModelVersion, len(stages))
+	for _, version := range m.Versions {
+		if current, ok := latest[version.CurrentStage]; !ok || version.Version > current.Version {
+			latest[version.CurrentStage] = version
+		}
+	}
+	versions := make([]ModelVersion, 0, len(stages))
+	for _, stage := range stages {
+		if version, ok := latest[stage]; ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// VersionAliases returns the list of aliases which point to the provided version.
+func (m RegisteredModel) VersionAliases(version int32) []string {
+	var aliases []string
+	for _, alias := range m.Aliases {
+		if alias.Version == version {
+			aliases = append(aliases, alias.Alias)
+		}
+	}
+	return aliases
+}
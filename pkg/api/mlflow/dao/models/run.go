@@ -44,6 +44,7 @@ type Run struct {
 	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
 	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
 	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Inputs         []Input        `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 // RowNum represents custom data type.
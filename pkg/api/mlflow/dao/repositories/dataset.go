@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// DatasetRepositoryProvider provides an interface to work with models.Dataset and models.Input entities.
+type DatasetRepositoryProvider interface {
+	BaseRepositoryProvider
+	// CreateRunInputs creates models.Dataset entities, if they don't exist yet,
+	// and links them to the run as its models.Input entities.
+	CreateRunInputs(ctx context.Context, run *models.Run, inputs []models.Input) error
+}
+
+// DatasetRepository repository to work with models.Dataset and models.Input entities.
+type DatasetRepository struct {
+	BaseRepository
+}
+
+// NewDatasetRepository creates repository to work with models.Dataset and models.Input entities.
+func NewDatasetRepository(db *gorm.DB) *DatasetRepository {
+	return &DatasetRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// CreateRunInputs creates models.Dataset entities, if they don't exist yet,
+// and links them to the run as its models.Input entities.
+// Dataset is identified by experiment, name and digest, so logging the same dataset twice is a no-op,
+// as well as logging the same input for the run twice.
+func (r DatasetRepository) CreateRunInputs(ctx context.Context, run *models.Run, inputs []models.Input) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, input := range inputs {
+			dataset := input.Dataset
+			dataset.ExperimentID = run.ExperimentID
+			if err := tx.Where(
+				"experiment_id = ? AND name = ? AND digest = ?", dataset.ExperimentID, dataset.Name, dataset.Digest,
+			).Attrs(
+				dataset,
+			).FirstOrCreate(&dataset).Error; err != nil {
+				return eris.Wrapf(err, "error creating dataset with name: %s", dataset.Name)
+			}
+
+			input.DatasetID, input.Dataset, input.RunID = dataset.ID, models.Dataset{}, run.ID
+			result := tx.Omit(
+				"Dataset", "Tags",
+			).Clauses(
+				clause.OnConflict{DoNothing: true},
+			).Create(&input)
+			if result.Error != nil {
+				return eris.Wrapf(result.Error, "error creating input for dataset with name: %s", dataset.Name)
+			}
+			if result.RowsAffected == 0 || len(input.Tags) == 0 {
+				continue
+			}
+
+			for i := range input.Tags {
+				input.Tags[i].InputID = input.ID
+			}
+			if err := tx.Create(&input.Tags).Error; err != nil {
+				return eris.Wrapf(err, "error creating tags of input for dataset with name: %s", dataset.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error creating inputs for run with id: %s", run.ID)
+	}
+	return nil
+}
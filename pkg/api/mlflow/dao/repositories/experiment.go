@@ -22,6 +22,9 @@ type ExperimentRepositoryProvider interface {
 	Delete(ctx context.Context, experiment *models.Experiment) error
 	// DeleteBatch removes existing []models.Experiment in batch from the db.
 	DeleteBatch(ctx context.Context, ids []*int32) error
+	// GetDeletedBefore returns models.Experiment entities together with their runs, which have been deleted
+	// before provided time. Empty namespaceIDs means that experiments of all the namespaces are returned.
+	GetDeletedBefore(ctx context.Context, namespaceIDs []uint, lastUpdateTime int64) ([]models.Experiment, error)
 	// GetByNamespaceIDAndName returns experiment by Namespace ID and Experiment name.
 	GetByNamespaceIDAndName(ctx context.Context, namespaceID uint, name string) (*models.Experiment, error)
 	// GetByNamespaceIDAndExperimentID returns experiment by Namespace ID and Experiment ID.
@@ -98,6 +101,31 @@ func (r ExperimentRepository) GetByNamespaceIDAndName(
 	return &experiment, nil
 }
 
+// GetDeletedBefore returns models.Experiment entities together with their runs, which have been deleted
+// before provided time. Default experiments of the namespaces are never returned.
+// Experiments without `last_update_time`, e.g. the ones imported from MLflow, fall back to their creation time.
+func (r ExperimentRepository) GetDeletedBefore(
+	ctx context.Context, namespaceIDs []uint, lastUpdateTime int64,
+) ([]models.Experiment, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Runs",
+	).Where(
+		"lifecycle_stage = ? AND COALESCE(last_update_time, creation_time, 0) < ?",
+		models.LifecycleStageDeleted, lastUpdateTime,
+	).Where(
+		"experiment_id NOT IN (?)", r.db.Unscoped().Model(models.Namespace{}).Select("default_experiment_id"),
+	)
+	if len(namespaceIDs) > 0 {
+		query = query.Where("namespace_id IN ?", namespaceIDs)
+	}
+
+	var experiments []models.Experiment
+	if err := query.Order("experiment_id").Find(&experiments).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting deleted experiments")
+	}
+	return experiments, nil
+}
+
 // Update updates existing models.Experiment entity.
 func (r ExperimentRepository) Update(ctx context.Context, experiment *models.Experiment) error {
 	if err := r.db.Transaction(func(tx *gorm.DB) error {
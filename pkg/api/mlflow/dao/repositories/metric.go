@@ -2,13 +2,14 @@ package repositories
 
 import (
 	"context"
-	"database/sql"
+	"fmt"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
@@ -16,6 +17,7 @@ import (
 const (
 	MetricHistoriesDefaultLimit   = 10000000
 	MetricHistoryBulkDefaultLimit = 25000
+	MetricHistoryDefaultLimit     = 25000
 )
 
 // MetricRepositoryProvider provides an interface to work with models.Metric entity.
@@ -31,13 +33,19 @@ type MetricRepositoryProvider interface {
 		viewType request.ViewType,
 		limit int32,
 		jsonPathValueMap map[string]string,
-	) (*sql.Rows, func(*sql.Rows, interface{}) error, error)
+		sampling MetricSampling,
+	) (MetricIterator, error)
 	// GetMetricHistoryBulk returns metrics history bulk.
 	GetMetricHistoryBulk(
-		ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
+		ctx context.Context, namespaceID uint, runIDs []string, key string, sampling MetricSampling, limit int,
 	) ([]models.Metric, error)
-	// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
-	GetMetricHistoryByRunIDAndKey(ctx context.Context, runID, key string) ([]models.Metric, error)
+	// GetMetricHistoryByRunIDAndKey returns a page of metrics history by RunID and Key ordered by step and timestamp.
+	// Zero limit means the whole history.
+	GetMetricHistoryByRunIDAndKey(
+		ctx context.Context, runID, key string, sampling MetricSampling, limit, offset int,
+	) ([]models.Metric, error)
+	// DeleteOrphanedContexts removes models.Context entities, which are not referenced by any metric anymore.
+	DeleteOrphanedContexts(ctx context.Context) (int64, error)
 }
 
 // MetricRepository repository to work with models.Metric entity.
@@ -102,21 +110,28 @@ func (r MetricRepository) CreateBatch(
 		metrics[n].Iter = lastIters[metric.Key] + 1
 		lastIters[metric.Key] = metrics[n].Iter
 		lm, ok := latestMetrics[metric.Key]
-		if !ok ||
-			metric.Step > lm.Step ||
-			(metric.Step == lm.Step && metric.Timestamp > lm.Timestamp) ||
-			(metric.Step == lm.Step && metric.Timestamp == lm.Timestamp && metric.Value > lm.Value) {
-			latestMetrics[metric.Key] = models.LatestMetric{
-				RunID:     metric.RunID,
-				Key:       metric.Key,
-				Value:     metric.Value,
-				Timestamp: metric.Timestamp,
-				Step:      metric.Step,
-				IsNan:     metric.IsNan,
-				LastIter:  metric.Iter,
-				Context:   metrics[n].Context,
+		if !ok {
+			lm = models.LatestMetric{
+				RunID: metric.RunID,
+				Key:   metric.Key,
 			}
 		}
+		if !ok || isLaterMetric(&metric, &lm) {
+			lm.Value = metric.Value
+			lm.Timestamp = metric.Timestamp
+			lm.Step = metric.Step
+			lm.IsNan = metric.IsNan
+			lm.Context = metrics[n].Context
+		}
+		if !ok || isEarlierMetric(&metric, &lm) {
+			lm.FirstValue = metric.Value
+			lm.FirstIsNan = metric.IsNan
+			lm.FirstStep = metric.Step
+			lm.FirstTimestamp = metric.Timestamp
+		}
+		lm.LastIter = metrics[n].Iter
+		addLatestMetricValue(&lm, metric.Value, metric.IsNan)
+		latestMetrics[metric.Key] = lm
 	}
 	if err := r.db.WithContext(ctx).Clauses(
 		clause.OnConflict{
@@ -127,45 +142,117 @@ func (r MetricRepository) CreateBatch(
 		return eris.Wrapf(err, "error creating contexts")
 	}
 
-	if err := r.db.WithContext(ctx).Clauses(
-		clause.OnConflict{DoNothing: true},
-	).CreateInBatches(&metrics, batchSize).Error; err != nil {
-		return eris.Wrapf(err, "error creating metrics for run: %s", run.ID)
-	}
-
-	// TODO update latest metrics in the background?
-
-	currentLatestMetricsMap := make(map[string]models.LatestMetric, len(latestMetrics))
-	for _, m := range latestMetrics {
-		currentLatestMetricsMap[m.Key] = m
+	// metrics are created key by key, so the summary of the latest metric counts only the metrics,
+	// which have been actually created, and not the duplicates of the already existing ones.
+	keyMetrics := make(map[string][]models.Metric, len(metricKeys))
+	for _, metric := range metrics {
+		keyMetrics[metric.Key] = append(keyMetrics[metric.Key], metric)
 	}
-
 	updatedLatestMetrics := make([]models.LatestMetric, 0, len(latestMetrics))
-	for k, m := range latestMetrics {
-		lm, ok := currentLatestMetricsMap[k]
-		if !ok ||
-			m.Step > lm.Step ||
-			(m.Step == lm.Step && m.Timestamp > lm.Timestamp) ||
-			(m.Step == lm.Step && m.Timestamp == lm.Timestamp && m.Value > lm.Value) {
-			updatedLatestMetrics = append(updatedLatestMetrics, m)
-		} else {
-			lm.LastIter = lastIters[k]
+	for _, key := range metricKeys {
+		created := keyMetrics[key]
+		result := r.db.WithContext(ctx).Clauses(
+			clause.OnConflict{DoNothing: true},
+		).CreateInBatches(&created, batchSize)
+		if result.Error != nil {
+			return eris.Wrapf(result.Error, "error creating metrics for run: %s", run.ID)
+		}
+		if result.RowsAffected > 0 {
+			lm := latestMetrics[key]
+			lm.Count = result.RowsAffected
 			updatedLatestMetrics = append(updatedLatestMetrics, lm)
 		}
 	}
 
+	// TODO update latest metrics in the background?
+
 	if len(updatedLatestMetrics) > 0 {
-		if err := r.db.Clauses(clause.OnConflict{
-			UpdateAll: true,
-		}).Create(&updatedLatestMetrics).Error; err != nil {
+		if err := r.db.WithContext(ctx).Clauses(
+			getLatestMetricsUpsert(r.db.Dialector.Name()),
+		).Create(&updatedLatestMetrics).Error; err != nil {
 			return eris.Wrapf(err, "error updating latest metrics for run: %s", run.ID)
 		}
 	}
 	return nil
 }
 
+// isLaterMetric reports whether the metric has to replace the latest metric value of the batch.
+func isLaterMetric(metric *models.Metric, lm *models.LatestMetric) bool {
+	return metric.Step > lm.Step ||
+		(metric.Step == lm.Step && metric.Timestamp > lm.Timestamp) ||
+		(metric.Step == lm.Step && metric.Timestamp == lm.Timestamp && metric.Value > lm.Value)
+}
+
+// isEarlierMetric reports whether the metric has to replace the first metric value of the batch.
+func isEarlierMetric(metric *models.Metric, lm *models.LatestMetric) bool {
+	return metric.Step < lm.FirstStep ||
+		(metric.Step == lm.FirstStep && metric.Timestamp < lm.FirstTimestamp)
+}
+
+// addLatestMetricValue adds the logged value to the min and max of the latest metric.
+func addLatestMetricValue(lm *models.LatestMetric, value float64, isNan bool) {
+	if isNan {
+		return
+	}
+	if lm.MinValue == nil || value < *lm.MinValue {
+		lm.MinValue = common.GetPointer(value)
+	}
+	if lm.MaxValue == nil || value > *lm.MaxValue {
+		lm.MaxValue = common.GetPointer(value)
+	}
+}
+
+// getLatestMetricsUpsert returns the clause, which merges the latest metrics of the batch into
+// the stored ones by the database itself, so concurrent batches of the same key don't lose each other's values.
+func getLatestMetricsUpsert(dialector string) clause.OnConflict {
+	least, greatest := "MIN", "MAX"
+	if dialector == database.PostgresDialectorName {
+		least, greatest = "LEAST", "GREATEST"
+	}
+	// the last value is replaced by the one of the batch, unless the stored one has been logged
+	// for the later step or timestamp.
+	last := "CASE WHEN excluded.step > latest_metrics.step OR " +
+		"(excluded.step = latest_metrics.step AND excluded.timestamp >= latest_metrics.timestamp) " +
+		"THEN excluded.%[1]s ELSE latest_metrics.%[1]s END"
+	// the first value is replaced only by the earlier one, the same way as isEarlierMetric does.
+	first := "CASE WHEN excluded.first_step < latest_metrics.first_step OR " +
+		"(excluded.first_step = latest_metrics.first_step AND " +
+		"excluded.first_timestamp < latest_metrics.first_timestamp) " +
+		"THEN excluded.%[1]s ELSE latest_metrics.%[1]s END"
+	// min and max of the keys, which have only NaN values so far, are NULL.
+	minMax := "%s(COALESCE(latest_metrics.%[2]s, excluded.%[2]s), COALESCE(excluded.%[2]s, latest_metrics.%[2]s))"
+
+	updates := clause.AssignmentColumns([]string{"last_iter"})
+	for _, update := range []struct {
+		column string
+		value  string
+	}{
+		{column: "value", value: fmt.Sprintf(last, "value")},
+		{column: "timestamp", value: fmt.Sprintf(last, "timestamp")},
+		{column: "step", value: fmt.Sprintf(last, "step")},
+		{column: "is_nan", value: fmt.Sprintf(last, "is_nan")},
+		{column: "context_id", value: fmt.Sprintf(last, "context_id")},
+		{column: "first_value", value: fmt.Sprintf(first, "first_value")},
+		{column: "first_is_nan", value: fmt.Sprintf(first, "first_is_nan")},
+		{column: "first_step", value: fmt.Sprintf(first, "first_step")},
+		{column: "first_timestamp", value: fmt.Sprintf(first, "first_timestamp")},
+		{column: "min_value", value: fmt.Sprintf(minMax, least, "min_value")},
+		{column: "max_value", value: fmt.Sprintf(minMax, greatest, "max_value")},
+		{column: "count", value: `latest_metrics."count" + excluded."count"`},
+	} {
+		updates = append(updates, clause.Assignment{
+			Column: clause.Column{Name: update.column},
+			Value:  gorm.Expr(update.value),
+		})
+	}
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}},
+		DoUpdates: updates,
+	}
+}
+
 // GetMetricHistories returns metric histories by request parameters.
-// TODO think about to use interface instead of underlying type for -> func(*sql.Rows, interface{})
+// When downsampling is enabled, limit is applied to the downsampled metrics.
 func (r MetricRepository) GetMetricHistories(
 	ctx context.Context,
 	namespaceID uint,
@@ -173,7 +260,8 @@ func (r MetricRepository) GetMetricHistories(
 	viewType request.ViewType,
 	limit int32,
 	jsonPathValueMap map[string]string,
-) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
+	sampling MetricSampling,
+) (MetricIterator, error) {
 	// if experimentIDs has been provided then firstly get the runs by provided experimentIDs.
 	if len(experimentIDs) > 0 {
 		query := r.db.WithContext(ctx).Model(
@@ -201,7 +289,7 @@ func (r MetricRepository) GetMetricHistories(
 			})
 		}
 		if err := query.Pluck("run_uuid", &runIDs).Error; err != nil {
-			return nil, nil, eris.Wrapf(
+			return nil, eris.Wrapf(
 				err, "error getting runs by experimentIDs: %v, viewType: %s", experimentIDs, viewType,
 			)
 		}
@@ -220,24 +308,8 @@ func (r MetricRepository) GetMetricHistories(
 		namespaceID,
 	).Joins(
 		"Context",
-	).Order(
-		"runs.start_time DESC",
-	).Order(
-		"metrics.run_uuid",
-	).Order(
-		"metrics.key",
-	).Order(
-		"metrics.step",
-	).Order(
-		"metrics.timestamp",
-	).Order(
-		"metrics.value",
 	)
-
-	if limit == 0 {
-		limit = MetricHistoriesDefaultLimit
-	}
-	query.Limit(int(limit))
+	sampling.applyStepRange(query)
 
 	if len(metricKeys) > 0 {
 		query.Where("metrics.key IN ?", metricKeys)
@@ -249,9 +321,53 @@ func (r MetricRepository) GetMetricHistories(
 		query.Where(sql, args...)
 	}
 
+	if limit == 0 {
+		limit = MetricHistoriesDefaultLimit
+	}
+
+	// downsampling needs every series to be read in one go and ordered by step,
+	// while only the preselected points of the series are read.
+	if sampling.IsEnabled() {
+		query = sampling.preselect(
+			r.db.WithContext(ctx),
+			query,
+			"metrics.*",
+			`"Context"."id" AS "Context__id"`,
+			`"Context"."json" AS "Context__json"`,
+			"runs.start_time AS run_start_time",
+		).Order(
+			"metrics.run_start_time DESC",
+		).Order(
+			"metrics.run_uuid",
+		).Order(
+			"metrics.key",
+		).Order(
+			"metrics.context_id",
+		).Limit(
+			sampling.getRowsLimit(int(limit)),
+		)
+	} else {
+		query.Order(
+			"runs.start_time DESC",
+		).Order(
+			"metrics.run_uuid",
+		).Order(
+			"metrics.key",
+		).Limit(
+			int(limit),
+		)
+	}
+	query.Order(
+		"metrics.step",
+	).Order(
+		"metrics.timestamp",
+	).Order(
+		"metrics.value",
+	)
+
 	rows, err := query.Rows()
 	if err != nil {
-		return nil, nil, eris.Wrapf(
+		return nil, eris.Wrapf(
 			err, "error getting metrics by experimentIDs: %v, runIDs: %v, metricKeys: %v, viewType: %s",
 			experimentIDs,
 			runIDs,
@@ -259,7 +375,12 @@ func (r MetricRepository) GetMetricHistories(
 			viewType,
 		)
 	}
-	return rows, r.db.ScanRows, nil
+
+	var iterator MetricIterator = &rowsMetricIterator{db: r.db, rows: rows}
+	if sampling.IsEnabled() {
+		iterator = &sampledMetricIterator{MetricIterator: iterator, sampling: sampling, limit: int(limit)}
+	}
+	return iterator, nil
 }
 
 // getLatestMetricsByRunIDAndKeys returns the latest metrics by requested Run ID and keys.
@@ -277,27 +398,62 @@ func (r MetricRepository) getLatestMetricsByRunIDAndKeys(
 	return metrics, nil
 }
 
-// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
+// GetMetricHistoryByRunIDAndKey returns a page of metrics history by RunID and Key ordered by step and timestamp.
+// value and is_nan complete the primary key, so the order is stable between the pages.
+// When downsampling is enabled, the page is taken from the downsampled metrics.
 func (r MetricRepository) GetMetricHistoryByRunIDAndKey(
-	ctx context.Context, runID, key string,
+	ctx context.Context, runID, key string, sampling MetricSampling, limit, offset int,
 ) ([]models.Metric, error) {
 	var metrics []models.Metric
-	if err := r.db.WithContext(ctx).Preload("Context").Where(
-		"run_uuid = ?", runID,
+	query := r.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Where(
+		"metrics.run_uuid = ?", runID,
 	).Where(
-		"key = ?", key,
+		"metrics.key = ?", key,
+	)
+	sampling.applyStepRange(query)
+
+	// downsampling needs every series to be read in one go, so the page is taken
+	// after the downsampling, while only the preselected points of the series are read.
+	if sampling.IsEnabled() {
+		query = sampling.preselect(r.db.WithContext(ctx), query, "metrics.*").Order("metrics.context_id")
+		if limit > 0 {
+			query.Limit(sampling.getRowsLimit(offset + limit))
+		}
+	} else if limit > 0 {
+		query.Limit(limit).Offset(offset)
+	}
+	if err := query.Preload("Context").Order(
+		"metrics.step",
+	).Order(
+		"metrics.timestamp",
+	).Order(
+		"metrics.value",
+	).Order(
+		"metrics.is_nan",
 	).Find(&metrics).Error; err != nil {
 		return nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, key)
 	}
+
+	if sampling.IsEnabled() {
+		metrics = sampleMetrics(metrics, sampling)
+		if limit > 0 {
+			metrics = metrics[min(offset, len(metrics)):min(offset+limit, len(metrics))]
+		}
+	}
 	return metrics, nil
 }
 
 // GetMetricHistoryBulk returns metrics history bulk.
+// When downsampling is enabled, every series is ordered by step instead of timestamp.
 func (r MetricRepository) GetMetricHistoryBulk(
-	ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
+	ctx context.Context, namespaceID uint, runIDs []string, key string, sampling MetricSampling, limit int,
 ) ([]models.Metric, error) {
 	var metrics []models.Metric
-	query := r.db.WithContext(ctx).Where(
+	query := r.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Where(
 		"runs.run_uuid IN ?", runIDs,
 	).Joins(
 		"LEFT JOIN runs ON runs.run_uuid = metrics.run_uuid",
@@ -305,26 +461,74 @@ func (r MetricRepository) GetMetricHistoryBulk(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
 	).Where(
-		"key = ?", key,
-	).Order(
-		"metrics.run_uuid",
-	).Order(
-		"metrics.timestamp",
-	).Order(
-		"metrics.step",
-	).Order(
-		"metrics.value",
+		"metrics.key = ?", key,
 	)
+	sampling.applyStepRange(query)
 
 	if limit == 0 {
 		limit = MetricHistoryBulkDefaultLimit
 	}
-	query.Limit(limit)
 
-	if err := query.Find(
+	// downsampling needs every series to be read in one go and ordered by step,
+	// while only the preselected points of the series are read.
+	if sampling.IsEnabled() {
+		query = sampling.preselect(
+			r.db.WithContext(ctx), query, "metrics.*",
+		).Order(
+			"metrics.run_uuid",
+		).Order(
+			"metrics.context_id",
+		).Order(
+			"metrics.step",
+		).Order(
+			"metrics.timestamp",
+		).Limit(
+			sampling.getRowsLimit(limit),
+		)
+	} else {
+		query.Order(
+			"metrics.run_uuid",
+		).Order(
+			"metrics.timestamp",
+		).Order(
+			"metrics.step",
+		).Limit(
+			limit,
+		)
+	}
+	if err := query.Order(
+		"metrics.value",
+	).Find(
 		&metrics,
 	).Error; err != nil {
 		return nil, eris.Wrapf(err, "error getting metric history by run ids: %v and key: %s", runIDs, key)
 	}
+
+	if sampling.IsEnabled() {
+		metrics = sampleMetrics(metrics, sampling)
+		metrics = metrics[:min(limit, len(metrics))]
+	}
 	return metrics, nil
 }
+
+// DeleteOrphanedContexts removes models.Context entities, which are not referenced by any metric
+// or any other sequence (blob, distribution, text or figure) anymore.
+func (r MetricRepository) DeleteOrphanedContexts(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Where(
+		"id NOT IN (?)", r.db.Model(models.Metric{}).Distinct("context_id").Where("context_id IS NOT NULL"),
+	).Where(
+		"id NOT IN (?)", r.db.Model(models.LatestMetric{}).Distinct("context_id").Where("context_id IS NOT NULL"),
+	).Where(
+		"id NOT IN (?)", r.db.Model(database.Blob{}).Distinct("context_id"),
+	).Where(
+		"id NOT IN (?)", r.db.Model(database.Distribution{}).Distinct("context_id"),
+	).Where(
+		"id NOT IN (?)", r.db.Model(database.Text{}).Distinct("context_id"),
+	).Where(
+		"id NOT IN (?)", r.db.Model(database.Figure{}).Distinct("context_id"),
+	).Delete(&models.Context{})
+	if result.Error != nil {
+		return 0, eris.Wrap(result.Error, "error deleting orphaned contexts")
+	}
+	return result.RowsAffected, nil
+}
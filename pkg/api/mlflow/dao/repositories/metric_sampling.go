@@ -0,0 +1,352 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// MetricSampling represents optional downsampling parameters of metric histories.
+type MetricSampling struct {
+	// NumPoints is the maximum number of points of every series. Zero disables downsampling.
+	NumPoints int
+	// Method is the downsampling method. Empty means request.SamplingMethodLTTB.
+	Method request.SamplingMethod
+	// StartStep limits the histories to the steps greater than or equal to it, when provided.
+	StartStep *int64
+	// EndStep limits the histories to the steps less than or equal to it, when provided.
+	EndStep *int64
+}
+
+// IsEnabled makes check that downsampling has been requested.
+func (s MetricSampling) IsEnabled() bool {
+	return s.NumPoints > 0
+}
+
+// applyStepRange adds step range conditions to the query of `metrics` table.
+func (s MetricSampling) applyStepRange(query *gorm.DB) *gorm.DB {
+	if s.StartStep != nil {
+		query = query.Where("metrics.step >= ?", *s.StartStep)
+	}
+	if s.EndStep != nil {
+		query = query.Where("metrics.step <= ?", *s.EndStep)
+	}
+	return query
+}
+
+// lttbPreselectionRatio is the number of points per downsampled point, which are preselected for LTTB.
+const lttbPreselectionRatio = 4
+
+// getPreselection returns the number of buckets, which the points of the long series are preselected from,
+// and the maximal number of the preselected points of a series.
+func (s MetricSampling) getPreselection() (int, int) {
+	if s.Method == request.SamplingMethodMinMax {
+		return (s.NumPoints - 2) / 2, s.NumPoints
+	}
+	buckets := s.NumPoints * lttbPreselectionRatio / 2
+	return buckets, buckets*2 + 2
+}
+
+// getRowsLimit returns the number of the preselected points, which is enough to downsample
+// the first numPoints points. Every series is read as a whole, so the last one is fully included.
+func (s MetricSampling) getRowsLimit(numPoints int) int {
+	_, maxPoints := s.getPreselection()
+	return numPoints*((maxPoints+s.NumPoints-1)/s.NumPoints) + maxPoints
+}
+
+// preselect wraps the query of `metrics` table into the query, which keeps only the points downsampling
+// can select: all the points of the short series, the first and the last points of the long ones
+// and the points with the minimal and the maximal value of every bucket of them.
+// For min/max downsampling these are exactly the downsampled points, while LTTB runs on them afterwards
+// the way MinMaxLTTB does, so only a bounded number of points of every series is read from the database.
+// The query selects provided columns, which have to include `metrics.*`, and is split into series
+// by run, key and context.
+func (s MetricSampling) preselect(db *gorm.DB, query *gorm.DB, columns ...string) *gorm.DB {
+	series := "PARTITION BY metrics.run_uuid, metrics.key, metrics.context_id"
+	buckets, maxPoints := s.getPreselection()
+
+	ranked := query.Select(append(
+		columns,
+		fmt.Sprintf(
+			"ROW_NUMBER() OVER (%s ORDER BY metrics.step, metrics.timestamp, metrics.value, metrics.is_nan) - 1 "+
+				"AS series_index",
+			series,
+		),
+		fmt.Sprintf("COUNT(*) OVER (%s) AS series_size", series),
+	))
+
+	// points, which are always kept, have -1 bucket, the rest is split into the buckets evenly
+	// the same way as minMaxIndices does.
+	bucket := "-2"
+	if buckets > 0 {
+		bucket = fmt.Sprintf("(metrics.series_index * %d - 1) / (metrics.series_size - 2)", buckets)
+	}
+	bucketed := db.Table("(?) AS metrics", ranked).Select(
+		"metrics.*",
+		fmt.Sprintf(
+			"CASE WHEN metrics.series_size <= %d OR metrics.series_index = 0 "+
+				"OR metrics.series_index = metrics.series_size - 1 THEN -1 ELSE %s END AS series_bucket",
+			maxPoints, bucket,
+		),
+	)
+
+	ordered := db.Table("(?) AS metrics", bucketed).Select(
+		"metrics.*",
+		fmt.Sprintf(
+			"ROW_NUMBER() OVER (%s, metrics.series_bucket ORDER BY metrics.value, metrics.series_index) AS min_rank",
+			series,
+		),
+		fmt.Sprintf(
+			"ROW_NUMBER() OVER (%s, metrics.series_bucket ORDER BY metrics.value DESC, metrics.series_index) "+
+				"AS max_rank",
+			series,
+		),
+	)
+
+	return db.Table("(?) AS metrics", ordered).Where(
+		"metrics.series_bucket = -1 OR (metrics.series_bucket >= 0 AND (metrics.min_rank = 1 OR metrics.max_rank = 1))",
+	)
+}
+
+// sampleSeries downsamples a single series of points ordered by step to at most sampling.NumPoints points.
+// The first and the last points of the series are always kept.
+func sampleSeries[T any](series []T, sampling MetricSampling, point func(*T) (float64, float64)) []T {
+	if !sampling.IsEnabled() || len(series) <= sampling.NumPoints || len(series) < 3 {
+		return series
+	}
+
+	xs, ys := make([]float64, len(series)), make([]float64, len(series))
+	for i := range series {
+		xs[i], ys[i] = point(&series[i])
+	}
+
+	var indices []int
+	switch sampling.Method {
+	case request.SamplingMethodMinMax:
+		indices = minMaxIndices(ys, sampling.NumPoints)
+	default:
+		indices = lttbIndices(xs, ys, sampling.NumPoints)
+	}
+
+	sampled := make([]T, len(indices))
+	for i, index := range indices {
+		sampled[i] = series[index]
+	}
+	return sampled
+}
+
+// lttbIndices selects numPoints points with Largest-Triangle-Three-Buckets algorithm.
+// It expects numPoints to be at least 3 and less than the number of points.
+func lttbIndices(xs, ys []float64, numPoints int) []int {
+	indices := make([]int, 0, numPoints)
+	indices = append(indices, 0)
+
+	// the first and the last points are kept, the rest is split into numPoints-2 buckets.
+	bucketSize := float64(len(xs)-2) / float64(numPoints-2)
+	selected := 0
+	for bucket := 0; bucket < numPoints-2; bucket++ {
+		// the third vertex of the triangle is the average point of the next bucket.
+		nextStart := int(float64(bucket+1)*bucketSize) + 1
+		nextEnd := min(int(float64(bucket+2)*bucketSize)+1, len(xs))
+		avgX, avgY := 0.0, 0.0
+		for i := nextStart; i < nextEnd; i++ {
+			avgX += xs[i]
+			avgY += ys[i]
+		}
+		avgX /= float64(nextEnd - nextStart)
+		avgY /= float64(nextEnd - nextStart)
+
+		start, end := int(float64(bucket)*bucketSize)+1, int(float64(bucket+1)*bucketSize)+1
+		maxArea, maxIndex := -1.0, start
+		for i := start; i < end; i++ {
+			area := math.Abs(
+				(xs[selected]-avgX)*(ys[i]-ys[selected]) - (xs[selected]-xs[i])*(avgY-ys[selected]),
+			)
+			if area > maxArea {
+				maxArea, maxIndex = area, i
+			}
+		}
+		indices = append(indices, maxIndex)
+		selected = maxIndex
+	}
+
+	return append(indices, len(xs)-1)
+}
+
+// minMaxIndices splits the points into (numPoints-2)/2 buckets and selects the points with
+// the minimal and the maximal value of every bucket, so that the peaks of the series are preserved.
+// It expects numPoints to be at least 3 and less than the number of points.
+func minMaxIndices(ys []float64, numPoints int) []int {
+	indices := make([]int, 0, numPoints)
+	indices = append(indices, 0)
+
+	// the bounds of the buckets are calculated with integers, so they match the ones of MetricSampling.preselect.
+	if buckets := (numPoints - 2) / 2; buckets > 0 {
+		for bucket := 0; bucket < buckets; bucket++ {
+			start, end := bucket*(len(ys)-2)/buckets+1, (bucket+1)*(len(ys)-2)/buckets+1
+			minIndex, maxIndex := start, start
+			for i := start + 1; i < end; i++ {
+				if ys[i] < ys[minIndex] {
+					minIndex = i
+				}
+				if ys[i] > ys[maxIndex] {
+					maxIndex = i
+				}
+			}
+			// keep the original order of the points.
+			indices = append(indices, min(minIndex, maxIndex))
+			if minIndex != maxIndex {
+				indices = append(indices, max(minIndex, maxIndex))
+			}
+		}
+	}
+
+	return append(indices, len(ys)-1)
+}
+
+// sampleMetrics downsamples every series of metrics grouped by series and ordered by step.
+func sampleMetrics(metrics []models.Metric, sampling MetricSampling) []models.Metric {
+	sampled := make([]models.Metric, 0, len(metrics))
+	for start, end := 0, 0; start < len(metrics); start = end {
+		for end = start + 1; end < len(metrics); end++ {
+			if metrics[start].RunID != metrics[end].RunID ||
+				metrics[start].Key != metrics[end].Key ||
+				!isSameContext(metrics[start].ContextID, metrics[end].ContextID) {
+				break
+			}
+		}
+		sampled = append(sampled, sampleSeries(metrics[start:end], sampling, modelMetricPoint)...)
+	}
+	return sampled
+}
+
+// modelMetricPoint returns the coordinates of models.Metric used by downsampling.
+func modelMetricPoint(metric *models.Metric) (float64, float64) {
+	return float64(metric.Step), metric.Value
+}
+
+// metricPoint returns the coordinates of database.Metric used by downsampling.
+func metricPoint(metric *database.Metric) (float64, float64) {
+	return float64(metric.Step), metric.Value
+}
+
+// MetricIterator iterates over metric histories.
+type MetricIterator interface {
+	// Next prepares the next metric for reading with Scan. It returns false when there are no more metrics.
+	Next() bool
+	// Scan copies the current metric into the provided one.
+	Scan(metric *database.Metric) error
+	// Err returns the error, if any, that was encountered during iteration.
+	Err() error
+	// Close closes the iterator and releases underlying resources.
+	Close() error
+}
+
+// rowsMetricIterator iterates over the rows of `metrics` query.
+type rowsMetricIterator struct {
+	db   *gorm.DB
+	rows *sql.Rows
+}
+
+// Next prepares the next metric for reading with Scan.
+func (i *rowsMetricIterator) Next() bool {
+	return i.rows.Next()
+}
+
+// Scan copies the current metric into the provided one.
+func (i *rowsMetricIterator) Scan(metric *database.Metric) error {
+	return i.db.ScanRows(i.rows, metric)
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (i *rowsMetricIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Close closes the underlying rows.
+func (i *rowsMetricIterator) Close() error {
+	return i.rows.Close()
+}
+
+// sampledMetricIterator downsamples every series of the underlying iterator.
+// The underlying iterator has to return the metrics grouped by series and ordered by step.
+type sampledMetricIterator struct {
+	MetricIterator
+	sampling MetricSampling
+	limit    int
+	returned int
+	next     *database.Metric
+	series   []database.Metric
+	current  int
+	err      error
+}
+
+// Next prepares the next downsampled metric for reading with Scan.
+func (i *sampledMetricIterator) Next() bool {
+	if i.err != nil || i.returned >= i.limit {
+		return false
+	}
+	if i.current+1 < len(i.series) {
+		i.current++
+		i.returned++
+		return true
+	}
+
+	// read the next series from the underlying iterator.
+	i.series, i.current = i.series[:0], 0
+	if i.next != nil {
+		i.series = append(i.series, *i.next)
+		i.next = nil
+	}
+	for i.MetricIterator.Next() {
+		var metric database.Metric
+		if err := i.MetricIterator.Scan(&metric); err != nil {
+			i.err = err
+			return false
+		}
+		if len(i.series) > 0 && !isSameSeries(&i.series[0], &metric) {
+			i.next = &metric
+			break
+		}
+		i.series = append(i.series, metric)
+	}
+	if len(i.series) == 0 {
+		return false
+	}
+	i.series = sampleSeries(i.series, i.sampling, metricPoint)
+	i.returned++
+	return true
+}
+
+// Scan copies the current downsampled metric into the provided one.
+func (i *sampledMetricIterator) Scan(metric *database.Metric) error {
+	*metric = i.series[i.current]
+	return nil
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (i *sampledMetricIterator) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.MetricIterator.Err()
+}
+
+// isSameSeries makes check that both metrics belong to the same series.
+func isSameSeries(a, b *database.Metric) bool {
+	return a.RunID == b.RunID && a.Key == b.Key && isSameContext(a.ContextID, b.ContextID)
+}
+
+// isSameContext makes check that both context ids are equal.
+func isSameContext(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// sliceMetricIterator iterates over the metrics of a slice.
+type sliceMetricIterator struct {
+	metrics []database.Metric
+	current int
+}
+
+func (i *sliceMetricIterator) Next() bool {
+	i.current++
+	return i.current <= len(i.metrics)
+}
+
+func (i *sliceMetricIterator) Scan(metric *database.Metric) error {
+	*metric = i.metrics[i.current-1]
+	return nil
+}
+
+func (i *sliceMetricIterator) Err() error {
+	return nil
+}
+
+func (i *sliceMetricIterator) Close() error {
+	return nil
+}
+
+func Test_lttbIndices(t *testing.T) {
+	xs := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	ys := []float64{0, 5, 1, 9, 2, 3, -4, 1, 0, 7}
+	assert.Equal(t, []int{0, 3, 6, 9}, lttbIndices(xs, ys, 4))
+	assert.Equal(t, []int{0, 6, 9}, lttbIndices(xs, ys, 3))
+}
+
+func Test_minMaxIndices(t *testing.T) {
+	ys := []float64{0, 5, 1, 9, 2, 3, -4, 1, 0, 7}
+	assert.Equal(t, []int{0, 2, 3, 5, 6, 9}, minMaxIndices(ys, 6))
+	assert.Equal(t, []int{0, 3, 6, 9}, minMaxIndices(ys, 4))
+	assert.Equal(t, []int{0, 9}, minMaxIndices(ys, 3))
+}
+
+func Test_sampleMetrics(t *testing.T) {
+	metrics := []models.Metric{
+		{RunID: "run1", Key: "key", Step: 0, Value: 0},
+		{RunID: "run1", Key: "key", Step: 1, Value: 5},
+		{RunID: "run1", Key: "key", Step: 2, Value: 1},
+		{RunID: "run1", Key: "key", Step: 3, Value: 9},
+		{RunID: "run1", Key: "key", Step: 4, Value: 2},
+		{RunID: "run1", Key: "key", ContextID: common.GetPointer[uint](1), Step: 0, Value: 1},
+		{RunID: "run1", Key: "key", ContextID: common.GetPointer[uint](1), Step: 1, Value: 2},
+		{RunID: "run2", Key: "key", Step: 0, Value: 3},
+	}
+
+	tests := []struct {
+		name     string
+		sampling MetricSampling
+		expected []models.Metric
+	}{
+		{
+			name:     "Disabled",
+			sampling: MetricSampling{},
+			expected: metrics,
+		},
+		{
+			name:     "LTTB",
+			sampling: MetricSampling{NumPoints: 3},
+			expected: []models.Metric{metrics[0], metrics[3], metrics[4], metrics[5], metrics[6], metrics[7]},
+		},
+		{
+			name:     "MinMax",
+			sampling: MetricSampling{NumPoints: 4, Method: request.SamplingMethodMinMax},
+			expected: []models.Metric{
+				metrics[0], metrics[2], metrics[3], metrics[4], metrics[5], metrics[6], metrics[7],
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sampleMetrics(metrics, tt.sampling))
+		})
+	}
+}
+
+func Test_sampledMetricIterator(t *testing.T) {
+	metrics := []database.Metric{
+		{RunID: "run1", Key: "key1", Step: 0, Value: 0},
+		{RunID: "run1", Key: "key1", Step: 1, Value: 5},
+		{RunID: "run1", Key: "key1", Step: 2, Value: 1},
+		{RunID: "run1", Key: "key1", Step: 3, Value: 9},
+		{RunID: "run1", Key: "key1", Step: 4, Value: 2},
+		{RunID: "run1", Key: "key2", Step: 0, Value: 1},
+		{RunID: "run2", Key: "key1", Step: 0, Value: 3},
+		{RunID: "run2", Key: "key1", Step: 1, Value: 4},
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		expected []database.Metric
+	}{
+		{
+			name:     "WithoutLimit",
+			limit:    MetricHistoriesDefaultLimit,
+			expected: []database.Metric{metrics[0], metrics[3], metrics[4], metrics[5], metrics[6], metrics[7]},
+		},
+		{
+			name:     "WithLimit",
+			limit:    4,
+			expected: []database.Metric{metrics[0], metrics[3], metrics[4], metrics[5]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iterator := sampledMetricIterator{
+				MetricIterator: &sliceMetricIterator{metrics: metrics},
+				sampling:       MetricSampling{NumPoints: 3},
+				limit:          tt.limit,
+			}
+			var result []database.Metric
+			for iterator.Next() {
+				var metric database.Metric
+				require.Nil(t, iterator.Scan(&metric))
+				result = append(result, metric)
+			}
+			require.Nil(t, iterator.Err())
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMetricSampling_getRowsLimit(t *testing.T) {
+	minMax := MetricSampling{Method: request.SamplingMethodMinMax, NumPoints: 10}
+	assert.Equal(t, 30, minMax.getRowsLimit(20))
+	lttb := MetricSampling{Method: request.SamplingMethodLTTB, NumPoints: 10}
+	assert.Equal(t, 142, lttb.getRowsLimit(20))
+}
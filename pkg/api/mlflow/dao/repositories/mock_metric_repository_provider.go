@@ -12,8 +12,6 @@ import (
 	models "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 
 	request "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
-
-	sql "database/sql"
 )
 
 // MockMetricRepositoryProvider is an autogenerated mock type for the MetricRepositoryProvider type
@@ -35,6 +33,30 @@ func (_m *MockMetricRepositoryProvider) CreateBatch(ctx context.Context, run *mo
 	return r0
 }
 
+// DeleteOrphanedContexts provides a mock function with given fields: ctx
+func (_m *MockMetricRepositoryProvider) DeleteOrphanedContexts(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDB provides a mock function with given fields:
 func (_m *MockMetricRepositoryProvider) GetDB() *gorm.DB {
 	ret := _m.Called()
@@ -51,60 +73,51 @@ func (_m *MockMetricRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
-// GetMetricHistories provides a mock function with given fields: ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap
-func (_m *MockMetricRepositoryProvider) GetMetricHistories(ctx context.Context, namespaceID uint, experimentIDs []string, runIDs []string, metricKeys []string, viewType request.ViewType, limit int32, jsonPathValueMap map[string]string) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
-	ret := _m.Called(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+// GetMetricHistories provides a mock function with given fields: ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap, sampling
+func (_m *MockMetricRepositoryProvider) GetMetricHistories(ctx context.Context, namespaceID uint, experimentIDs []string, runIDs []string, metricKeys []string, viewType request.ViewType, limit int32, jsonPathValueMap map[string]string, sampling MetricSampling) (MetricIterator, error) {
+	ret := _m.Called(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap, sampling)
 
-	var r0 *sql.Rows
-	var r1 func(*sql.Rows, interface{}) error
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) (*sql.Rows, func(*sql.Rows, interface{}) error, error)); ok {
-		return rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	var r0 MetricIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string, MetricSampling) (MetricIterator, error)); ok {
+		return rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap, sampling)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) *sql.Rows); ok {
-		r0 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string, MetricSampling) MetricIterator); ok {
+		r0 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap, sampling)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sql.Rows)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) func(*sql.Rows, interface{}) error); ok {
-		r1 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func(*sql.Rows, interface{}) error)
+			r0 = ret.Get(0).(MetricIterator)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) error); ok {
-		r2 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string, MetricSampling) error); ok {
+		r1 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap, sampling)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, limit
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, limit int) ([]models.Metric, error) {
-	ret := _m.Called(ctx, namespaceID, runIDs, key, limit)
+// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, sampling, limit
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, sampling MetricSampling, limit int) ([]models.Metric, error) {
+	ret := _m.Called(ctx, namespaceID, runIDs, key, sampling, limit)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) ([]models.Metric, error)); ok {
-		return rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, MetricSampling, int) ([]models.Metric, error)); ok {
+		return rf(ctx, namespaceID, runIDs, key, sampling, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) []models.Metric); ok {
-		r0 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, MetricSampling, int) []models.Metric); ok {
+		r0 = rf(ctx, namespaceID, runIDs, key, sampling, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, int) error); ok {
-		r1 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, MetricSampling, int) error); ok {
+		r1 = rf(ctx, namespaceID, runIDs, key, sampling, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,25 +125,25 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context
 	return r0, r1
 }
 
-// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string) ([]models.Metric, error) {
-	ret := _m.Called(ctx, runID, key)
+// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key, sampling, limit, offset
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string, sampling MetricSampling, limit int, offset int) ([]models.Metric, error) {
+	ret := _m.Called(ctx, runID, key, sampling, limit, offset)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Metric, error)); ok {
-		return rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, MetricSampling, int, int) ([]models.Metric, error)); ok {
+		return rf(ctx, runID, key, sampling, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Metric); ok {
-		r0 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, MetricSampling, int, int) []models.Metric); ok {
+		r0 = rf(ctx, runID, key, sampling, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, MetricSampling, int, int) error); ok {
+		r1 = rf(ctx, runID, key, sampling, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
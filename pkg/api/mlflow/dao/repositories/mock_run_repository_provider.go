@@ -181,6 +181,72 @@ func (_m *MockRunRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
+// GetDeletedBefore provides a mock function with given fields: ctx, namespaceIDs, deletedTime
+func (_m *MockRunRepositoryProvider) GetDeletedBefore(ctx context.Context, namespaceIDs []uint, deletedTime int64) ([]models.Run, error) {
+	ret := _m.Called(ctx, namespaceIDs, deletedTime)
+
+	var r0 []models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, int64) ([]models.Run, error)); ok {
+		return rf(ctx, namespaceIDs, deletedTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, int64) []models.Run); ok {
+		r0 = rf(ctx, namespaceIDs, deletedTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint, int64) error); ok {
+		r1 = rf(ctx, namespaceIDs, deletedTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDescendantsByNamespaceIDAndRunID provides a mock function with given fields: ctx, namespaceID, runID
+func (_m *MockRunRepositoryProvider) GetDescendantsByNamespaceIDAndRunID(ctx context.Context, namespaceID uint, runID string) ([]models.Run, error) {
+	ret := _m.Called(ctx, namespaceID, runID)
+
+	var r0 []models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) ([]models.Run, error)); ok {
+		return rf(ctx, namespaceID, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) []models.Run); ok {
+		r0 = rf(ctx, namespaceID, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, namespaceID, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeBatch provides a mock function with given fields: ctx, ids
+func (_m *MockRunRepositoryProvider) PurgeBatch(ctx context.Context, ids []string) error {
+	ret := _m.Called(ctx, ids)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Restore provides a mock function with given fields: ctx, run
 func (_m *MockRunRepositoryProvider) Restore(ctx context.Context, run *models.Run) error {
 	ret := _m.Called(ctx, run)
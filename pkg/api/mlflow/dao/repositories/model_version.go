@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelVersionRepositoryProvider provides an interface to work with models.ModelVersion entity.
+type ModelVersionRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.ModelVersion entity with the next available version number.
+	Create(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Update updates existing models.ModelVersion entity.
+	Update(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Delete marks existing models.ModelVersion entity as deleted and removes its tags and aliases.
+	Delete(ctx context.Context, modelVersion *models.ModelVersion) error
+	// GetByRegisteredModelIDAndVersion returns models.ModelVersion by Registered Model ID and version number.
+	GetByRegisteredModelIDAndVersion(
+		ctx context.Context, registeredModelID uuid.UUID, version int32,
+	) (*models.ModelVersion, error)
+	// TransitionStage moves models.ModelVersion into its new stage, optionally archiving
+	// the other versions of the same registered model which are currently in that stage.
+	TransitionStage(ctx context.Context, modelVersion *models.ModelVersion, archiveExistingVersions bool) error
+	// SetTag creates or updates models.ModelVersionTag entity.
+	SetTag(ctx context.Context, tag *models.ModelVersionTag) error
+	// DeleteTag deletes existing models.ModelVersionTag entity.
+	DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error
+}
+
+// ModelVersionRepository repository to work with models.ModelVersion entity.
+type ModelVersionRepository struct {
+	BaseRepository
+}
+
+// NewModelVersionRepository creates repository to work with models.ModelVersion entity.
+func NewModelVersionRepository(db *gorm.DB) *ModelVersionRepository {
+	return &ModelVersionRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.ModelVersion entity with the next available version number.
+func (r ModelVersionRepository) Create(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// deleted versions are kept in the table, so their numbers will never be reused.
+		var version sql.NullInt32
+		if err := tx.Model(
+			&models.ModelVersion{},
+		).Where(
+			"registered_model_id = ?", modelVersion.RegisteredModelID,
+		).Pluck("MAX(version)", &version).Error; err != nil {
+			return eris.Wrap(err, "error getting latest model version")
+		}
+		modelVersion.Version = version.Int32 + 1
+
+		if err := tx.Omit("RegisteredModel").Create(modelVersion).Error; err != nil {
+			return eris.Wrap(err, "error creating model version")
+		}
+
+		if err := tx.Model(
+			&models.RegisteredModel{ID: modelVersion.RegisteredModelID},
+		).Update(
+			"LastUpdatedTime", modelVersion.CreationTime,
+		).Error; err != nil {
+			return eris.Wrap(err, "error updating registered model")
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(
+			err, "error creating model version for registered model with id: %s", modelVersion.RegisteredModelID,
+		)
+	}
+	return nil
+}
+
+// Update updates existing models.ModelVersion entity.
+func (r ModelVersionRepository) Update(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Model(
+		modelVersion,
+	).Select(
+		"Description", "LastUpdatedTime",
+	).Updates(modelVersion).Error; err != nil {
+		return eris.Wrapf(err, "error updating model version with id: %s", modelVersion.ID)
+	}
+	return nil
+}
+
+// Delete marks existing models.ModelVersion entity as deleted and removes its tags and aliases.
+func (r ModelVersionRepository) Delete(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(
+			"model_version_id = ?", modelVersion.ID,
+		).Delete(&models.ModelVersionTag{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting model version tags")
+		}
+		if err := tx.Where(
+			"registered_model_id = ? AND version = ?", modelVersion.RegisteredModelID, modelVersion.Version,
+		).Delete(&models.RegisteredModelAlias{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting model version aliases")
+		}
+		modelVersion.CurrentStage = models.ModelVersionStageDeletedInternal
+		return tx.Model(modelVersion).Select("CurrentStage", "LastUpdatedTime").Updates(modelVersion).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting model version with id: %s", modelVersion.ID)
+	}
+	return nil
+}
+
+// GetByRegisteredModelIDAndVersion returns models.ModelVersion by Registered Model ID and version number.
+func (r ModelVersionRepository) GetByRegisteredModelIDAndVersion(
+	ctx context.Context, registeredModelID uuid.UUID, version int32,
+) (*models.ModelVersion, error) {
+	var modelVersion models.ModelVersion
+	if err := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Where(
+		"registered_model_id = ?", registeredModelID,
+	).Where(
+		"version = ?", version,
+	).Where(
+		"current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	).First(&modelVersion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(
+			err, "error getting model version by registered model id: %s and version: %d", registeredModelID, version,
+		)
+	}
+	return &modelVersion, nil
+}
+
+// TransitionStage moves models.ModelVersion into its new stage, optionally archiving
+// the other versions of the same registered model which are currently in that stage.
+func (r ModelVersionRepository) TransitionStage(
+	ctx context.Context, modelVersion *models.ModelVersion, archiveExistingVersions bool,
+) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if archiveExistingVersions {
+			if err := tx.Model(
+				&models.ModelVersion{},
+			).Where(
+				"registered_model_id = ?", modelVersion.RegisteredModelID,
+			).Where(
+				"current_stage = ?", modelVersion.CurrentStage,
+			).Where(
+				"version <> ?", modelVersion.Version,
+			).Updates(map[string]any{
+				"current_stage":     models.ModelVersionStageArchived,
+				"last_updated_time": modelVersion.LastUpdatedTime,
+			}).Error; err != nil {
+				return eris.Wrap(err, "error archiving existing model versions")
+			}
+		}
+		return tx.Model(modelVersion).Select("CurrentStage", "LastUpdatedTime").Updates(modelVersion).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error transitioning stage of model version with id: %s", modelVersion.ID)
+	}
+	return nil
+}
+
+// SetTag creates or updates models.ModelVersionTag entity.
+func (r ModelVersionRepository) SetTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag for model version with id: %s", tag.ModelVersionID)
+	}
+	return nil
+}
+
+// DeleteTag deletes existing models.ModelVersionTag entity.
+func (r ModelVersionRepository) DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.db.WithContext(ctx).Delete(tag).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag by model version id: %s and key: %s", tag.ModelVersionID, tag.Key)
+	}
+	return nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
@@ -27,6 +29,12 @@ type RunRepositoryProvider interface {
 	GetByNamespaceIDAndRunID(
 		ctx context.Context, namespaceID uint, runID string,
 	) (*models.Run, error)
+	// GetDescendantsByNamespaceIDAndRunID returns all the models.Run entities, which are children
+	// of the run with provided ID either directly or through the other children.
+	GetDescendantsByNamespaceIDAndRunID(ctx context.Context, namespaceID uint, runID string) ([]models.Run, error)
+	// GetDeletedBefore returns models.Run entities, which have been deleted before provided time.
+	// Empty namespaceIDs means that runs of all the namespaces are returned.
+	GetDeletedBefore(ctx context.Context, namespaceIDs []uint, deletedTime int64) ([]models.Run, error)
 	// Create creates new models.Run entity.
 	Create(ctx context.Context, run *models.Run) error
 	// Update updates existing models.Experiment entity.
@@ -37,11 +45,13 @@ type RunRepositoryProvider interface {
 	Delete(ctx context.Context, namespaceID uint, run *models.Run) error
 	// Restore marks existing models.Run entity as active.
 	Restore(ctx context.Context, run *models.Run) error
-	// ArchiveBatch marks existing models.Run entities as archived.
+	// ArchiveBatch marks existing models.Run entities and their descendants as archived.
 	ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error
-	// DeleteBatch removes the existing models.Run from the db.
+	// DeleteBatch removes the existing models.Run and their descendants from the db.
 	DeleteBatch(ctx context.Context, namespaceID uint, ids []string) error
-	// RestoreBatch marks existing models.Run entities as active.
+	// PurgeBatch permanently removes existing models.Run entities from the db, leaving their descendants untouched.
+	PurgeBatch(ctx context.Context, ids []string) error
+	// RestoreBatch marks existing models.Run entities and their descendants as active.
 	RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error
 	// SetRunTagsBatch sets Run tags in batch.
 	SetRunTagsBatch(ctx context.Context, run *models.Run, batchSize int, tags []models.Tag) error
@@ -120,6 +130,10 @@ func (r RunRepository) GetByNamespaceIDAndRunID(
 		"Params",
 	).Preload(
 		"Tags",
+	).Preload(
+		"Inputs.Dataset",
+	).Preload(
+		"Inputs.Tags",
 	).Joins(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
@@ -132,6 +146,60 @@ func (r RunRepository) GetByNamespaceIDAndRunID(
 	return &run, nil
 }
 
+// GetDescendantsByNamespaceIDAndRunID returns all the models.Run entities, which are children
+// of the run with provided ID either directly or through the other children.
+func (r RunRepository) GetDescendantsByNamespaceIDAndRunID(
+	ctx context.Context, namespaceID uint, runID string,
+) ([]models.Run, error) {
+	ids, err := r.getDescendantIDs(r.db.WithContext(ctx), namespaceID, []string{runID})
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting descendants of run with id: %s", runID)
+	}
+
+	var runs []models.Run
+	if len(ids) == 0 {
+		return runs, nil
+	}
+	if err := r.db.WithContext(
+		ctx,
+	).Preload(
+		"Tags",
+	).Where(
+		"run_uuid IN ?", ids,
+	).Order(
+		"start_time",
+	).Order(
+		"run_uuid",
+	).Find(&runs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting descendants of run with id: %s", runID)
+	}
+	return runs, nil
+}
+
+// GetDeletedBefore returns models.Run entities, which have been deleted before provided time.
+// Empty namespaceIDs means that runs of all the namespaces are returned.
+// Runs deleted before `deleted_time` was tracked, e.g. the ones imported from MLflow, don't have it,
+// so their end or start time is used instead, and the runs without any of them are treated as deleted long ago.
+func (r RunRepository) GetDeletedBefore(
+	ctx context.Context, namespaceIDs []uint, deletedTime int64,
+) ([]models.Run, error) {
+	query := r.db.WithContext(ctx).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+	).Where(
+		"runs.lifecycle_stage = ? AND COALESCE(runs.deleted_time, runs.end_time, runs.start_time, 0) < ?",
+		models.LifecycleStageDeleted, deletedTime,
+	)
+	if len(namespaceIDs) > 0 {
+		query = query.Where("experiments.namespace_id IN ?", namespaceIDs)
+	}
+
+	var runs []models.Run
+	if err := query.Order("runs.row_num").Find(&runs).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting deleted runs")
+	}
+	return runs, nil
+}
+
 // Create creates new models.Run entity.
 func (r RunRepository) Create(ctx context.Context, run *models.Run) error {
 	// Lock need to calculate row_num
@@ -170,8 +238,14 @@ func (r RunRepository) Archive(ctx context.Context, run *models.Run) error {
 	return nil
 }
 
-// ArchiveBatch marks existing models.Run entities as archived.
+// ArchiveBatch marks existing models.Run entities and their descendants as archived.
 func (r RunRepository) ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error {
+	descendantIDs, err := r.getDescendantIDs(r.db.WithContext(ctx), namespaceID, ids)
+	if err != nil {
+		return eris.Wrapf(err, "error getting descendants of runs with ids: %s", ids)
+	}
+	ids = append(slices.Clip(ids), descendantIDs...)
+
 	if err := r.db.WithContext(
 		ctx,
 	).Model(
@@ -206,9 +280,15 @@ func (r RunRepository) Delete(ctx context.Context, namespaceID uint, run *models
 	return r.DeleteBatch(ctx, namespaceID, []string{run.ID})
 }
 
-// DeleteBatch removes existing models.Run from the db.
+// DeleteBatch removes existing models.Run and their descendants from the db.
 func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []string) error {
 	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		descendantIDs, err := r.getDescendantIDs(tx, namespaceID, ids)
+		if err != nil {
+			return eris.Wrapf(err, "error getting descendants of runs with ids: %s", ids)
+		}
+		ids = append(slices.Clip(ids), descendantIDs...)
+
 		runs := make([]models.Run, 0, len(ids))
 		if err := tx.Clauses(
 			clause.Returning{Columns: []clause.Column{{Name: "row_num"}}},
@@ -248,6 +328,34 @@ func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []
 	return nil
 }
 
+// PurgeBatch permanently removes existing models.Run entities from the db, leaving their descendants untouched.
+func (r RunRepository) PurgeBatch(ctx context.Context, ids []string) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		runs := make([]models.Run, 0, len(ids))
+		if err := tx.Clauses(
+			clause.Returning{Columns: []clause.Column{{Name: "row_num"}}},
+		).Where(
+			"run_uuid IN ?", ids,
+		).Delete(
+			&runs,
+		).Error; err != nil {
+			return eris.Wrapf(err, "error deleting existing runs with ids: %s", ids)
+		}
+
+		// renumber the remainder
+		if len(runs) > 0 {
+			if err := r.renumberRows(tx, getMinRowNum(runs)); err != nil {
+				return eris.Wrapf(err, "error renumbering runs.row_num")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error purging runs")
+	}
+
+	return nil
+}
+
 // Restore marks existing models.Run entity as active.
 func (r RunRepository) Restore(ctx context.Context, run *models.Run) error {
 	// Use UpdateColumns so we can reset DeletedTime to null
@@ -261,8 +369,14 @@ func (r RunRepository) Restore(ctx context.Context, run *models.Run) error {
 	return nil
 }
 
-// RestoreBatch marks existing models.Run entities as active.
+// RestoreBatch marks existing models.Run entities and their descendants as active.
 func (r RunRepository) RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error {
+	descendantIDs, err := r.getDescendantIDs(r.db.WithContext(ctx), namespaceID, ids)
+	if err != nil {
+		return eris.Wrapf(err, "error getting descendants of runs with ids: %s", ids)
+	}
+	ids = append(slices.Clip(ids), descendantIDs...)
+
 	if err := r.db.WithContext(
 		ctx,
 	).Where(
@@ -326,6 +440,45 @@ func (r RunRepository) SetRunTagsBatch(ctx context.Context, run *models.Run, bat
 	return nil
 }
 
+// getDescendantIDs walks through `mlflow.parentRunId` tags level by level and returns ids of all
+// the runs, which descend from the provided runs. Provided ids are never included into the result,
+// so the walk stops even when tags form a cycle.
+func (r RunRepository) getDescendantIDs(tx *gorm.DB, namespaceID uint, ids []string) ([]string, error) {
+	visited := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		visited[id] = struct{}{}
+	}
+
+	var descendantIDs []string
+	for parentIDs := ids; len(parentIDs) > 0; {
+		var childIDs []string
+		if err := tx.Model(
+			models.Tag{},
+		).Joins(
+			"INNER JOIN runs ON runs.run_uuid = tags.run_uuid",
+		).Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			namespaceID,
+		).Where(
+			"tags.key = ? AND tags.value IN ?", common.ParentRunIDTagKey, parentIDs,
+		).Pluck(
+			"tags.run_uuid", &childIDs,
+		).Error; err != nil {
+			return nil, eris.Wrap(err, "error getting child runs")
+		}
+
+		parentIDs = nil
+		for _, id := range childIDs {
+			if _, ok := visited[id]; !ok {
+				visited[id] = struct{}{}
+				parentIDs = append(parentIDs, id)
+			}
+		}
+		descendantIDs = append(descendantIDs, parentIDs...)
+	}
+	return descendantIDs, nil
+}
+
 // getMinRowNum will find the lowest row_num for the slice of runs
 // or 0 for an empty slice
 func getMinRowNum(runs []models.Run) models.RowNum {
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -69,3 +70,33 @@ func Test_renumberRows(t *testing.T) {
 		})
 	}
 }
+
+func TestRunRepository_GetDeletedBefore(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	require.Nil(t, err)
+	//nolint:errcheck
+	defer mockDb.Close()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		Conn:       mockDb,
+		DriverName: "postgres",
+	}), &gorm.Config{})
+	require.Nil(t, err)
+
+	// runs without `deleted_time` fall back to their end and start time.
+	mock.ExpectQuery(
+		`SELECT "runs"."run_uuid",.* FROM "runs" INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id `+
+			`WHERE \(runs.lifecycle_stage = \$1 AND `+
+			`COALESCE\(runs.deleted_time, runs.end_time, runs.start_time, 0\) < \$2\) `+
+			`AND experiments.namespace_id IN \(\$3\) ORDER BY runs.row_num`,
+	).WithArgs(
+		models.LifecycleStageDeleted, int64(1000), uint(1),
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"run_uuid"}).AddRow("id"),
+	)
+
+	runs, err := NewRunRepository(db).GetDeletedBefore(context.Background(), []uint{1}, 1000)
+	require.Nil(t, err)
+	assert.Equal(t, []models.Run{{ID: "id"}}, runs)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
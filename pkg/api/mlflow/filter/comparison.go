@@ -0,0 +1,96 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// ValidateOperator checks that comparison operator belongs to one of the provided operator groups.
+// kind describes compared value in the error message, for example `tag` or `numeric attribute`.
+func (c Comparison) ValidateOperator(kind string, groups ...[]Operator) error {
+	for _, operators := range groups {
+		if slices.Contains(operators, c.Operator) {
+			return nil
+		}
+	}
+	return NewError(c.OperatorPosition, "invalid %s comparison operator '%s'", kind, c.Operator)
+}
+
+// Value returns the comparison value as is. It is a string for the scalar operators,
+// a list of strings for `IN` and `NOT IN` operators and nil for `IS NULL` and `IS NOT NULL` operators.
+func (c Comparison) Value() any {
+	switch c.Operator {
+	case IsNullOperator, IsNotNullOperator:
+		return nil
+	case InOperator, NotInOperator:
+		values := make([]string, len(c.Values))
+		for i, value := range c.Values {
+			values[i] = value.Text
+		}
+		return values
+	default:
+		return c.Values[0].Text
+	}
+}
+
+// IsNumeric checks whether the values are compared as numbers: either the ordering operator is used
+// with the numeric value or the value is the number without quotes.
+func (c Comparison) IsNumeric() bool {
+	switch c.Operator {
+	case LessOperator, LessOrEqualOperator, GreaterOperator, GreaterOrEqualOperator:
+		if _, err := strconv.ParseFloat(c.Values[0].Text, 64); err == nil {
+			return true
+		}
+	case EqualOperator, NotEqualOperator:
+		if _, err := strconv.ParseFloat(c.Values[0].Raw, 64); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IntegerValue returns the comparison value converted into integer.
+// Only scalar operators are expected, nil is returned for `IS NULL` and `IS NOT NULL` operators.
+func (c Comparison) IntegerValue() (any, error) {
+	if len(c.Values) == 0 {
+		return nil, nil
+	}
+	value, err := strconv.ParseInt(c.Values[0].Text, 10, 64)
+	if err != nil {
+		return nil, NewError(c.Values[0].Position, "invalid numeric value '%s'", c.Values[0].Raw)
+	}
+	return value, nil
+}
+
+// FloatValue returns the comparison value converted into float.
+// Only scalar operators are expected, nil is returned for `IS NULL` and `IS NOT NULL` operators.
+func (c Comparison) FloatValue() (any, error) {
+	if len(c.Values) == 0 {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(c.Values[0].Text, 64)
+	if err != nil {
+		return nil, NewError(c.Values[0].Position, "invalid numeric value '%s'", c.Values[0].Raw)
+	}
+	return value, nil
+}
+
+// Condition builds the clause, which applies the comparison with the provided value to the column.
+func (c Comparison) Condition(column string, value any, dialector string) clause.Expression {
+	switch c.Operator {
+	case IsNullOperator, IsNotNullOperator:
+		return clause.Expr{SQL: fmt.Sprintf("%s %s", column, c.Operator)}
+	case ILikeOperator:
+		// sqlite doesn't support `ILIKE`, so compare lowercase values instead.
+		if s, ok := value.(string); ok && dialector == database.SQLiteDialectorName {
+			return clause.Expr{SQL: fmt.Sprintf("LOWER(%s) LIKE ?", column), Vars: []any{strings.ToLower(s)}}
+		}
+	}
+	return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, c.Operator), Vars: []any{value}}
+}
@@ -0,0 +1,96 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+)
+
+func TestComparison_ValidateOperator(t *testing.T) {
+	comparison := Comparison{Operator: LikeOperator, OperatorPosition: 10}
+	require.Nil(t, comparison.ValidateOperator("tag", StringOperators, ListOperators))
+	assert.EqualError(
+		t,
+		comparison.ValidateOperator("metric", NumericOperators, NullOperators),
+		"invalid filter at position 10: invalid metric comparison operator 'LIKE'",
+	)
+}
+
+func TestComparison_Value(t *testing.T) {
+	values := []Value{{Text: "1", Raw: "'1'"}, {Text: "x", Raw: "x", Position: 5}}
+	assert.Equal(t, "1", Comparison{Operator: EqualOperator, Values: values[:1]}.Value())
+	assert.Equal(t, []string{"1", "x"}, Comparison{Operator: InOperator, Values: values}.Value())
+	assert.Nil(t, Comparison{Operator: IsNullOperator}.Value())
+
+	value, err := Comparison{Operator: LessOperator, Values: values[:1]}.IntegerValue()
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), value)
+	value, err = Comparison{Operator: IsNotNullOperator}.FloatValue()
+	require.Nil(t, err)
+	assert.Nil(t, value)
+	_, err = Comparison{Operator: LessOperator, Values: values[1:]}.FloatValue()
+	assert.EqualError(t, err, "invalid filter at position 5: invalid numeric value 'x'")
+}
+
+func TestComparison_IsNumeric(t *testing.T) {
+	quoted, unquoted := []Value{{Text: "0.1", Raw: "'0.1'"}}, []Value{{Text: "0.1", Raw: "0.1"}}
+	assert.True(t, Comparison{Operator: LessOperator, Values: quoted}.IsNumeric())
+	assert.False(t, Comparison{Operator: LessOperator, Values: []Value{{Text: "b", Raw: "'b'"}}}.IsNumeric())
+	assert.True(t, Comparison{Operator: EqualOperator, Values: unquoted}.IsNumeric())
+	assert.False(t, Comparison{Operator: EqualOperator, Values: quoted}.IsNumeric())
+	assert.False(t, Comparison{Operator: LikeOperator, Values: unquoted}.IsNumeric())
+	assert.False(t, Comparison{Operator: IsNullOperator}.IsNumeric())
+}
+
+func TestComparison_Condition(t *testing.T) {
+	testData := []struct {
+		name       string
+		comparison Comparison
+		value      any
+		dialector  string
+		condition  clause.Expression
+	}{
+		{
+			name:       "Equal",
+			comparison: Comparison{Operator: EqualOperator},
+			value:      "Value",
+			dialector:  "postgres",
+			condition:  clause.Expr{SQL: "column = ?", Vars: []any{"Value"}},
+		},
+		{
+			name:       "NotIn",
+			comparison: Comparison{Operator: NotInOperator},
+			value:      []string{"a", "b"},
+			dialector:  "sqlite",
+			condition:  clause.Expr{SQL: "column NOT IN ?", Vars: []any{[]string{"a", "b"}}},
+		},
+		{
+			name:       "IsNotNull",
+			comparison: Comparison{Operator: IsNotNullOperator},
+			dialector:  "sqlite",
+			condition:  clause.Expr{SQL: "column IS NOT NULL"},
+		},
+		{
+			name:       "ILikeWithPostgres",
+			comparison: Comparison{Operator: ILikeOperator},
+			value:      "Value%",
+			dialector:  "postgres",
+			condition:  clause.Expr{SQL: "column ILIKE ?", Vars: []any{"Value%"}},
+		},
+		{
+			name:       "ILikeWithSqlite",
+			comparison: Comparison{Operator: ILikeOperator},
+			value:      "Value%",
+			dialector:  "sqlite",
+			condition:  clause.Expr{SQL: "LOWER(column) LIKE ?", Vars: []any{"value%"}},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.condition, tt.comparison.Condition("column", tt.value, tt.dialector))
+		})
+	}
+}
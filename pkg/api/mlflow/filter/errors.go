@@ -0,0 +1,25 @@
+package filter
+
+import (
+	"fmt"
+)
+
+// Error represents filter error, which points to the token caused it.
+type Error struct {
+	// Position is 1-based position of the token in the filter.
+	Position int
+	Message  string
+}
+
+// NewError creates new instance of Error object.
+func NewError(position int, format string, args ...any) *Error {
+	return &Error{
+		Position: position,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Error returns error message.
+func (e Error) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Position, e.Message)
+}
@@ -0,0 +1,172 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// number matches numeric literal at the beginning of the string.
+var number = regexp.MustCompile(`^[+-]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][+-]?\d+)?`)
+
+// tokenKind represents kind of the filter token.
+type tokenKind int
+
+// supported token kinds.
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenNumber
+	tokenString
+	tokenQuotedIdentifier
+	tokenOperator
+	tokenDot
+	tokenComma
+	tokenLeftParen
+	tokenRightParen
+)
+
+// token represents single lexeme of the filter.
+type token struct {
+	kind tokenKind
+	// text is the token text without quotes.
+	text string
+	// raw is the token text as it is in the filter.
+	raw string
+	// quote is the quote character of the quoted token.
+	quote byte
+	// position is 1-based position of the token in the filter.
+	position int
+}
+
+// isKeyword checks that token is the provided keyword. Keywords are case-insensitive.
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+// String returns token description to be used in the error messages.
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("'%s'", t.raw)
+}
+
+// tokenize splits the filter into the list of tokens. The last token is always tokenEOF.
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			text, end, ok := scanQuoted(filter, i)
+			if !ok {
+				return nil, NewError(i+1, "unterminated quoted string %s", filter[i:])
+			}
+			kind := tokenString
+			if c == '`' {
+				kind = tokenQuotedIdentifier
+			}
+			tokens = append(tokens, token{kind: kind, text: text, raw: filter[i:end], quote: c, position: i + 1})
+			i = end
+			continue
+		case c == '.' && !isValuePosition(tokens):
+			tokens = append(tokens, token{kind: tokenDot, text: ".", raw: ".", position: i + 1})
+			i++
+			continue
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", raw: ",", position: i + 1})
+			i++
+			continue
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", raw: "(", position: i + 1})
+			i++
+			continue
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", raw: ")", position: i + 1})
+			i++
+			continue
+		case c == '=' || c == '<' || c == '>' || c == '!':
+			end := i + 1
+			if end < len(filter) && filter[end] == '=' {
+				end++
+			}
+			if filter[i:end] == "!" {
+				return nil, NewError(i+1, "unexpected character '!'")
+			}
+			tokens = append(
+				tokens, token{kind: tokenOperator, text: filter[i:end], raw: filter[i:end], position: i + 1},
+			)
+			i = end
+			continue
+		}
+
+		// numbers could be followed by the word characters only if they are the part of the word,
+		// like in the run ids, so check it before reading the word.
+		if match := number.FindString(filter[i:]); match != "" {
+			end := i + len(match)
+			if end == len(filter) || !isWordCharacter(filter[end]) {
+				tokens = append(tokens, token{kind: tokenNumber, text: match, raw: match, position: i + 1})
+				i = end
+				continue
+			}
+		}
+
+		if !isWordCharacter(c) {
+			return nil, NewError(i+1, "unexpected character '%c'", c)
+		}
+		end := i + 1
+		for end < len(filter) && isWordCharacter(filter[end]) {
+			end++
+		}
+		tokens = append(tokens, token{kind: tokenWord, text: filter[i:end], raw: filter[i:end], position: i + 1})
+		i = end
+	}
+	return append(tokens, token{kind: tokenEOF, position: len(filter) + 1}), nil
+}
+
+// scanQuoted reads quoted string, which starts at the provided position.
+// Quote character could be escaped either by doubling it or by the backslash.
+func scanQuoted(filter string, start int) (string, int, bool) {
+	quote := filter[start]
+	var text strings.Builder
+	for i := start + 1; i < len(filter); i++ {
+		switch {
+		case filter[i] == '\\' && i+1 < len(filter) && filter[i+1] == quote:
+			text.WriteByte(quote)
+			i++
+		case filter[i] == quote && i+1 < len(filter) && filter[i+1] == quote:
+			text.WriteByte(quote)
+			i++
+		case filter[i] == quote:
+			return text.String(), i + 1, true
+		default:
+			text.WriteByte(filter[i])
+		}
+	}
+	return "", 0, false
+}
+
+// isValuePosition checks that the next token is expected to be a value, so the dot starts a number.
+func isValuePosition(tokens []token) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	switch last := tokens[len(tokens)-1]; last.kind {
+	case tokenOperator, tokenComma, tokenLeftParen:
+		return true
+	case tokenWord:
+		return last.isKeyword("LIKE") || last.isKeyword("ILIKE")
+	}
+	return false
+}
+
+// isWordCharacter checks that character could be a part of the bare word.
+// Non-ASCII characters are allowed to support unicode keys and values.
+func isWordCharacter(c byte) bool {
+	return c == '_' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,234 @@
+package filter
+
+import (
+	"strings"
+)
+
+// Operator represents comparison operator.
+type Operator string
+
+// supported comparison operators.
+const (
+	EqualOperator          Operator = "="
+	NotEqualOperator       Operator = "!="
+	LessOperator           Operator = "<"
+	LessOrEqualOperator    Operator = "<="
+	GreaterOperator        Operator = ">"
+	GreaterOrEqualOperator Operator = ">="
+	LikeOperator           Operator = "LIKE"
+	ILikeOperator          Operator = "ILIKE"
+	InOperator             Operator = "IN"
+	NotInOperator          Operator = "NOT IN"
+	IsNullOperator         Operator = "IS NULL"
+	IsNotNullOperator      Operator = "IS NOT NULL"
+)
+
+// groups of operators, which are usually supported together.
+var (
+	NumericOperators = []Operator{
+		EqualOperator, NotEqualOperator, LessOperator, LessOrEqualOperator, GreaterOperator, GreaterOrEqualOperator,
+	}
+	StringOperators = []Operator{EqualOperator, NotEqualOperator, LikeOperator, ILikeOperator}
+	ListOperators   = []Operator{InOperator, NotInOperator}
+	NullOperators   = []Operator{IsNullOperator, IsNotNullOperator}
+)
+
+// Value represents literal value of the comparison.
+type Value struct {
+	// Text is the value without quotes.
+	Text string
+	// Raw is the value as it is in the filter.
+	Raw      string
+	Position int
+}
+
+// Comparison represents single `<entity>.<key> <operator> <value>` expression of the filter.
+type Comparison struct {
+	// Entity is the entity type as it is in the filter, for example `tags` or `attribute`.
+	// It is empty, when key has been provided without the entity.
+	Entity string
+	Key    string
+	// Position is the position of the identifier.
+	Position int
+	// KeyPosition is the position of the key part of identifier.
+	KeyPosition      int
+	Operator         Operator
+	OperatorPosition int
+	// Values holds single value for the scalar operators, list of values for `IN` and `NOT IN` operators
+	// and nothing for `IS NULL` and `IS NOT NULL` operators.
+	Values []Value
+}
+
+// parser represents recursive descent parser of the filter tokens.
+type parser struct {
+	tokens []token
+	index  int
+}
+
+// Parse parses the filter into the list of comparisons, which are combined with `AND`.
+//
+// The grammar is the same as MLflow has:
+//
+//	filter     = comparison { "AND" comparison }
+//	comparison = identifier operator [ value | "(" value { "," value } ")" ]
+//	identifier = [ entity "." ] key
+//
+// Keys with the special characters could be quoted with double quotes or backticks.
+func Parse(filter string) ([]Comparison, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{tokens: tokens}
+	var comparisons []Comparison
+	for {
+		comparison, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, *comparison)
+
+		switch t := p.next(); {
+		case t.kind == tokenEOF:
+			return comparisons, nil
+		case t.isKeyword("AND"):
+		case t.isKeyword("OR"):
+			return nil, NewError(t.position, "'OR' is not supported, comparisons could be combined with 'AND' only")
+		default:
+			return nil, NewError(t.position, "expected 'AND' or end of filter, got %s", t)
+		}
+	}
+}
+
+// next returns the current token and moves to the next one. tokenEOF is returned infinitely at the end.
+func (p *parser) next() token {
+	t := p.tokens[p.index]
+	if t.kind != tokenEOF {
+		p.index++
+	}
+	return t
+}
+
+// peek returns the current token without moving to the next one.
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+// parseComparison parses single comparison.
+func (p *parser) parseComparison() (*Comparison, error) {
+	comparison := Comparison{}
+	if err := p.parseIdentifier(&comparison); err != nil {
+		return nil, err
+	}
+	if err := p.parseOperator(&comparison); err != nil {
+		return nil, err
+	}
+
+	switch comparison.Operator {
+	case IsNullOperator, IsNotNullOperator:
+	case InOperator, NotInOperator:
+		if t := p.next(); t.kind != tokenLeftParen {
+			return nil, NewError(t.position, "expected '(' to start the list of values, got %s", t)
+		}
+		for {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			comparison.Values = append(comparison.Values, *value)
+
+			t := p.next()
+			if t.kind == tokenRightParen {
+				break
+			}
+			if t.kind != tokenComma {
+				return nil, NewError(t.position, "expected ',' or ')', got %s", t)
+			}
+		}
+	default:
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = []Value{*value}
+	}
+	return &comparison, nil
+}
+
+// parseIdentifier parses `[entity.]key` identifier. Everything after the first dot is the key.
+func (p *parser) parseIdentifier(comparison *Comparison) error {
+	var parts []token
+	for {
+		t := p.next()
+		switch {
+		case t.kind == tokenWord, t.kind == tokenQuotedIdentifier, t.kind == tokenString && t.quote == '"':
+		case t.kind == tokenNumber && len(parts) > 0:
+		default:
+			return NewError(t.position, "expected identifier, got %s", t)
+		}
+		parts = append(parts, t)
+
+		if p.peek().kind != tokenDot {
+			break
+		}
+		p.next()
+	}
+
+	comparison.Position = parts[0].position
+	if len(parts) > 1 {
+		comparison.Entity, parts = parts[0].text, parts[1:]
+	}
+	comparison.KeyPosition = parts[0].position
+	keys := make([]string, len(parts))
+	for i, part := range parts {
+		keys[i] = part.text
+	}
+	comparison.Key = strings.Join(keys, ".")
+	return nil
+}
+
+// parseOperator parses comparison operator. Keyword operators are case-insensitive.
+func (p *parser) parseOperator(comparison *Comparison) error {
+	t := p.next()
+	comparison.OperatorPosition = t.position
+	switch {
+	case t.kind == tokenOperator:
+		comparison.Operator = Operator(t.text)
+	case t.isKeyword("LIKE"):
+		comparison.Operator = LikeOperator
+	case t.isKeyword("ILIKE"):
+		comparison.Operator = ILikeOperator
+	case t.isKeyword("IN"):
+		comparison.Operator = InOperator
+	case t.isKeyword("NOT"):
+		if next := p.next(); !next.isKeyword("IN") {
+			return NewError(next.position, "expected 'IN' after 'NOT', got %s", next)
+		}
+		comparison.Operator = NotInOperator
+	case t.isKeyword("IS"):
+		comparison.Operator = IsNullOperator
+		next := p.next()
+		if next.isKeyword("NOT") {
+			comparison.Operator = IsNotNullOperator
+			next = p.next()
+		}
+		if !next.isKeyword("NULL") {
+			return NewError(next.position, "expected 'NULL', got %s", next)
+		}
+	default:
+		return NewError(t.position, "expected comparison operator, got %s", t)
+	}
+	return nil
+}
+
+// parseValue parses single value. Bare words are treated as strings.
+func (p *parser) parseValue() (*Value, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString, tokenNumber, tokenWord:
+		return &Value{Text: t.text, Raw: t.raw, Position: t.position}, nil
+	default:
+		return nil, NewError(t.position, "expected value, got %s", t)
+	}
+}
@@ -0,0 +1,238 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Ok(t *testing.T) {
+	testData := []struct {
+		name        string
+		filter      string
+		comparisons []Comparison
+	}{
+		{
+			name:   "AttributeWithoutEntity",
+			filter: "start_time >= 123",
+			comparisons: []Comparison{
+				{
+					Key:              "start_time",
+					Position:         1,
+					KeyPosition:      1,
+					Operator:         GreaterOrEqualOperator,
+					OperatorPosition: 12,
+					Values:           []Value{{Text: "123", Raw: "123", Position: 15}},
+				},
+			},
+		},
+		{
+			name:   "ValueWithAndKeyword",
+			filter: "params.a = 'x and y' AND tags.mlflow.runName LIKE \"run%\"",
+			comparisons: []Comparison{
+				{
+					Entity:           "params",
+					Key:              "a",
+					Position:         1,
+					KeyPosition:      8,
+					Operator:         EqualOperator,
+					OperatorPosition: 10,
+					Values:           []Value{{Text: "x and y", Raw: "'x and y'", Position: 12}},
+				},
+				{
+					Entity:           "tags",
+					Key:              "mlflow.runName",
+					Position:         26,
+					KeyPosition:      31,
+					Operator:         LikeOperator,
+					OperatorPosition: 46,
+					Values:           []Value{{Text: "run%", Raw: `"run%"`, Position: 51}},
+				},
+			},
+		},
+		{
+			name:   "QuotedKeysAndEscapedQuotes",
+			filter: "tags.`my key` = 'it''s' and params.\"a.b-c\" != 'it\\'s'",
+			comparisons: []Comparison{
+				{
+					Entity:           "tags",
+					Key:              "my key",
+					Position:         1,
+					KeyPosition:      6,
+					Operator:         EqualOperator,
+					OperatorPosition: 15,
+					Values:           []Value{{Text: "it's", Raw: "'it''s'", Position: 17}},
+				},
+				{
+					Entity:           "params",
+					Key:              "a.b-c",
+					Position:         29,
+					KeyPosition:      36,
+					Operator:         NotEqualOperator,
+					OperatorPosition: 44,
+					Values:           []Value{{Text: "it's", Raw: "'it\\'s'", Position: 47}},
+				},
+			},
+		},
+		{
+			name:   "ListOperators",
+			filter: "tags.a in ('x', \"y\") AND metrics.b NOT IN (1,-2.5e3)",
+			comparisons: []Comparison{
+				{
+					Entity:           "tags",
+					Key:              "a",
+					Position:         1,
+					KeyPosition:      6,
+					Operator:         InOperator,
+					OperatorPosition: 8,
+					Values: []Value{
+						{Text: "x", Raw: "'x'", Position: 12},
+						{Text: "y", Raw: `"y"`, Position: 17},
+					},
+				},
+				{
+					Entity:           "metrics",
+					Key:              "b",
+					Position:         26,
+					KeyPosition:      34,
+					Operator:         NotInOperator,
+					OperatorPosition: 36,
+					Values: []Value{
+						{Text: "1", Raw: "1", Position: 44},
+						{Text: "-2.5e3", Raw: "-2.5e3", Position: 46},
+					},
+				},
+			},
+		},
+		{
+			name:   "NullOperators",
+			filter: "attributes.end_time IS NULL AND tags.a is not null",
+			comparisons: []Comparison{
+				{
+					Entity:           "attributes",
+					Key:              "end_time",
+					Position:         1,
+					KeyPosition:      12,
+					Operator:         IsNullOperator,
+					OperatorPosition: 21,
+				},
+				{
+					Entity:           "tags",
+					Key:              "a",
+					Position:         33,
+					KeyPosition:      38,
+					Operator:         IsNotNullOperator,
+					OperatorPosition: 40,
+				},
+			},
+		},
+		{
+			name:   "BareWordValues",
+			filter: "run_id = 3f2a9c AND metrics.loss<.5",
+			comparisons: []Comparison{
+				{
+					Key:              "run_id",
+					Position:         1,
+					KeyPosition:      1,
+					Operator:         EqualOperator,
+					OperatorPosition: 8,
+					Values:           []Value{{Text: "3f2a9c", Raw: "3f2a9c", Position: 10}},
+				},
+				{
+					Entity:           "metrics",
+					Key:              "loss",
+					Position:         21,
+					KeyPosition:      29,
+					Operator:         LessOperator,
+					OperatorPosition: 33,
+					Values:           []Value{{Text: ".5", Raw: ".5", Position: 34}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			comparisons, err := Parse(tt.filter)
+			require.Nil(t, err)
+			assert.Equal(t, tt.comparisons, comparisons)
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	testData := []struct {
+		name   string
+		filter string
+		error  string
+	}{
+		{
+			name:   "MissingOperator",
+			filter: "invalid_filter",
+			error:  "invalid filter at position 15: expected comparison operator, got end of filter",
+		},
+		{
+			name:   "UnknownOperator",
+			filter: "tags.a CONTAINS 'x'",
+			error:  "invalid filter at position 8: expected comparison operator, got 'CONTAINS'",
+		},
+		{
+			name:   "MissingValue",
+			filter: "tags.a = ",
+			error:  "invalid filter at position 10: expected value, got end of filter",
+		},
+		{
+			name:   "OrIsNotSupported",
+			filter: "tags.a = 'x' OR tags.b = 'y'",
+			error:  "invalid filter at position 14: 'OR' is not supported, comparisons could be combined with 'AND' only",
+		},
+		{
+			name:   "UnterminatedString",
+			filter: "tags.a = 'x",
+			error:  "invalid filter at position 10: unterminated quoted string 'x",
+		},
+		{
+			name:   "UnexpectedCharacter",
+			filter: "tags.my-key = 'x'",
+			error:  "invalid filter at position 8: unexpected character '-'",
+		},
+		{
+			name:   "SingleQuotedKey",
+			filter: "tags.'key' = 'x'",
+			error:  "invalid filter at position 6: expected identifier, got ''key''",
+		},
+		{
+			name:   "ListWithoutParenthesis",
+			filter: "tags.a IN 'x'",
+			error:  "invalid filter at position 11: expected '(' to start the list of values, got ''x''",
+		},
+		{
+			name:   "UnterminatedList",
+			filter: "tags.a IN ('x' 'y')",
+			error:  "invalid filter at position 16: expected ',' or ')', got ''y''",
+		},
+		{
+			name:   "NotWithoutIn",
+			filter: "tags.a NOT LIKE 'x'",
+			error:  "invalid filter at position 12: expected 'IN' after 'NOT', got 'LIKE'",
+		},
+		{
+			name:   "IsWithoutNull",
+			filter: "tags.a IS 'x'",
+			error:  "invalid filter at position 11: expected 'NULL', got ''x''",
+		},
+		{
+			name:   "MissingAnd",
+			filter: "tags.a = 'x' tags.b = 'y'",
+			error:  "invalid filter at position 14: expected 'AND' or end of filter, got 'tags'",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.filter)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
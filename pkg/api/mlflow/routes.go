@@ -9,16 +9,34 @@ import (
 
 // List of route prefixes.
 const (
-	RunsRoutePrefix        = "/runs"
-	MetricsRoutePrefix     = "/metrics"
-	ArtifactsRoutePrefix   = "/artifacts"
-	ExperimentsRoutePrefix = "/experiments"
+	RunsRoutePrefix             = "/runs"
+	MetricsRoutePrefix          = "/metrics"
+	ArtifactsRoutePrefix        = "/artifacts"
+	ExperimentsRoutePrefix      = "/experiments"
+	ModelVersionsRoutePrefix    = "/model-versions"
+	RegisteredModelsRoutePrefix = "/registered-models"
+)
+
+// List of `mlflow-artifacts` proxy routes.
+const (
+	ProxiedArtifactsRoute     = "/artifacts"
+	ProxiedArtifactsPathRoute = "/artifacts/*"
+)
+
+// List of `mlflow-artifacts` multipart upload routes.
+const (
+	MultipartUploadAbortRoute    = "/mpu/abort"
+	MultipartUploadPartsRoute    = "/mpu/parts"
+	MultipartUploadCreateRoute   = "/mpu/create"
+	MultipartUploadUploadRoute   = "/mpu/upload"
+	MultipartUploadCompleteRoute = "/mpu/complete"
 )
 
 // List of `/artifact/*` routes.
 const (
-	ArtifactsGetRoute  = "/get"
-	ArtifactsListRoute = "/list"
+	ArtifactsGetRoute      = "/get"
+	ArtifactsListRoute     = "/list"
+	ArtifactsDownloadRoute = "/download"
 )
 
 // List of `/experiments/*` routes.
@@ -41,6 +59,33 @@ const (
 	MetricsGetHistoryBulkRoute = "/get-history-bulk"
 )
 
+// List of `/model-versions/*` routes.
+const (
+	ModelVersionsGetRoute             = "/get"
+	ModelVersionsCreateRoute          = "/create"
+	ModelVersionsDeleteRoute          = "/delete"
+	ModelVersionsSearchRoute          = "/search"
+	ModelVersionsSetTagRoute          = "/set-tag"
+	ModelVersionsUpdateRoute          = "/update"
+	ModelVersionsDeleteTagRoute       = "/delete-tag"
+	ModelVersionsGetDownloadURIRoute  = "/get-download-uri"
+	ModelVersionsTransitionStageRoute = "/transition-stage"
+)
+
+// List of `/registered-models/*` routes.
+const (
+	RegisteredModelsGetRoute               = "/get"
+	RegisteredModelsAliasRoute             = "/alias"
+	RegisteredModelsCreateRoute            = "/create"
+	RegisteredModelsDeleteRoute            = "/delete"
+	RegisteredModelsRenameRoute            = "/rename"
+	RegisteredModelsSearchRoute            = "/search"
+	RegisteredModelsSetTagRoute            = "/set-tag"
+	RegisteredModelsUpdateRoute            = "/update"
+	RegisteredModelsDeleteTagRoute         = "/delete-tag"
+	RegisteredModelsGetLatestVersionsRoute = "/get-latest-versions"
+)
+
 // List of `/runs/*` routes.
 const (
 	RunsGetRoute          = "/get"
@@ -48,18 +93,21 @@ const (
 	RunsDeleteRoute       = "/delete"
 	RunsSearchRoute       = "/search"
 	RunsSetTagRoute       = "/set-tag"
+	RunsGetTreeRoute      = "/get-tree"
 	RunsUpdateRoute       = "/update"
 	RunsRestoreRoute      = "/restore"
 	RunsDeleteTagRoute    = "/delete-tag"
 	RunsLogBatchRoute     = "/log-batch"
+	RunsLogInputsRoute    = "/log-inputs"
 	RunsLogMetricRoute    = "/log-metric"
 	RunsLogParameterRoute = "/log-parameter"
 )
 
 // Router represents `mlflow` router.
 type Router struct {
-	prefixList []string
-	controller *controller.Controller
+	prefixList      []string
+	proxyPrefixList []string
+	controller      *controller.Controller
 }
 
 // NewRouter creates new instance of `mlflow` router.
@@ -69,18 +117,40 @@ func NewRouter(controller *controller.Controller) *Router {
 			"/api/2.0/mlflow/",
 			"/ajax-api/2.0/mlflow/",
 		},
+		proxyPrefixList: []string{
+			"/api/2.0/mlflow-artifacts/",
+			"/ajax-api/2.0/mlflow-artifacts/",
+		},
 		controller: controller,
 	}
 }
 
 // Init makes initialization of all `mlflow` routes.
 func (r Router) Init(server fiber.Router) {
+	for _, prefix := range r.proxyPrefixList {
+		proxyGroup := server.Group(prefix)
+		proxyGroup.Get(ProxiedArtifactsRoute, r.controller.ListProxiedArtifacts)
+		proxyGroup.Get(ProxiedArtifactsPathRoute, r.controller.DownloadProxiedArtifact)
+		proxyGroup.Put(ProxiedArtifactsPathRoute, r.controller.UploadProxiedArtifact)
+		proxyGroup.Delete(ProxiedArtifactsPathRoute, r.controller.DeleteProxiedArtifact)
+		proxyGroup.Post(MultipartUploadAbortRoute+"/*", r.controller.AbortMultipartUpload)
+		proxyGroup.Get(MultipartUploadPartsRoute+"/*", r.controller.ListMultipartUploadParts)
+		proxyGroup.Post(MultipartUploadCreateRoute+"/*", r.controller.CreateMultipartUpload)
+		proxyGroup.Put(MultipartUploadUploadRoute+"/*", r.controller.UploadMultipartUploadPart)
+		proxyGroup.Post(MultipartUploadCompleteRoute+"/*", r.controller.CompleteMultipartUpload)
+
+		proxyGroup.Use(func(c *fiber.Ctx) error {
+			return api.NewEndpointNotFound("Not found")
+		})
+	}
+
 	for _, prefix := range r.prefixList {
 		mainGroup := server.Group(prefix)
 
 		artifacts := mainGroup.Group(ArtifactsRoutePrefix)
 		artifacts.Get(ArtifactsGetRoute, r.controller.GetArtifact)
 		artifacts.Get(ArtifactsListRoute, r.controller.ListArtifacts)
+		artifacts.Get(ArtifactsDownloadRoute, r.controller.DownloadArtifacts)
 
 		experiments := mainGroup.Group(ExperimentsRoutePrefix)
 		experiments.Post(ExperimentsCreateRoute, r.controller.CreateExperiment)
@@ -104,7 +174,9 @@ func (r Router) Init(server fiber.Router) {
 		runs.Post(RunsDeleteRoute, r.controller.DeleteRun)
 		runs.Post(RunsDeleteTagRoute, r.controller.DeleteRunTag)
 		runs.Get(RunsGetRoute, r.controller.GetRun)
+		runs.Get(RunsGetTreeRoute, r.controller.GetRunTree)
 		runs.Post(RunsLogBatchRoute, r.controller.LogBatch)
+		runs.Post(RunsLogInputsRoute, r.controller.LogInputs)
 		runs.Post(RunsLogMetricRoute, r.controller.LogMetric)
 		runs.Post(RunsLogParameterRoute, r.controller.LogParam)
 		runs.Post(RunsRestoreRoute, r.controller.RestoreRun)
@@ -112,8 +184,33 @@ func (r Router) Init(server fiber.Router) {
 		runs.Post(RunsSetTagRoute, r.controller.SetRunTag)
 		runs.Post(RunsUpdateRoute, r.controller.UpdateRun)
 
-		mainGroup.Get("/model-versions/search", r.controller.SearchModelVersions)
-		mainGroup.Get("/registered-models/search", r.controller.SearchRegisteredModels)
+		modelVersions := mainGroup.Group(ModelVersionsRoutePrefix)
+		modelVersions.Post(ModelVersionsCreateRoute, r.controller.CreateModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteRoute, r.controller.DeleteModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteTagRoute, r.controller.DeleteModelVersionTag)
+		modelVersions.Get(ModelVersionsGetRoute, r.controller.GetModelVersion)
+		modelVersions.Get(ModelVersionsGetDownloadURIRoute, r.controller.GetModelVersionDownloadURI)
+		modelVersions.Get(ModelVersionsSearchRoute, r.controller.SearchModelVersions)
+		modelVersions.Post(ModelVersionsSearchRoute, r.controller.SearchModelVersions)
+		modelVersions.Post(ModelVersionsSetTagRoute, r.controller.SetModelVersionTag)
+		modelVersions.Post(ModelVersionsTransitionStageRoute, r.controller.TransitionModelVersionStage)
+		modelVersions.Patch(ModelVersionsUpdateRoute, r.controller.UpdateModelVersion)
+
+		registeredModels := mainGroup.Group(RegisteredModelsRoutePrefix)
+		registeredModels.Get(RegisteredModelsAliasRoute, r.controller.GetModelVersionByAlias)
+		registeredModels.Post(RegisteredModelsAliasRoute, r.controller.SetRegisteredModelAlias)
+		registeredModels.Delete(RegisteredModelsAliasRoute, r.controller.DeleteRegisteredModelAlias)
+		registeredModels.Post(RegisteredModelsCreateRoute, r.controller.CreateRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteRoute, r.controller.DeleteRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteTagRoute, r.controller.DeleteRegisteredModelTag)
+		registeredModels.Get(RegisteredModelsGetRoute, r.controller.GetRegisteredModel)
+		registeredModels.Get(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsRenameRoute, r.controller.RenameRegisteredModel)
+		registeredModels.Get(RegisteredModelsSearchRoute, r.controller.SearchRegisteredModels)
+		registeredModels.Post(RegisteredModelsSearchRoute, r.controller.SearchRegisteredModels)
+		registeredModels.Post(RegisteredModelsSetTagRoute, r.controller.SetRegisteredModelTag)
+		registeredModels.Patch(RegisteredModelsUpdateRoute, r.controller.UpdateRegisteredModel)
 
 		mainGroup.Use(func(c *fiber.Ctx) error {
 			return api.NewEndpointNotFound("Not found")
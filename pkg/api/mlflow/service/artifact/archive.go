@@ -0,0 +1,136 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// List of supported formats of artifact directory archives.
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+)
+
+// SupportedArchiveFormats are the formats, which artifact directory can be downloaded in.
+var SupportedArchiveFormats = []string{ArchiveFormatZip, ArchiveFormatTarGz}
+
+// ArtifactArchive represents artifact directory, which is ready to be streamed as an archive.
+// Only the list of the objects is read upfront, content of the objects is read while the archive is written.
+type ArtifactArchive struct {
+	Name        string
+	Format      string
+	Size        int64
+	objects     []storage.ArtifactObject
+	storage     storage.ArtifactStorageProvider
+	artifactURI string
+	path        string
+}
+
+// ContentType returns content type of the archive.
+func (a ArtifactArchive) ContentType() string {
+	if a.Format == ArchiveFormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// Write writes the archive into provided writer.
+func (a ArtifactArchive) Write(ctx context.Context, writer io.Writer) error {
+	if a.Format == ArchiveFormatTarGz {
+		return a.writeTarGz(ctx, writer)
+	}
+	return a.writeZip(ctx, writer)
+}
+
+// writeZip writes the archive in zip format.
+func (a ArtifactArchive) writeZip(ctx context.Context, writer io.Writer) error {
+	modified := time.Now()
+	zipWriter := zip.NewWriter(writer)
+	for _, object := range a.objects {
+		name, err := a.getEntryName(object)
+		if err != nil {
+			return err
+		}
+		entryWriter, err := zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return eris.Wrapf(err, "error creating zip entry for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, entryWriter, object); err != nil {
+			return err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing zip writer")
+	}
+	return nil
+}
+
+// writeTarGz writes the archive in tar format compressed with gzip.
+func (a ArtifactArchive) writeTarGz(ctx context.Context, writer io.Writer) error {
+	modified := time.Now()
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, object := range a.objects {
+		name, err := a.getEntryName(object)
+		if err != nil {
+			return err
+		}
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     object.Size,
+			Mode:     0o644,
+			ModTime:  modified,
+		}); err != nil {
+			return eris.Wrapf(err, "error writing tar header for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, tarWriter, object); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing tar writer")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing gzip writer")
+	}
+	return nil
+}
+
+// copyObject copies exactly the listed size of the object, so the total size of the archive content
+// never exceeds the checked one, even if the object has been changed since it was listed.
+func (a ArtifactArchive) copyObject(ctx context.Context, writer io.Writer, object storage.ArtifactObject) error {
+	reader, err := a.storage.Get(ctx, a.artifactURI, object.Path)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact object: %s", object.Path)
+	}
+	//nolint:errcheck
+	defer reader.Close()
+
+	if _, err := io.CopyN(writer, reader, object.Size); err != nil {
+		return eris.Wrapf(err, "error copying artifact object: %s", object.Path)
+	}
+	return nil
+}
+
+// getEntryName returns name of the archive entry relative to the downloaded directory.
+func (a ArtifactArchive) getEntryName(object storage.ArtifactObject) (string, error) {
+	name, err := filepath.Rel(filepath.Join(".", a.path), object.Path)
+	if err != nil {
+		return "", eris.Wrapf(err, "error getting relative path for object: %s", object.Path)
+	}
+	return filepath.ToSlash(name), nil
+}
@@ -0,0 +1,51 @@
+package artifact
+
+import (
+	"context"
+	"io"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// Artifact represents artifact object, which is ready to be downloaded.
+// Content is read only on demand, so conditional and range requests don't read more than needed.
+// When the download has to be redirected, only PresignedURL is set.
+type Artifact struct {
+	PresignedURL string
+	Stat         *storage.ArtifactObjectStat
+	storage      storage.ArtifactStorageProvider
+	artifactURI  string
+	path         string
+}
+
+// Read returns the whole content of the artifact.
+// Already known Stat is reused, so the storage doesn't need to get it once again.
+func (a Artifact) Read(ctx context.Context) (io.ReadCloser, error) {
+	var reader io.ReadCloser
+	var err error
+	if statStorage, ok := a.storage.(storage.StatArtifactStorageProvider); ok && a.Stat != nil {
+		reader, err = statStorage.GetWithStat(ctx, a.artifactURI, a.path, a.Stat)
+	} else {
+		reader, err = a.storage.Get(ctx, a.artifactURI, a.path)
+	}
+	if err != nil {
+		return nil, api.NewInternalError("error reading artifact object for path: %s", a.path)
+	}
+	return reader, nil
+}
+
+// ReadRange returns `length` bytes of the artifact content starting at `offset`.
+func (a Artifact) ReadRange(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	var reader io.ReadCloser
+	var err error
+	if statStorage, ok := a.storage.(storage.StatArtifactStorageProvider); ok && a.Stat != nil {
+		reader, err = statStorage.GetRangeWithStat(ctx, a.artifactURI, a.path, a.Stat, offset, length)
+	} else {
+		reader, err = a.storage.GetRange(ctx, a.artifactURI, a.path, offset, length)
+	}
+	if err != nil {
+		return nil, api.NewInternalError("error reading range of artifact object for path: %s", a.path)
+	}
+	return reader, nil
+}
@@ -10,8 +10,11 @@ import (
 	"path/filepath"
 	"slices"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
@@ -19,16 +22,19 @@ import (
 
 // Service provides service layer to work with `artifact` business logic.
 type Service struct {
+	config                 *config.ServiceConfig
 	runRepository          repositories.RunRepositoryProvider
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider
 }
 
 // NewService creates new Service instance.
 func NewService(
+	config *config.ServiceConfig,
 	runRepository repositories.RunRepositoryProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 ) *Service {
 	return &Service{
+		config:                 config,
 		runRepository:          runRepository,
 		artifactStorageFactory: artifactStorageFactory,
 	}
@@ -50,12 +56,16 @@ func (s Service) ListArtifacts(
 		return "", nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
-	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	artifactURI, err := s.config.ResolveArtifactURI(run.ArtifactURI)
+	if err != nil {
+		return "", nil, api.NewInternalError("run with id '%s' has incorrect artifact uri: %s", run.ID, err)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
 	if err != nil {
 		return "", nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
 	}
 
-	artifacts, err := artifactStorage.List(ctx, run.ArtifactURI, req.Path)
+	artifacts, err := artifactStorage.List(ctx, artifactURI, req.Path)
 	if err != nil {
 		return "", nil, api.NewInternalError("error getting artifact list from storage")
 	}
@@ -71,7 +81,7 @@ func (s Service) ListArtifacts(
 // GetArtifact handles business logic of `GET /artifacts/get` endpoint.
 func (s Service) GetArtifact(
 	ctx context.Context, namespace *models.Namespace, req *request.GetArtifactRequest,
-) (io.ReadCloser, error) {
+) (*Artifact, error) {
 	if err := ValidateGetArtifactRequest(req); err != nil {
 		return nil, err
 	}
@@ -83,20 +93,388 @@ func (s Service) GetArtifact(
 	if run == nil {
 		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
-	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	artifactURI, err := s.config.ResolveArtifactURI(run.ArtifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has incorrect artifact uri: %s", run.ID, err)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	return s.getArtifact(
+		ctx, artifactStorage, artifactURI, req.Path,
+		fmt.Sprintf("error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path)),
+	)
+}
+
+// DownloadArtifacts handles business logic of `GET /artifacts/download` endpoint.
+// The directory is walked recursively upfront, so the size limit is checked before the archive is streamed.
+func (s Service) DownloadArtifacts(
+	ctx context.Context, namespace *models.Namespace, req *request.DownloadArtifactsRequest,
+) (*ArtifactArchive, error) {
+	if err := ValidateDownloadArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+	artifactURI, err := s.config.ResolveArtifactURI(run.ArtifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has incorrect artifact uri: %s", run.ID, err)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
 	if err != nil {
 		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
 	}
 
-	artifactReader, err := artifactStorage.Get(
-		ctx, run.ArtifactURI, req.Path,
+	objects, err := s.listArtifactsRecursively(ctx, artifactStorage, artifactURI, req.Path)
+	if err != nil {
+		return nil, api.NewInternalError("error getting artifact list from storage")
+	}
+	if len(objects) == 0 {
+		return nil, api.NewResourceDoesNotExistError(
+			"artifact directory for URI: %s is empty or does not exist", filepath.Join(run.ArtifactURI, req.Path),
+		)
+	}
+	slices.SortFunc(objects, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	archive := ArtifactArchive{
+		Format:      req.Format,
+		objects:     objects,
+		storage:     artifactStorage,
+		artifactURI: artifactURI,
+		path:        req.Path,
+	}
+	if archive.Format == "" {
+		archive.Format = ArchiveFormatZip
+	}
+	for _, object := range objects {
+		archive.Size += object.Size
+	}
+	if s.config.ArchiveMaxSize > 0 && archive.Size > s.config.ArchiveMaxSize {
+		return nil, api.NewInvalidParameterValueError(
+			"total size of artifact directory %d bytes exceeds the limit of %d bytes",
+			archive.Size, s.config.ArchiveMaxSize,
+		)
+	}
+
+	name := filepath.Base(filepath.Join(".", req.Path))
+	if name == "." {
+		name = "artifacts"
+	}
+	archive.Name = fmt.Sprintf("%s.%s", name, archive.Format)
+	return &archive, nil
+}
+
+// ListProxiedArtifacts handles business logic of `GET /mlflow-artifacts/artifacts` endpoint.
+func (s Service) ListProxiedArtifacts(
+	ctx context.Context, req *request.ListProxiedArtifactsRequest,
+) ([]storage.ArtifactObject, error) {
+	if err := ValidateListProxiedArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := artifactStorage.List(ctx, s.config.ArtifactsDestination, req.Path)
+	if err != nil {
+		return nil, api.NewInternalError("error getting artifact list from storage")
+	}
+
+	// sort artifacts by path
+	slices.SortFunc(artifacts, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	return artifacts, nil
+}
+
+// GetProxiedArtifact handles business logic of `GET /mlflow-artifacts/artifacts/{path}` endpoint.
+func (s Service) GetProxiedArtifact(
+	ctx context.Context, req *request.ProxiedArtifactRequest,
+) (*Artifact, error) {
+	if err := ValidateProxiedArtifactRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getArtifact(
+		ctx, artifactStorage, s.config.ArtifactsDestination, req.Path,
+		fmt.Sprintf("error getting artifact object for path: %s", req.Path),
+	)
+}
+
+// PutProxiedArtifact handles business logic of `PUT /mlflow-artifacts/artifacts/{path}` endpoint.
+func (s Service) PutProxiedArtifact(
+	ctx context.Context, req *request.ProxiedArtifactRequest, reader io.Reader,
+) error {
+	if err := ValidateProxiedArtifactRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.Put(ctx, s.config.ArtifactsDestination, req.Path, reader); err != nil {
+		return api.NewInternalError("error uploading artifact object for path: %s", req.Path)
+	}
+	return nil
+}
+
+// DeleteProxiedArtifact handles business logic of `DELETE /mlflow-artifacts/artifacts/{path}` endpoint.
+func (s Service) DeleteProxiedArtifact(ctx context.Context, req *request.ProxiedArtifactRequest) error {
+	if err := ValidateProxiedArtifactRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.Delete(ctx, s.config.ArtifactsDestination, req.Path); err != nil {
+		msg := fmt.Sprintf("error deleting artifact object for path: %s", req.Path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return api.NewResourceDoesNotExistError(msg)
+		}
+		return api.NewInternalError(msg)
+	}
+	return nil
+}
+
+// CreateMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/create/{path}` endpoint.
+func (s Service) CreateMultipartUpload(
+	ctx context.Context, req *request.CreateMultipartUploadRequest,
+) (*storage.MultipartUpload, error) {
+	if err := ValidateCreateMultipartUploadRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedMultipartArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := artifactStorage.CreateMultipartUpload(ctx, s.config.ArtifactsDestination, req.Path, req.NumParts)
+	if err != nil {
+		return nil, api.NewInternalError("error creating multipart upload for path: %s", req.Path)
+	}
+	return upload, nil
+}
+
+// UploadMultipartUploadPart handles business logic of `PUT /mlflow-artifacts/mpu/upload/{path}` endpoint.
+func (s Service) UploadMultipartUploadPart(
+	ctx context.Context, req *request.UploadMultipartUploadPartRequest, reader io.Reader,
+) (*storage.MultipartUploadPart, error) {
+	if err := ValidateUploadMultipartUploadPartRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedMultipartArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	part, err := artifactStorage.UploadPart(
+		ctx, s.config.ArtifactsDestination, req.Path, req.UploadID, req.PartNumber, reader, req.Size,
 	)
 	if err != nil {
-		msg := fmt.Sprintf("error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path))
+		return nil, newMultipartUploadError(
+			err, "error uploading part %d of multipart upload '%s'", req.PartNumber, req.UploadID,
+		)
+	}
+	return part, nil
+}
+
+// ListMultipartUploadParts handles business logic of `GET /mlflow-artifacts/mpu/parts/{path}` endpoint.
+func (s Service) ListMultipartUploadParts(
+	ctx context.Context, req *request.ListMultipartUploadPartsRequest,
+) ([]storage.MultipartUploadPart, error) {
+	if err := ValidateListMultipartUploadPartsRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedMultipartArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := artifactStorage.ListParts(ctx, s.config.ArtifactsDestination, req.Path, req.UploadID)
+	if err != nil {
+		return nil, newMultipartUploadError(err, "error listing parts of multipart upload '%s'", req.UploadID)
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/complete/{path}` endpoint.
+func (s Service) CompleteMultipartUpload(ctx context.Context, req *request.CompleteMultipartUploadRequest) error {
+	if err := ValidateCompleteMultipartUploadRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedMultipartArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	parts := make([]storage.MultipartUploadPart, len(req.Parts))
+	for i, part := range req.Parts {
+		parts[i] = storage.MultipartUploadPart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		}
+	}
+	if err := artifactStorage.CompleteMultipartUpload(
+		ctx, s.config.ArtifactsDestination, req.Path, req.UploadID, parts,
+	); err != nil {
+		return newMultipartUploadError(err, "error completing multipart upload '%s'", req.UploadID)
+	}
+	return nil
+}
+
+// AbortMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/abort/{path}` endpoint.
+func (s Service) AbortMultipartUpload(ctx context.Context, req *request.AbortMultipartUploadRequest) error {
+	if err := ValidateAbortMultipartUploadRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedMultipartArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.AbortMultipartUpload(
+		ctx, s.config.ArtifactsDestination, req.Path, req.UploadID,
+	); err != nil {
+		return newMultipartUploadError(err, "error aborting multipart upload '%s'", req.UploadID)
+	}
+	return nil
+}
+
+// getArtifact returns the artifact, which is either redirected to the presigned url or read from the storage.
+// Metadata of the artifact is read upfront, so missing artifacts are reported before the download starts.
+func (s Service) getArtifact(
+	ctx context.Context, artifactStorage storage.ArtifactStorageProvider, artifactURI, path, errorMessage string,
+) (*Artifact, error) {
+	if presignedURL := s.getPresignedURL(ctx, artifactStorage, artifactURI, path); presignedURL != "" {
+		return &Artifact{PresignedURL: presignedURL}, nil
+	}
+
+	stat, err := artifactStorage.Stat(ctx, artifactURI, path)
+	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
-			return nil, api.NewResourceDoesNotExistError(msg)
+			return nil, api.NewResourceDoesNotExistError(errorMessage)
 		}
-		return nil, api.NewInternalError(msg)
+		return nil, api.NewInternalError(errorMessage)
+	}
+	return &Artifact{
+		Stat:        stat,
+		storage:     artifactStorage,
+		artifactURI: artifactURI,
+		path:        path,
+	}, nil
+}
+
+// listArtifactsRecursively returns all the artifact objects under the `path`.
+// Directories are not returned, only the objects inside them.
+func (s Service) listArtifactsRecursively(
+	ctx context.Context, artifactStorage storage.ArtifactStorageProvider, artifactURI, path string,
+) ([]storage.ArtifactObject, error) {
+	objects, err := artifactStorage.List(ctx, artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []storage.ArtifactObject
+	for _, object := range objects {
+		if !object.IsDir {
+			result = append(result, object)
+			continue
+		}
+		children, err := s.listArtifactsRecursively(ctx, artifactStorage, artifactURI, object.Path)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, children...)
+	}
+	return result, nil
+}
+
+// getPresignedURL returns presigned url of the artifact, when redirects of the downloads are enabled
+// and the storage is able to presign urls. Otherwise, empty url is returned, so the artifact is proxied.
+func (s Service) getPresignedURL(
+	ctx context.Context, artifactStorage storage.ArtifactStorageProvider, artifactURI, path string,
+) string {
+	if !s.config.PresignedRedirect {
+		return ""
+	}
+	presignedArtifactStorage, ok := artifactStorage.(storage.PresignedArtifactStorageProvider)
+	if !ok {
+		return ""
+	}
+	presignedURL, err := presignedArtifactStorage.GetPresignedURL(
+		ctx, artifactURI, path, s.config.PresignedURLExpiry,
+	)
+	if err != nil {
+		log.Warnf("error presigning url for artifact %q, falling back to proxying: %s", path, err)
+		return ""
+	}
+	return presignedURL
+}
+
+// getProxiedMultipartArtifactStorage returns artifact storage of the `artifacts-destination`,
+// if it supports multipart uploads.
+func (s Service) getProxiedMultipartArtifactStorage(
+	ctx context.Context,
+) (storage.MultipartArtifactStorageProvider, error) {
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	multipartArtifactStorage, ok := artifactStorage.(storage.MultipartArtifactStorageProvider)
+	if !ok {
+		return nil, api.NewBadRequestError("artifacts destination storage doesn't support multipart uploads")
+	}
+	return multipartArtifactStorage, nil
+}
+
+// newMultipartUploadError converts storage error into api error.
+// Unknown upload or part is reported as a missing resource, so the client is able to restart the upload.
+func newMultipartUploadError(err error, msg string, args ...any) *api.ErrorResponse {
+	if errors.Is(err, fs.ErrNotExist) {
+		return api.NewResourceDoesNotExistError(msg, args...)
+	}
+	return api.NewInternalError(msg, args...)
+}
+
+// getProxiedArtifactStorage returns artifact storage of the `artifacts-destination`,
+// if artifacts serving has been enabled.
+func (s Service) getProxiedArtifactStorage(ctx context.Context) (storage.ArtifactStorageProvider, error) {
+	if !s.config.ServeArtifacts {
+		return nil, api.NewEndpointNotFound(
+			"mlflow-artifacts endpoints are disabled. To enable them, run the server with `--serve-artifacts`",
+		)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, s.config.ArtifactsDestination)
+	if err != nil {
+		return nil, api.NewInternalError("artifacts destination has unsupported artifact storage")
 	}
-	return artifactReader, nil
+	return artifactStorage, nil
 }
@@ -1,18 +1,24 @@
 package artifact
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"io"
+	"io/fs"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
@@ -55,7 +61,7 @@ func TestService_ListArtifacts_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &artifactStorageFactory)
+	service := NewService(&config.ServiceConfig{}, &runRepository, &artifactStorageFactory)
 	rootURI, artifacts, err := service.ListArtifacts(
 		context.TODO(),
 		&models.Namespace{
@@ -95,6 +101,7 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 			request: &request.ListArtifactsRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockRunRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -109,6 +116,7 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockRunRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -129,6 +137,7 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 					"id",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&runRepository,
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -164,6 +173,7 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&runRepository,
 					&artifactStorageFactory,
 				)
@@ -184,11 +194,21 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 
 func TestService_GetArtifact_Ok(t *testing.T) {
 	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Stat", context.TODO(), "/artifact/uri", "",
+	).Return(
+		&storage.ArtifactObjectStat{Size: 7, ETag: `"etag"`}, nil,
+	)
 	artifactStorage.On(
 		"Get", context.TODO(), "/artifact/uri", "",
 	).Return(
 		io.NopCloser(strings.NewReader("content")), nil,
 	)
+	artifactStorage.On(
+		"GetRange", context.TODO(), "/artifact/uri", "", int64(1), int64(3),
+	).Return(
+		io.NopCloser(strings.NewReader("ont")), nil,
+	)
 
 	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
 	artifactStorageFactory.On(
@@ -208,8 +228,8 @@ func TestService_GetArtifact_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &artifactStorageFactory)
-	data, err := service.GetArtifact(
+	service := NewService(&config.ServiceConfig{}, &runRepository, &artifactStorageFactory)
+	artifact, err := service.GetArtifact(
 		context.TODO(),
 		&models.Namespace{
 			ID: 1,
@@ -219,11 +239,191 @@ func TestService_GetArtifact_Ok(t *testing.T) {
 		},
 	)
 
+	require.Nil(t, err)
+	assert.Empty(t, artifact.PresignedURL)
+	assert.Equal(t, &storage.ArtifactObjectStat{Size: 7, ETag: `"etag"`}, artifact.Stat)
+
+	data, err := artifact.Read(context.TODO())
 	require.Nil(t, err)
 	result := new(bytes.Buffer)
 	_, err = result.ReadFrom(data)
 	require.Nil(t, err)
 	assert.Equal(t, "content", result.String())
+
+	data, err = artifact.ReadRange(context.TODO(), 1, 3)
+	require.Nil(t, err)
+	result = new(bytes.Buffer)
+	_, err = result.ReadFrom(data)
+	require.Nil(t, err)
+	assert.Equal(t, "ont", result.String())
+}
+
+func TestService_GetArtifact_Cached(t *testing.T) {
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Stat", context.TODO(), "/artifact/uri", "file.txt",
+	).Return(
+		&storage.ArtifactObjectStat{Size: 7, ETag: `"etag"`}, nil,
+	)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "file.txt",
+	).Return(
+		io.NopCloser(strings.NewReader("content")), nil,
+	)
+
+	cache, err := storage.NewCache(t.TempDir(), 1024)
+	require.Nil(t, err)
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(cache.Wrap(&artifactStorage), nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+
+	// call service under testing.
+	service := NewService(&config.ServiceConfig{}, &runRepository, &artifactStorageFactory)
+	artifact, err := service.GetArtifact(
+		context.TODO(),
+		&models.Namespace{
+			ID: 1,
+		},
+		&request.GetArtifactRequest{
+			RunID: "id",
+			Path:  "file.txt",
+		},
+	)
+	require.Nil(t, err)
+
+	// the first read fills the cache, the range is served from it.
+	data, err := artifact.Read(context.TODO())
+	require.Nil(t, err)
+	result := new(bytes.Buffer)
+	_, err = result.ReadFrom(data)
+	require.Nil(t, err)
+	require.Nil(t, data.Close())
+	assert.Equal(t, "content", result.String())
+
+	data, err = artifact.ReadRange(context.TODO(), 1, 3)
+	require.Nil(t, err)
+	result = new(bytes.Buffer)
+	_, err = result.ReadFrom(data)
+	require.Nil(t, err)
+	require.Nil(t, data.Close())
+	assert.Equal(t, "ont", result.String())
+
+	// the reads reuse the stat of the artifact instead of getting it from the storage once again.
+	artifactStorage.AssertNumberOfCalls(t, "Stat", 1)
+	artifactStorage.AssertNumberOfCalls(t, "Get", 1)
+	assert.Equal(t, storage.CacheStats{Hits: 1, Misses: 1, Size: 7, Objects: 1}, cache.GetStats())
+}
+
+// presignedArtifactStorage combines mocks of both storage interfaces, like S3 and GS storages do.
+type presignedArtifactStorage struct {
+	*storage.MockArtifactStorageProvider
+	*storage.MockPresignedArtifactStorageProvider
+}
+
+func TestService_GetArtifact_Presigned(t *testing.T) {
+	tests := []struct {
+		name                 string
+		config               *config.ServiceConfig
+		presignedURL         string
+		presignedError       error
+		expectedPresignedURL string
+		expectedContent      string
+	}{
+		{
+			name:                 "RedirectIsEnabled",
+			config:               &config.ServiceConfig{PresignedRedirect: true, PresignedURLExpiry: time.Minute},
+			presignedURL:         "https://bucket.s3.amazonaws.com/artifact/uri/file.txt?X-Amz-Signature=signature",
+			expectedPresignedURL: "https://bucket.s3.amazonaws.com/artifact/uri/file.txt?X-Amz-Signature=signature",
+		},
+		{
+			name:            "RedirectIsDisabled",
+			config:          &config.ServiceConfig{},
+			expectedContent: "content",
+		},
+		{
+			name:            "PresigningFailed",
+			config:          &config.ServiceConfig{PresignedRedirect: true, PresignedURLExpiry: time.Minute},
+			presignedError:  errors.New("no credentials to sign url"),
+			expectedContent: "content",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artifactStorage := storage.MockArtifactStorageProvider{}
+			artifactStorage.On(
+				"Stat", context.TODO(), "s3://bucket/artifact/uri", "file.txt",
+			).Return(
+				&storage.ArtifactObjectStat{Size: 7}, nil,
+			)
+			artifactStorage.On(
+				"Get", context.TODO(), "s3://bucket/artifact/uri", "file.txt",
+			).Return(
+				io.NopCloser(strings.NewReader("content")), nil,
+			)
+			presignedStorage := storage.MockPresignedArtifactStorageProvider{}
+			presignedStorage.On(
+				"GetPresignedURL", context.TODO(), "s3://bucket/artifact/uri", "file.txt", time.Minute,
+			).Return(tt.presignedURL, tt.presignedError)
+
+			artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+			artifactStorageFactory.On(
+				"GetStorage", context.TODO(), "s3://bucket/artifact/uri",
+			).Return(presignedArtifactStorage{
+				MockArtifactStorageProvider:          &artifactStorage,
+				MockPresignedArtifactStorageProvider: &presignedStorage,
+			}, nil)
+
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetByNamespaceIDAndRunID",
+				context.TODO(),
+				uint(1),
+				"id",
+			).Return(&models.Run{
+				ID:          "id",
+				ArtifactURI: "s3://bucket/artifact/uri",
+			}, nil)
+
+			// call service under testing.
+			service := NewService(tt.config, &runRepository, &artifactStorageFactory)
+			artifact, err := service.GetArtifact(
+				context.TODO(),
+				&models.Namespace{
+					ID: 1,
+				},
+				&request.GetArtifactRequest{
+					RunID: "id",
+					Path:  "file.txt",
+				},
+			)
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedPresignedURL, artifact.PresignedURL)
+			if tt.expectedContent != "" {
+				data, err := artifact.Read(context.TODO())
+				require.Nil(t, err)
+				result := new(bytes.Buffer)
+				_, err = result.ReadFrom(data)
+				require.Nil(t, err)
+				assert.Equal(t, tt.expectedContent, result.String())
+			} else {
+				assert.Nil(t, artifact.Stat)
+			}
+		})
+	}
 }
 
 func TestService_GetArtifact_Error(t *testing.T) {
@@ -239,6 +439,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			request: &request.GetArtifactRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockRunRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -253,6 +454,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockRunRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -273,6 +475,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					"id",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&runRepository,
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
@@ -287,7 +490,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			service: func() *Service {
 				artifactStorage := storage.MockArtifactStorageProvider{}
 				artifactStorage.On(
-					"Get", context.TODO(), "/artifact/uri", "",
+					"Stat", context.TODO(), "/artifact/uri", "",
 				).Return(
 					nil, errors.New("storage error"),
 				)
@@ -308,6 +511,44 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.ServiceConfig{},
+					&runRepository,
+					&artifactStorageFactory,
+				)
+			},
+		},
+		{
+			name:  "ArtifactNotFound",
+			error: api.NewResourceDoesNotExistError("error getting artifact object for URI: /artifact/uri/file.txt"),
+			request: &request.GetArtifactRequest{
+				RunID: "id",
+				Path:  "file.txt",
+			},
+			service: func() *Service {
+				artifactStorage := storage.MockArtifactStorageProvider{}
+				artifactStorage.On(
+					"Stat", context.TODO(), "/artifact/uri", "file.txt",
+				).Return(
+					nil, fs.ErrNotExist,
+				)
+
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifact/uri",
+				).Return(&artifactStorage, nil)
+
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+				}, nil)
+				return NewService(
+					&config.ServiceConfig{},
 					&runRepository,
 					&artifactStorageFactory,
 				)
@@ -322,7 +563,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			service: func() *Service {
 				artifactStorage := storage.MockArtifactStorageProvider{}
 				artifactStorage.On(
-					"Get", context.TODO(), "/artifact/uri", "",
+					"Stat", context.TODO(), "/artifact/uri", "",
 				).Return(
 					nil, errors.New("storage error"),
 				)
@@ -343,6 +584,7 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&runRepository,
 					&artifactStorageFactory,
 				)
@@ -360,3 +602,299 @@ func TestService_GetArtifact_Error(t *testing.T) {
 		})
 	}
 }
+
+// newDirectoryArtifactStorage creates storage mock with `dir/file1.txt` and `dir/subdir/file2.txt` artifacts.
+func newDirectoryArtifactStorage() *storage.MockArtifactStorageProvider {
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "dir",
+	).Return([]storage.ArtifactObject{
+		{Path: "dir/subdir", IsDir: true},
+		{Path: "dir/file1.txt", Size: 8},
+	}, nil)
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "dir/subdir",
+	).Return([]storage.ArtifactObject{
+		{Path: "dir/subdir/file2.txt", Size: 8},
+	}, nil)
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "empty",
+	).Return([]storage.ArtifactObject{}, nil)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "dir/file1.txt",
+	).Return(io.NopCloser(strings.NewReader("content1")), nil)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "dir/subdir/file2.txt",
+	).Return(io.NopCloser(strings.NewReader("content2")), nil)
+	return &artifactStorage
+}
+
+func TestService_DownloadArtifacts_Ok(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       string
+		expectedName string
+		readArchive  func(t *testing.T, data []byte) map[string]string
+	}{
+		{
+			name:         "ZipFormat",
+			expectedName: "dir.zip",
+			readArchive: func(t *testing.T, data []byte) map[string]string {
+				reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+				require.Nil(t, err)
+				files := map[string]string{}
+				for _, file := range reader.File {
+					content, err := file.Open()
+					require.Nil(t, err)
+					result, err := io.ReadAll(content)
+					require.Nil(t, err)
+					files[file.Name] = string(result)
+				}
+				return files
+			},
+		},
+		{
+			name:         "TarGzFormat",
+			format:       ArchiveFormatTarGz,
+			expectedName: "dir.tar.gz",
+			readArchive: func(t *testing.T, data []byte) map[string]string {
+				gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+				require.Nil(t, err)
+				reader := tar.NewReader(gzipReader)
+				files := map[string]string{}
+				for {
+					header, err := reader.Next()
+					if err == io.EOF {
+						break
+					}
+					require.Nil(t, err)
+					result, err := io.ReadAll(reader)
+					require.Nil(t, err)
+					files[header.Name] = string(result)
+				}
+				return files
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+			artifactStorageFactory.On(
+				"GetStorage", context.TODO(), "/artifact/uri",
+			).Return(newDirectoryArtifactStorage(), nil)
+
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetByNamespaceIDAndRunID",
+				context.TODO(),
+				uint(1),
+				"id",
+			).Return(&models.Run{
+				ID:          "id",
+				ArtifactURI: "/artifact/uri",
+			}, nil)
+
+			// call service under testing.
+			service := NewService(&config.ServiceConfig{ArchiveMaxSize: 16}, &runRepository, &artifactStorageFactory)
+			archive, err := service.DownloadArtifacts(
+				context.TODO(),
+				&models.Namespace{
+					ID: 1,
+				},
+				&request.DownloadArtifactsRequest{
+					RunID:  "id",
+					Path:   "dir",
+					Format: tt.format,
+				},
+			)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedName, archive.Name)
+			assert.Equal(t, int64(16), archive.Size)
+
+			result := new(bytes.Buffer)
+			require.Nil(t, archive.Write(context.TODO(), result))
+			assert.Equal(t, map[string]string{
+				"file1.txt":        "content1",
+				"subdir/file2.txt": "content2",
+			}, tt.readArchive(t, result.Bytes()))
+		})
+	}
+}
+
+func TestService_DownloadArtifacts_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		config  *config.ServiceConfig
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name:   "SizeLimitExceeded",
+			error:  api.NewInvalidParameterValueError("total size of artifact directory 16 bytes exceeds the limit of 15 bytes"),
+			config: &config.ServiceConfig{ArchiveMaxSize: 15},
+			request: &request.DownloadArtifactsRequest{
+				RunID: "id",
+				Path:  "dir",
+			},
+		},
+		{
+			name: "EmptyDirectory",
+			error: api.NewResourceDoesNotExistError(
+				"artifact directory for URI: /artifact/uri/empty is empty or does not exist",
+			),
+			config: &config.ServiceConfig{},
+			request: &request.DownloadArtifactsRequest{
+				RunID: "id",
+				Path:  "empty",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+			artifactStorageFactory.On(
+				"GetStorage", context.TODO(), "/artifact/uri",
+			).Return(newDirectoryArtifactStorage(), nil)
+
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetByNamespaceIDAndRunID",
+				context.TODO(),
+				uint(1),
+				"id",
+			).Return(&models.Run{
+				ID:          "id",
+				ArtifactURI: "/artifact/uri",
+			}, nil)
+
+			// call service under testing.
+			_, err := NewService(tt.config, &runRepository, &artifactStorageFactory).DownloadArtifacts(
+				context.TODO(),
+				&models.Namespace{
+					ID: 1,
+				},
+				tt.request,
+			)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+// multipartArtifactStorage combines mocks of both storage interfaces, like S3 and Local storages do.
+type multipartArtifactStorage struct {
+	*storage.MockArtifactStorageProvider
+	*storage.MockMultipartArtifactStorageProvider
+}
+
+func TestService_CompleteMultipartUpload_Ok(t *testing.T) {
+	multipartStorage := storage.MockMultipartArtifactStorageProvider{}
+	multipartStorage.On(
+		"CompleteMultipartUpload",
+		context.TODO(),
+		"/artifacts/destination",
+		"dir/file.bin",
+		"upload-id",
+		[]storage.MultipartUploadPart{
+			{PartNumber: 1, ETag: "etag1"},
+			{PartNumber: 2, ETag: "etag2"},
+		},
+	).Return(nil)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifacts/destination",
+	).Return(multipartArtifactStorage{
+		MockArtifactStorageProvider:          &storage.MockArtifactStorageProvider{},
+		MockMultipartArtifactStorageProvider: &multipartStorage,
+	}, nil)
+
+	// call service under testing.
+	service := NewService(&config.ServiceConfig{
+		ServeArtifacts:       true,
+		ArtifactsDestination: "/artifacts/destination",
+	}, &repositories.MockRunRepositoryProvider{}, &artifactStorageFactory)
+	err := service.CompleteMultipartUpload(context.TODO(), &request.CompleteMultipartUploadRequest{
+		Path:     "dir/file.bin",
+		UploadID: "upload-id",
+		Parts: []request.MultipartUploadPartPartialRequest{
+			{PartNumber: 1, ETag: "etag1"},
+			{PartNumber: 2, ETag: "etag2"},
+		},
+	})
+
+	require.Nil(t, err)
+	multipartStorage.AssertExpectations(t)
+}
+
+func TestService_CompleteMultipartUpload_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		config  *config.ServiceConfig
+		service func(config *config.ServiceConfig) *Service
+	}{
+		{
+			name: "ArtifactsServingIsDisabled",
+			error: api.NewEndpointNotFound(
+				"mlflow-artifacts endpoints are disabled. To enable them, run the server with `--serve-artifacts`",
+			),
+			service: func(config *config.ServiceConfig) *Service {
+				return NewService(
+					config, &repositories.MockRunRepositoryProvider{}, &storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+			config: &config.ServiceConfig{},
+		},
+		{
+			name:  "StorageDoesNotSupportMultipartUploads",
+			error: api.NewBadRequestError("artifacts destination storage doesn't support multipart uploads"),
+			service: func(config *config.ServiceConfig) *Service {
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "gs://bucket",
+				).Return(&storage.MockArtifactStorageProvider{}, nil)
+				return NewService(config, &repositories.MockRunRepositoryProvider{}, &artifactStorageFactory)
+			},
+			config: &config.ServiceConfig{ServeArtifacts: true, ArtifactsDestination: "gs://bucket"},
+		},
+		{
+			name:  "UploadDoesNotExist",
+			error: api.NewResourceDoesNotExistError("error completing multipart upload 'upload-id'"),
+			service: func(config *config.ServiceConfig) *Service {
+				multipartStorage := storage.MockMultipartArtifactStorageProvider{}
+				multipartStorage.On(
+					"CompleteMultipartUpload",
+					context.TODO(),
+					"/artifacts/destination",
+					"dir/file.bin",
+					"upload-id",
+					[]storage.MultipartUploadPart{{PartNumber: 1}},
+				).Return(fs.ErrNotExist)
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifacts/destination",
+				).Return(multipartArtifactStorage{
+					MockArtifactStorageProvider:          &storage.MockArtifactStorageProvider{},
+					MockMultipartArtifactStorageProvider: &multipartStorage,
+				}, nil)
+				return NewService(config, &repositories.MockRunRepositoryProvider{}, &artifactStorageFactory)
+			},
+			config: &config.ServiceConfig{ServeArtifacts: true, ArtifactsDestination: "/artifacts/destination"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service(tt.config).CompleteMultipartUpload(
+				context.TODO(), &request.CompleteMultipartUploadRequest{
+					Path:     "dir/file.bin",
+					UploadID: "upload-id",
+					Parts:    []request.MultipartUploadPartPartialRequest{{PartNumber: 1}},
+				},
+			)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
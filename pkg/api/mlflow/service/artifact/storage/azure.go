@@ -0,0 +1,318 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+)
+
+// AzureStorageName is an Azure Blob Storage name used by MLflow, AzureShortStorageName is the one used by fsspec.
+const (
+	AzureStorageName      = "wasbs"
+	AzureShortStorageName = "az"
+)
+
+// azureConnectionStringEnv is the environment variable, which MLflow reads the connection string from.
+const azureConnectionStringEnv = "AZURE_STORAGE_CONNECTION_STRING"
+
+// Azure represents adapter to work with Azure Blob Storage artifacts.
+// The client is selected based on the provided configuration:
+//   - connection string, which defines the storage account and its credentials itself.
+//   - SAS token, which is appended to the storage account url.
+//   - default Azure credential chain (managed identity, workload identity, environment, Azure CLI).
+type Azure struct {
+	endpointURI      string
+	sasToken         string
+	connectionClient *azblob.Client
+	credential       azcore.TokenCredential
+	credentialErr    error
+	credentialOnce   sync.Once
+	clients          sync.Map
+}
+
+// NewAzure creates new Azure Blob Storage instance.
+func NewAzure(config *config.ServiceConfig) (*Azure, error) {
+	storage := Azure{
+		endpointURI: strings.TrimRight(config.AzureEndpointURI, "/"),
+		sasToken:    strings.TrimPrefix(config.AzureSASToken, "?"),
+	}
+
+	// the same as MLflow does, fall back to the connection string from the environment.
+	connectionString := config.AzureConnectionString
+	if connectionString == "" && storage.sasToken == "" {
+		connectionString = os.Getenv(azureConnectionStringEnv)
+	}
+	if connectionString != "" {
+		client, err := azblob.NewClientFromConnectionString(connectionString, nil)
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating Azure storage client from connection string")
+		}
+		storage.connectionClient = client
+	}
+
+	return &storage, nil
+}
+
+// List implements ArtifactStorageProvider interface.
+func (s *Azure) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+	// 1. process input parameters.
+	client, containerName, rootPrefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting Azure storage client")
+	}
+	prefix := filepath.Join(rootPrefix, path)
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	// 2. read data from azure storage.
+	var artifactList []ArtifactObject
+	pager := client.ServiceClient().NewContainerClient(containerName).NewListBlobsHierarchyPager(
+		"/", &container.ListBlobsHierarchyOptions{Prefix: &prefix},
+	)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, eris.Wrap(err, "error getting Azure page objects")
+		}
+
+		for _, dir := range page.Segment.BlobPrefixes {
+			relPath, err := filepath.Rel(rootPrefix, *dir.Name)
+			if err != nil {
+				return nil, eris.Wrapf(err, "error getting relative path for dir: %s", *dir.Name)
+			}
+			artifactList = append(artifactList, ArtifactObject{
+				Path:  relPath,
+				Size:  0,
+				IsDir: true,
+			})
+		}
+
+		for _, object := range page.Segment.BlobItems {
+			relPath, err := filepath.Rel(rootPrefix, *object.Name)
+			if err != nil {
+				return nil, eris.Wrapf(err, "error getting relative path for object: %s", *object.Name)
+			}
+			// filter current directory from the result set.
+			if relPath == path {
+				continue
+			}
+			var size int64
+			if object.Properties != nil && object.Properties.ContentLength != nil {
+				size = *object.Properties.ContentLength
+			}
+			artifactList = append(artifactList, ArtifactObject{
+				Path:  relPath,
+				Size:  size,
+				IsDir: false,
+			})
+		}
+	}
+
+	return artifactList, nil
+}
+
+// Get returns file content at the storage location.
+func (s *Azure) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting Azure storage client")
+	}
+
+	// 2. get object from azure storage.
+	resp, err := client.DownloadStream(ctx, containerName, filepath.Join(prefix, path), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object")
+	}
+
+	return resp.Body, nil
+}
+
+// Stat implements ArtifactStorageProvider interface.
+func (s *Azure) Stat(ctx context.Context, artifactURI, path string) (*ArtifactObjectStat, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting Azure storage client")
+	}
+
+	// 2. get object properties from azure storage.
+	resp, err := client.ServiceClient().NewContainerClient(containerName).NewBlobClient(
+		filepath.Join(prefix, path),
+	).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object properties")
+	}
+
+	stat := ArtifactObjectStat{}
+	if resp.ContentLength != nil {
+		stat.Size = *resp.ContentLength
+	}
+	if resp.LastModified != nil {
+		stat.LastModified = *resp.LastModified
+	}
+	if resp.ETag != nil {
+		stat.ETag = string(*resp.ETag)
+	}
+	return &stat, nil
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s *Azure) GetRange(
+	ctx context.Context, artifactURI, path string, offset, length int64,
+) (io.ReadCloser, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting Azure storage client")
+	}
+
+	// 2. get object range from azure storage.
+	resp, err := client.DownloadStream(ctx, containerName, filepath.Join(prefix, path), &azblob.DownloadStreamOptions{
+		Range: azblob.HTTPRange{
+			Offset: offset,
+			Count:  length,
+		},
+	})
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object range")
+	}
+
+	return resp.Body, nil
+}
+
+// Put writes content of the reader into the object at the storage location.
+func (s *Azure) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error getting Azure storage client")
+	}
+
+	// 2. put object into azure storage.
+	if _, err := client.UploadStream(ctx, containerName, filepath.Join(prefix, path), reader, nil); err != nil {
+		return eris.Wrap(err, "error putting object")
+	}
+	return nil
+}
+
+// Delete deletes the object or all the objects under the `directory` at the storage location.
+func (s *Azure) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error getting Azure storage client")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. delete the object itself, if it exists.
+	deleted := 0
+	if _, err := client.DeleteBlob(ctx, containerName, key, nil); err != nil {
+		if !bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return eris.Wrap(err, "error deleting object")
+		}
+	} else {
+		deleted++
+	}
+
+	// 3. delete all the objects under the `directory`.
+	objectPrefix := key + "/"
+	pager := client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{
+		Prefix: &objectPrefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return eris.Wrap(err, "error getting Azure page objects")
+		}
+		for _, object := range page.Segment.BlobItems {
+			if _, err := client.DeleteBlob(ctx, containerName, *object.Name, nil); err != nil {
+				return eris.Wrapf(err, "error deleting object: %s", *object.Name)
+			}
+			deleted++
+		}
+	}
+
+	if deleted == 0 {
+		return eris.Wrap(fs.ErrNotExist, "object does not exist")
+	}
+	return nil
+}
+
+// getClient returns the client, the container name and the prefix for provided artifact uri.
+func (s *Azure) getClient(artifactURI string) (*azblob.Client, string, string, error) {
+	host, containerName, prefix, err := ExtractAzureContainerAndPrefix(artifactURI)
+	if err != nil {
+		return nil, "", "", eris.Wrap(err, "error extracting container and prefix from provided uri")
+	}
+
+	// 1. connection string defines the storage account itself, so the account from the uri is ignored.
+	if s.connectionClient != nil {
+		return s.connectionClient, containerName, prefix, nil
+	}
+
+	// 2. otherwise the endpoint override has priority over the storage account from the uri.
+	serviceURL := s.endpointURI
+	if serviceURL == "" {
+		if host == "" {
+			return nil, "", "", eris.Errorf(
+				"storage account is not provided in uri %q and 'azure-endpoint-uri' flag is not set", artifactURI,
+			)
+		}
+		serviceURL = "https://" + host
+	}
+	if client, ok := s.clients.Load(serviceURL); ok {
+		return client.(*azblob.Client), containerName, prefix, nil
+	}
+
+	var client *azblob.Client
+	if s.sasToken != "" {
+		client, err = azblob.NewClientWithNoCredential(serviceURL+"/?"+s.sasToken, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure storage client with SAS token")
+		}
+	} else {
+		credential, err := s.getCredential()
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating default Azure credential")
+		}
+		client, err = azblob.NewClient(serviceURL, credential, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure storage client")
+		}
+	}
+
+	actual, _ := s.clients.LoadOrStore(serviceURL, client)
+	return actual.(*azblob.Client), containerName, prefix, nil
+}
+
+// getCredential lazily creates default Azure credential, which is shared between all the storage accounts.
+func (s *Azure) getCredential() (azcore.TokenCredential, error) {
+	s.credentialOnce.Do(func() {
+		s.credential, s.credentialErr = azidentity.NewDefaultAzureCredential(nil)
+	})
+	return s.credential, s.credentialErr
+}
@@ -0,0 +1,365 @@
+package storage
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+)
+
+// cacheTempFilePrefix is the prefix of the files, which are being filled and are not cached yet.
+const cacheTempFilePrefix = ".tmp-"
+
+// CacheStats represents statistics of the artifact cache.
+type CacheStats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Size    int64 `json:"size"`
+	Objects int   `json:"objects"`
+}
+
+// cacheEntry represents single cached object.
+type cacheEntry struct {
+	key  string
+	size int64
+}
+
+// Cache represents read-through cache of the artifact objects on the local disk.
+// Objects are keyed by their uri and ETag, so the changed objects are never served from the cache,
+// and the least recently used objects are evicted, when the total size exceeds the limit.
+type Cache struct {
+	dir     string
+	maxSize int64
+	hits    atomic.Int64
+	misses  atomic.Int64
+	mutex   sync.Mutex
+	size    int64
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// NewCache creates new Cache instance.
+// Objects cached by the previous runs are kept, starting from the most recently modified ones.
+func NewCache(dir string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, eris.Wrapf(err, "error creating cache directory: %s", dir)
+	}
+
+	cache := Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+	if err := cache.load(); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// GetStats returns current statistics of the cache.
+func (c *Cache) GetStats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return CacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Size:    c.size,
+		Objects: c.lru.Len(),
+	}
+}
+
+// Wrap returns caching decorator of the storage.
+// Multipart uploads and presigned urls are still available, if the storage supports them.
+func (c *Cache) Wrap(storage ArtifactStorageProvider) ArtifactStorageProvider {
+	cached := &cachedStorage{ArtifactStorageProvider: storage, cache: c}
+	multipartStorage, isMultipart := storage.(MultipartArtifactStorageProvider)
+	presignedStorage, isPresigned := storage.(PresignedArtifactStorageProvider)
+	switch {
+	case isMultipart && isPresigned:
+		return &cachedMultipartPresignedStorage{cached, multipartStorage, presignedStorage}
+	case isMultipart:
+		return &cachedMultipartStorage{cached, multipartStorage}
+	case isPresigned:
+		return &cachedPresignedStorage{cached, presignedStorage}
+	default:
+		return cached
+	}
+}
+
+// load indexes the objects, which have been cached before, and removes unfinished ones.
+func (c *Cache) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return eris.Wrapf(err, "error reading cache directory: %s", c.dir)
+	}
+
+	type cachedFile struct {
+		key      string
+		size     int64
+		modified time.Time
+	}
+	files := make([]cachedFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(entry.Name(), cacheTempFilePrefix) {
+			if err := os.Remove(filepath.Join(c.dir, entry.Name())); err != nil {
+				log.Warnf("error removing unfinished cache file %q: %s", entry.Name(), err)
+			}
+			continue
+		}
+		// only the files named by the cache keys belong to the cache.
+		if _, err := hex.DecodeString(entry.Name()); err != nil || len(entry.Name()) != sha256.Size*2 {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return eris.Wrapf(err, "error getting info for cache file: %s", entry.Name())
+		}
+		files = append(files, cachedFile{key: entry.Name(), size: info.Size(), modified: info.ModTime()})
+	}
+
+	slices.SortFunc(files, func(a, b cachedFile) int {
+		return b.modified.Compare(a.modified)
+	})
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, file := range files {
+		c.entries[file.key] = c.lru.PushBack(&cacheEntry{key: file.key, size: file.size})
+		c.size += file.size
+	}
+	c.evict()
+	return nil
+}
+
+// open returns cached object, if it exists, and marks it as the most recently used one.
+func (c *Cache) open(key string) (*os.File, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	file, err := os.Open(filepath.Join(c.dir, key))
+	if err != nil {
+		log.Warnf("error opening cached artifact object %q: %s", key, err)
+		c.remove(element)
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return file, true
+}
+
+// add moves completely filled temporary file into the cache.
+func (c *Cache) add(key, tempFileName string, size int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// the same object could be filled concurrently by several requests.
+	if _, ok := c.entries[key]; ok {
+		//nolint:errcheck,gosec
+		os.Remove(tempFileName)
+		return
+	}
+	if err := os.Rename(tempFileName, filepath.Join(c.dir, key)); err != nil {
+		log.Warnf("error caching artifact object %q: %s", key, err)
+		//nolint:errcheck,gosec
+		os.Remove(tempFileName)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, size: size})
+	c.size += size
+	c.evict()
+}
+
+// evict removes the least recently used objects until the total size fits the limit.
+// Already opened objects are still readable after the removal.
+func (c *Cache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		element := c.lru.Back()
+		c.remove(element)
+		if err := os.Remove(filepath.Join(c.dir, element.Value.(*cacheEntry).key)); err != nil {
+			log.Warnf("error evicting cached artifact object %q: %s", element.Value.(*cacheEntry).key, err)
+		}
+	}
+}
+
+// remove removes the object from the index of the cache.
+func (c *Cache) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+// getKey returns the cache key of the object. Objects without ETag can't be cached.
+func (c *Cache) getKey(artifactURI, path string, stat *ArtifactObjectStat) string {
+	if stat.ETag == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(strings.Join([]string{artifactURI, path, stat.ETag}, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+// cachedStorage represents caching decorator of the artifact storage.
+// Only the reads of the whole objects fill the cache, while the ranges are served from the already cached objects.
+type cachedStorage struct {
+	ArtifactStorageProvider
+	cache *Cache
+}
+
+// Get implements ArtifactStorageProvider interface.
+func (s *cachedStorage) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	stat, err := s.ArtifactStorageProvider.Stat(ctx, artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetWithStat(ctx, artifactURI, path, stat)
+}
+
+// GetWithStat implements StatArtifactStorageProvider interface.
+func (s *cachedStorage) GetWithStat(
+	ctx context.Context, artifactURI, path string, stat *ArtifactObjectStat,
+) (io.ReadCloser, error) {
+	key := s.cache.getKey(artifactURI, path, stat)
+	if key != "" {
+		if file, ok := s.cache.open(key); ok {
+			s.cache.hits.Add(1)
+			return file, nil
+		}
+	}
+	s.cache.misses.Add(1)
+
+	reader, err := s.ArtifactStorageProvider.Get(ctx, artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" || stat.Size > s.cache.maxSize {
+		return reader, nil
+	}
+
+	file, err := os.CreateTemp(s.cache.dir, cacheTempFilePrefix+"*")
+	if err != nil {
+		log.Warnf("error creating cache file for artifact object %q: %s", path, err)
+		return reader, nil
+	}
+	return &cacheFiller{
+		reader: reader,
+		file:   file,
+		cache:  s.cache,
+		key:    key,
+		size:   stat.Size,
+	}, nil
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s *cachedStorage) GetRange(
+	ctx context.Context, artifactURI, path string, offset, length int64,
+) (io.ReadCloser, error) {
+	stat, err := s.ArtifactStorageProvider.Stat(ctx, artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRangeWithStat(ctx, artifactURI, path, stat, offset, length)
+}
+
+// GetRangeWithStat implements StatArtifactStorageProvider interface.
+func (s *cachedStorage) GetRangeWithStat(
+	ctx context.Context, artifactURI, path string, stat *ArtifactObjectStat, offset, length int64,
+) (io.ReadCloser, error) {
+	if key := s.cache.getKey(artifactURI, path, stat); key != "" {
+		if file, ok := s.cache.open(key); ok {
+			if _, err := file.Seek(offset, io.SeekStart); err != nil {
+				//nolint:errcheck,gosec
+				file.Close()
+				return nil, eris.Wrap(err, "error seeking cached file")
+			}
+			s.cache.hits.Add(1)
+			return limitedReadCloser{
+				Reader: io.LimitReader(file, length),
+				Closer: file,
+			}, nil
+		}
+	}
+	s.cache.misses.Add(1)
+
+	return s.ArtifactStorageProvider.GetRange(ctx, artifactURI, path, offset, length)
+}
+
+// cachedMultipartStorage represents caching decorator of the storage, which supports multipart uploads.
+type cachedMultipartStorage struct {
+	*cachedStorage
+	MultipartArtifactStorageProvider
+}
+
+// cachedPresignedStorage represents caching decorator of the storage, which is able to presign urls.
+type cachedPresignedStorage struct {
+	*cachedStorage
+	PresignedArtifactStorageProvider
+}
+
+// cachedMultipartPresignedStorage represents caching decorator of the storage,
+// which supports multipart uploads and is able to presign urls.
+type cachedMultipartPresignedStorage struct {
+	*cachedStorage
+	MultipartArtifactStorageProvider
+	PresignedArtifactStorageProvider
+}
+
+// cacheFiller copies the object into temporary file while it is read from the storage.
+// The file is moved into the cache only when the whole object has been read successfully.
+type cacheFiller struct {
+	reader   io.ReadCloser
+	file     *os.File
+	cache    *Cache
+	key      string
+	size     int64
+	written  int64
+	failed   bool
+	complete bool
+}
+
+// Read implements io.Reader interface.
+func (f *cacheFiller) Read(p []byte) (int, error) {
+	n, err := f.reader.Read(p)
+	if n > 0 && !f.failed {
+		if _, err := f.file.Write(p[:n]); err != nil {
+			log.Warnf("error writing cache file for artifact object %q: %s", f.key, err)
+			f.failed = true
+		}
+		f.written += int64(n)
+	}
+	if err == io.EOF {
+		f.complete = true
+	}
+	return n, err
+}
+
+// Close implements io.Closer interface.
+func (f *cacheFiller) Close() error {
+	err := f.reader.Close()
+	if closeErr := f.file.Close(); closeErr != nil {
+		f.failed = true
+	}
+	if f.complete && !f.failed && f.written == f.size {
+		f.cache.add(f.key, f.file.Name(), f.size)
+	} else {
+		//nolint:errcheck,gosec
+		os.Remove(f.file.Name())
+	}
+	return err
+}
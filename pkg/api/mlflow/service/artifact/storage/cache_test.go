@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readCachedObject reads the whole artifact object through the cache.
+func readCachedObject(t *testing.T, storage ArtifactStorageProvider, artifactURI, path string) string {
+	reader, err := storage.Get(context.Background(), artifactURI, path)
+	require.Nil(t, err)
+	content, err := io.ReadAll(reader)
+	require.Nil(t, err)
+	require.Nil(t, reader.Close())
+	return string(content)
+}
+
+func TestCache_Get_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("content"), 0o600))
+
+	cache, err := NewCache(t.TempDir(), 1024)
+	require.Nil(t, err)
+	storage := cache.Wrap(&Local{})
+
+	// the first read fills the cache, the second one is served from it.
+	assert.Equal(t, "content", readCachedObject(t, storage, artifactRoot, "file.txt"))
+	assert.Equal(t, CacheStats{Misses: 1, Size: 7, Objects: 1}, cache.GetStats())
+	assert.Equal(t, "content", readCachedObject(t, storage, artifactRoot, "file.txt"))
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Size: 7, Objects: 1}, cache.GetStats())
+
+	// ranges are served from the cache too.
+	reader, err := storage.GetRange(context.Background(), artifactRoot, "file.txt", 1, 3)
+	require.Nil(t, err)
+	content, err := io.ReadAll(reader)
+	require.Nil(t, err)
+	require.Nil(t, reader.Close())
+	assert.Equal(t, "ont", string(content))
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1, Size: 7, Objects: 1}, cache.GetStats())
+
+	// changed object has another ETag, so it is read from the storage again.
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("new content"), 0o600))
+	assert.Equal(t, "new content", readCachedObject(t, storage, artifactRoot, "file.txt"))
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 2, Size: 18, Objects: 2}, cache.GetStats())
+}
+
+func TestCache_Get_NotCached(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "large.txt"), []byte("large content"), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("content"), 0o600))
+
+	cache, err := NewCache(t.TempDir(), 10)
+	require.Nil(t, err)
+	storage := cache.Wrap(&Local{})
+
+	// objects larger than the cache are never cached.
+	assert.Equal(t, "large content", readCachedObject(t, storage, artifactRoot, "large.txt"))
+	assert.Equal(t, CacheStats{Misses: 1}, cache.GetStats())
+
+	// partially read objects are not cached.
+	reader, err := storage.Get(context.Background(), artifactRoot, "file.txt")
+	require.Nil(t, err)
+	_, err = reader.Read(make([]byte, 3))
+	require.Nil(t, err)
+	require.Nil(t, reader.Close())
+	assert.Equal(t, CacheStats{Misses: 2}, cache.GetStats())
+
+	// ranges don't fill the cache.
+	reader, err = storage.GetRange(context.Background(), artifactRoot, "file.txt", 0, 7)
+	require.Nil(t, err)
+	require.Nil(t, reader.Close())
+	assert.Equal(t, CacheStats{Misses: 3}, cache.GetStats())
+}
+
+func TestCache_Evict_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	for _, name := range []string{"file1.txt", "file2.txt", "file3.txt"} {
+		require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, name), []byte("content"), 0o600))
+	}
+
+	cacheDir := t.TempDir()
+	cache, err := NewCache(cacheDir, 14)
+	require.Nil(t, err)
+	storage := cache.Wrap(&Local{})
+
+	// the least recently used object is evicted, when the limit is exceeded.
+	readCachedObject(t, storage, artifactRoot, "file1.txt")
+	readCachedObject(t, storage, artifactRoot, "file2.txt")
+	readCachedObject(t, storage, artifactRoot, "file1.txt")
+	readCachedObject(t, storage, artifactRoot, "file3.txt")
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 3, Size: 14, Objects: 2}, cache.GetStats())
+
+	readCachedObject(t, storage, artifactRoot, "file1.txt")
+	readCachedObject(t, storage, artifactRoot, "file2.txt")
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Size: 14, Objects: 2}, cache.GetStats())
+
+	files, err := os.ReadDir(cacheDir)
+	require.Nil(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestNewCache_Load_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file1.txt"), []byte("content"), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file2.txt"), []byte("content"), 0o600))
+
+	cacheDir := t.TempDir()
+	cache, err := NewCache(cacheDir, 1024)
+	require.Nil(t, err)
+	storage := cache.Wrap(&Local{})
+	readCachedObject(t, storage, artifactRoot, "file1.txt")
+	readCachedObject(t, storage, artifactRoot, "file2.txt")
+
+	// make the first object the least recently used one and leave some unrelated files.
+	files, err := os.ReadDir(cacheDir)
+	require.Nil(t, err)
+	require.Len(t, files, 2)
+	for i, file := range files {
+		modified := time.Now().Add(-time.Duration(i+1) * time.Hour)
+		require.Nil(t, os.Chtimes(filepath.Join(cacheDir, file.Name()), modified, modified))
+	}
+	require.Nil(t, os.WriteFile(filepath.Join(cacheDir, cacheTempFilePrefix+"unfinished"), []byte("c"), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(cacheDir, "unrelated.txt"), []byte("c"), 0o600))
+
+	// objects are loaded from the disk and the least recently used one is evicted first.
+	cache, err = NewCache(cacheDir, 7)
+	require.Nil(t, err)
+	assert.Equal(t, CacheStats{Size: 7, Objects: 1}, cache.GetStats())
+	_, ok := cache.open(files[0].Name())
+	assert.True(t, ok)
+
+	_, err = os.Stat(filepath.Join(cacheDir, cacheTempFilePrefix+"unfinished"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(cacheDir, "unrelated.txt"))
+	assert.Nil(t, err)
+}
+
+func TestCache_Wrap_Ok(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	require.Nil(t, err)
+
+	// optional interfaces of the storages are kept.
+	storage := cache.Wrap(&Local{})
+	_, ok := storage.(MultipartArtifactStorageProvider)
+	assert.True(t, ok)
+	_, ok = storage.(PresignedArtifactStorageProvider)
+	assert.False(t, ok)
+
+	storage = cache.Wrap(&GS{})
+	_, ok = storage.(MultipartArtifactStorageProvider)
+	assert.False(t, ok)
+	_, ok = storage.(PresignedArtifactStorageProvider)
+	assert.True(t, ok)
+
+	storage = cache.Wrap(&S3{})
+	_, ok = storage.(MultipartArtifactStorageProvider)
+	assert.True(t, ok)
+	_, ok = storage.(PresignedArtifactStorageProvider)
+	assert.True(t, ok)
+
+	storage = cache.Wrap(&Azure{})
+	_, ok = storage.(MultipartArtifactStorageProvider)
+	assert.False(t, ok)
+	_, ok = storage.(PresignedArtifactStorageProvider)
+	assert.False(t, ok)
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"io"
 	"io/fs"
+	"net/http"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/rotisserie/eris"
@@ -112,3 +115,134 @@ func (s GS) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return reader, nil
 }
+
+// Stat implements ArtifactStorageProvider interface.
+func (s GS) Stat(ctx context.Context, artifactURI, path string) (*ArtifactObjectStat, error) {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. get object attributes from gcp storage.
+	attrs, err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object attributes")
+	}
+
+	// GS returns entity tag without quotes.
+	return &ArtifactObjectStat{
+		Size:         attrs.Size,
+		LastModified: attrs.Updated,
+		ETag:         strconv.Quote(attrs.Etag),
+	}, nil
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s GS) GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error) {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. get object range from gcp storage.
+	reader, err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewRangeReader(ctx, offset, length)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object range")
+	}
+
+	return reader, nil
+}
+
+// GetPresignedURL implements PresignedArtifactStorageProvider interface.
+// Signing requires service account credentials, otherwise an error is returned.
+func (s GS) GetPresignedURL(_ context.Context, artifactURI, path string, expiration time.Duration) (string, error) {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. sign url to get object from gcp storage.
+	url, err := s.client.Bucket(bucketName).SignedURL(filepath.Join(prefix, path), &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(expiration),
+		Scheme:  storage.SigningSchemeV4,
+	})
+	if err != nil {
+		return "", eris.Wrap(err, "error signing url")
+	}
+	return url, nil
+}
+
+// Put writes content of the reader into the object at the storage location.
+func (s GS) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. write object into gcp storage. object is committed only when writer is closed.
+	writer := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewWriter(ctx)
+	if _, err := io.Copy(writer, reader); err != nil {
+		//nolint:errcheck,gosec
+		writer.Close()
+		return eris.Wrap(err, "error writing object")
+	}
+	if err := writer.Close(); err != nil {
+		return eris.Wrap(err, "error closing object writer")
+	}
+	return nil
+}
+
+// Delete deletes the object or all the objects under the `directory` at the storage location.
+func (s GS) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+	bucket := s.client.Bucket(bucketName)
+
+	// 2. delete the object itself, if it exists.
+	deleted := 0
+	if err := bucket.Object(key).Delete(ctx); err != nil {
+		if !errors.Is(err, storage.ErrObjectNotExist) {
+			return eris.Wrap(err, "error deleting object")
+		}
+	} else {
+		deleted++
+	}
+
+	// 3. delete all the objects under the `directory`.
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: key + "/",
+	})
+	for {
+		object, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return eris.Wrap(err, "error getting object information")
+		}
+		if err := bucket.Object(object.Name).Delete(ctx); err != nil {
+			return eris.Wrapf(err, "error deleting object: %s", object.Name)
+		}
+		deleted++
+	}
+
+	if deleted == 0 {
+		return eris.Wrap(fs.ErrNotExist, "object does not exist")
+	}
+	return nil
+}
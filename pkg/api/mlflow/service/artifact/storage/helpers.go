@@ -20,3 +20,22 @@ func ExtractBucketAndPrefix(uri string) (string, string, error) {
 
 	return u.Host, strings.TrimLeft(u.Path, "/"), nil
 }
+
+// ExtractAzureContainerAndPrefix extracts storage account host, container name and prefix path from provided uri.
+// after processing of uri wasbs://fasttrackml@account.blob.core.windows.net/2/artifacts result will be:
+// - host = account.blob.core.windows.net
+// - container = fasttrackml
+// - prefix = 2/artifacts
+// uri az://fasttrackml/2/artifacts doesn't contain the storage account, so the host is empty.
+func ExtractAzureContainerAndPrefix(uri string) (string, string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", eris.Wrapf(err, "error parsing provided uri: %s", uri)
+	}
+
+	prefix := strings.TrimLeft(u.Path, "/")
+	if u.User == nil {
+		return "", u.Host, prefix, nil
+	}
+	return u.Host, u.User.Username(), prefix, nil
+}
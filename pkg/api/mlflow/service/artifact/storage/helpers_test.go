@@ -14,3 +14,45 @@ func TestExtractS3BucketAndPrefix_Ok(t *testing.T) {
 	assert.Equal(t, "fasttrackml", bucket)
 	assert.Equal(t, "2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts", prefix)
 }
+
+func TestExtractAzureContainerAndPrefix_Ok(t *testing.T) {
+	tests := []struct {
+		name      string
+		uri       string
+		host      string
+		container string
+		prefix    string
+	}{
+		{
+			name:      "WasbsURI",
+			uri:       "wasbs://fasttrackml@account.blob.core.windows.net/2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+			host:      "account.blob.core.windows.net",
+			container: "fasttrackml",
+			prefix:    "2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+		},
+		{
+			name:      "AzURIWithAccount",
+			uri:       "az://fasttrackml@account.blob.core.windows.net",
+			host:      "account.blob.core.windows.net",
+			container: "fasttrackml",
+			prefix:    "",
+		},
+		{
+			name:      "AzURIWithoutAccount",
+			uri:       "az://fasttrackml/2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+			host:      "",
+			container: "fasttrackml",
+			prefix:    "2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, container, prefix, err := ExtractAzureContainerAndPrefix(tt.uri)
+			require.Nil(t, err)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.container, container)
+			assert.Equal(t, tt.prefix, prefix)
+		})
+	}
+}
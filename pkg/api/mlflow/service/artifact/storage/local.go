@@ -1,14 +1,21 @@
 package storage
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
 
@@ -20,6 +27,15 @@ const (
 	LocalStorageName = "file"
 )
 
+// List of names used to stage multipart uploads inside local storage.
+const (
+	LocalMultipartUploadsDir     = ".multipart-uploads"
+	localMultipartUploadPathFile = "path"
+)
+
+// localUploadFileSuffix is the suffix of the files, which are being uploaded next to their final location.
+const localUploadFileSuffix = ".fml-upload"
+
 // Local represents local file storage adapter to work with artifacts.
 type Local struct{}
 
@@ -46,8 +62,16 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 	}
 
 	log.Debugf("got %d objects from local storage for path %q", len(objects), absPath)
-	artifactList := make([]ArtifactObject, len(objects))
-	for i, object := range objects {
+	artifactList := make([]ArtifactObject, 0, len(objects))
+	for _, object := range objects {
+		// multipart uploads are staged in the root of the storage and are not artifacts yet.
+		if object.Name() == LocalMultipartUploadsDir && filepath.Clean(path) == "." {
+			continue
+		}
+		// artifacts, which are being uploaded, become visible only when they are complete.
+		if !object.IsDir() && strings.HasSuffix(object.Name(), localUploadFileSuffix) {
+			continue
+		}
 		info, err := object.Info()
 		if err != nil {
 			if errors.Is(err, fs.ErrNotExist) {
@@ -56,20 +80,68 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 			}
 			return nil, eris.Wrapf(err, "error getting info for object: %s", object.Name())
 		}
-		artifactList[i] = ArtifactObject{
+		artifactObject := ArtifactObject{
 			Path:  filepath.Join(path, info.Name()),
 			IsDir: object.IsDir(),
 		}
 		if !object.IsDir() {
-			artifactList[i].Size = info.Size()
+			artifactObject.Size = info.Size()
 		}
+		artifactList = append(artifactList, artifactObject)
 	}
 
 	return artifactList, nil
 }
 
+// Stat implements ArtifactStorageProvider interface.
+func (s Local) Stat(ctx context.Context, artifactURI, path string) (*ArtifactObjectStat, error) {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. check that the file exists and is not a directory.
+	fileInfo, err := os.Stat(filepath.Join(artifactURI, path))
+	if err != nil {
+		return nil, eris.Wrap(err, "path could not be opened")
+	}
+	if fileInfo.IsDir() {
+		return nil, eris.Wrap(fs.ErrNotExist, "path is a directory")
+	}
+
+	// 3. files have no entity tag, so it is derived from modification time and size.
+	return &ArtifactObjectStat{
+		Size:         fileInfo.Size(),
+		LastModified: fileInfo.ModTime(),
+		ETag:         fmt.Sprintf(`"%x-%x"`, fileInfo.ModTime().UnixNano(), fileInfo.Size()),
+	}, nil
+}
+
 // Get returns actual file content at the storage location.
 func (s Local) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	return s.open(artifactURI, path)
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s Local) GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error) {
+	// 1. open the file.
+	file, err := s.open(artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. seek to the beginning of the range and read only its length.
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		//nolint:errcheck,gosec
+		file.Close()
+		return nil, eris.Wrap(err, "unable to seek file")
+	}
+	return limitedReadCloser{
+		Reader: io.LimitReader(file, length),
+		Closer: file,
+	}, nil
+}
+
+// open opens the file at the storage location.
+func (s Local) open(artifactURI, path string) (*os.File, error) {
 	// 1. trim the `file://` prefix if it exists.
 	artifactURI = strings.TrimPrefix(artifactURI, "file://")
 
@@ -95,3 +167,320 @@ func (s Local) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser
 
 	return file, nil
 }
+
+// limitedReadCloser reads only the limited part of the file and closes the whole file afterwards.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Put writes content of the reader into the file at the storage location.
+func (s Local) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter and create parent directories.
+	absPath := filepath.Join(artifactURI, path)
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return eris.Wrap(err, "unable to create directory")
+	}
+
+	// 3. write the file into temporary file next to it and move it to the final location when it is complete,
+	// so failed uploads never replace the existing artifact with the partially written one.
+	file, err := os.CreateTemp(filepath.Dir(absPath), "."+filepath.Base(absPath)+".*"+localUploadFileSuffix)
+	if err != nil {
+		return eris.Wrap(err, "unable to create file")
+	}
+	//nolint:errcheck
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, reader); err != nil {
+		//nolint:errcheck,gosec
+		file.Close()
+		return eris.Wrap(err, "unable to write file")
+	}
+	if err := file.Close(); err != nil {
+		return eris.Wrap(err, "unable to close file")
+	}
+	// temporary files are private, while artifacts are readable as the ones created by os.Create.
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return eris.Wrap(err, "unable to change file mode")
+	}
+	if err := os.Rename(file.Name(), absPath); err != nil {
+		return eris.Wrap(err, "unable to rename file")
+	}
+	return nil
+}
+
+// Delete deletes the file or the whole directory at the storage location.
+func (s Local) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter and check that it exists. the root itself is deleted
+	// only when it is requested explicitly by the empty path, e.g. by the garbage collector.
+	absPath := filepath.Join(artifactURI, path)
+	if path != "" && absPath == filepath.Clean(artifactURI) {
+		return eris.New("unable to delete root of the storage")
+	}
+	if _, err := os.Stat(absPath); err != nil {
+		return eris.Wrap(err, "path could not be opened")
+	}
+
+	// 3. delete the file or directory.
+	if err := os.RemoveAll(absPath); err != nil {
+		return eris.Wrap(err, "unable to delete path")
+	}
+	return nil
+}
+
+// CreateMultipartUpload implements MultipartArtifactStorageProvider interface.
+// Parts are staged on the disk, so the upload survives both client and server restarts.
+func (s Local) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int,
+) (*MultipartUpload, error) {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. create staging directory and remember the artifact path in it.
+	uploadID := uuid.New().String()
+	uploadDir := filepath.Join(artifactURI, LocalMultipartUploadsDir, uploadID)
+	if err := os.MkdirAll(uploadDir, os.ModePerm); err != nil {
+		return nil, eris.Wrap(err, "unable to create directory")
+	}
+	if err := os.WriteFile(
+		filepath.Join(uploadDir, localMultipartUploadPathFile), []byte(path), 0o600,
+	); err != nil {
+		return nil, eris.Wrap(err, "unable to write multipart upload path")
+	}
+
+	// 3. local storage can't provide direct access, so all the parts go through the proxy.
+	upload := MultipartUpload{
+		UploadID:    uploadID,
+		Credentials: make([]MultipartUploadCredential, numParts),
+	}
+	for i := range upload.Credentials {
+		upload.Credentials[i] = MultipartUploadCredential{
+			PartNumber: i + 1,
+		}
+	}
+	return &upload, nil
+}
+
+// UploadPart implements MultipartArtifactStorageProvider interface.
+// Part is written into temporary file first, so partially written part never replaces already uploaded one.
+func (s Local) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader, _ int64,
+) (*MultipartUploadPart, error) {
+	// 1. find staging directory of the upload.
+	uploadDir, err := getLocalMultipartUploadDir(artifactURI, path, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. write the part into temporary file and calculate its ETag.
+	file, err := os.CreateTemp(uploadDir, "part-*.tmp")
+	if err != nil {
+		return nil, eris.Wrap(err, "unable to create file")
+	}
+	//nolint:errcheck
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), reader)
+	if err != nil {
+		//nolint:errcheck,gosec
+		file.Close()
+		return nil, eris.Wrap(err, "unable to write file")
+	}
+	if err := file.Close(); err != nil {
+		return nil, eris.Wrap(err, "unable to close file")
+	}
+
+	// 3. replace previously uploaded part with the same number, if it exists.
+	parts, err := listLocalMultipartUploadParts(uploadDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		if part.PartNumber == partNumber {
+			if err := os.Remove(filepath.Join(uploadDir, getLocalPartFileName(part))); err != nil {
+				return nil, eris.Wrap(err, "unable to delete previously uploaded part")
+			}
+		}
+	}
+	part := MultipartUploadPart{
+		PartNumber: partNumber,
+		ETag:       hex.EncodeToString(hash.Sum(nil)),
+		Size:       size,
+	}
+	if err := os.Rename(file.Name(), filepath.Join(uploadDir, getLocalPartFileName(part))); err != nil {
+		return nil, eris.Wrap(err, "unable to rename file")
+	}
+
+	return &part, nil
+}
+
+// ListParts implements MultipartArtifactStorageProvider interface.
+func (s Local) ListParts(ctx context.Context, artifactURI, path, uploadID string) ([]MultipartUploadPart, error) {
+	uploadDir, err := getLocalMultipartUploadDir(artifactURI, path, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	return listLocalMultipartUploadParts(uploadDir)
+}
+
+// CompleteMultipartUpload implements MultipartArtifactStorageProvider interface.
+func (s Local) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	// 1. find staging directory of the upload and check that all the requested parts were uploaded.
+	uploadDir, err := getLocalMultipartUploadDir(artifactURI, path, uploadID)
+	if err != nil {
+		return err
+	}
+	uploadedParts, err := listLocalMultipartUploadParts(uploadDir)
+	if err != nil {
+		return err
+	}
+
+	parts = slices.Clone(parts)
+	slices.SortFunc(parts, func(a, b MultipartUploadPart) int {
+		return cmp.Compare(a.PartNumber, b.PartNumber)
+	})
+	for i, part := range parts {
+		idx := slices.IndexFunc(uploadedParts, func(uploadedPart MultipartUploadPart) bool {
+			return uploadedPart.PartNumber == part.PartNumber
+		})
+		if idx == -1 {
+			return eris.Wrapf(fs.ErrNotExist, "part %d has not been uploaded", part.PartNumber)
+		}
+		if etag := strings.Trim(part.ETag, `"`); etag != "" && etag != uploadedParts[idx].ETag {
+			return eris.Wrapf(fs.ErrNotExist, "part %d has different etag", part.PartNumber)
+		}
+		parts[i] = uploadedParts[idx]
+	}
+
+	// 2. assemble the artifact inside staging directory and move it to the final location.
+	// artifactURI and path are validated by the caller
+	// #nosec G304
+	file, err := os.Create(filepath.Join(uploadDir, "artifact.tmp"))
+	if err != nil {
+		return eris.Wrap(err, "unable to create file")
+	}
+	for _, part := range parts {
+		if err := appendLocalFile(file, filepath.Join(uploadDir, getLocalPartFileName(part))); err != nil {
+			//nolint:errcheck,gosec
+			file.Close()
+			return err
+		}
+	}
+	if err := file.Close(); err != nil {
+		return eris.Wrap(err, "unable to close file")
+	}
+
+	absPath := filepath.Join(strings.TrimPrefix(artifactURI, "file://"), path)
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return eris.Wrap(err, "unable to create directory")
+	}
+	if err := os.Rename(file.Name(), absPath); err != nil {
+		return eris.Wrap(err, "unable to rename file")
+	}
+
+	// 3. cleanup staging directory.
+	if err := os.RemoveAll(uploadDir); err != nil {
+		return eris.Wrap(err, "unable to delete multipart upload directory")
+	}
+	return nil
+}
+
+// AbortMultipartUpload implements MultipartArtifactStorageProvider interface.
+func (s Local) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	uploadDir, err := getLocalMultipartUploadDir(artifactURI, path, uploadID)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(uploadDir); err != nil {
+		return eris.Wrap(err, "unable to delete multipart upload directory")
+	}
+	return nil
+}
+
+// getLocalMultipartUploadDir returns staging directory of the multipart upload,
+// if the upload exists and has been created for the same artifact path.
+func getLocalMultipartUploadDir(artifactURI, path, uploadID string) (string, error) {
+	// upload id is provided by the client, so make sure it can't point outside of the staging directory.
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", eris.Wrap(fs.ErrNotExist, "multipart upload does not exist")
+	}
+
+	uploadDir := filepath.Join(
+		strings.TrimPrefix(artifactURI, "file://"), LocalMultipartUploadsDir, uploadID,
+	)
+	uploadPath, err := os.ReadFile(filepath.Join(uploadDir, localMultipartUploadPathFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", eris.Wrap(fs.ErrNotExist, "multipart upload does not exist")
+		}
+		return "", eris.Wrap(err, "unable to read multipart upload path")
+	}
+	if string(uploadPath) != path {
+		return "", eris.Wrapf(fs.ErrNotExist, "multipart upload does not exist for path: %s", path)
+	}
+	return uploadDir, nil
+}
+
+// listLocalMultipartUploadParts lists parts stored inside staging directory ordered by part number.
+func listLocalMultipartUploadParts(uploadDir string) ([]MultipartUploadPart, error) {
+	objects, err := os.ReadDir(uploadDir)
+	if err != nil {
+		return nil, eris.Wrap(err, "error reading multipart upload directory")
+	}
+
+	parts := []MultipartUploadPart{}
+	for _, object := range objects {
+		partNumber, etag, ok := strings.Cut(object.Name(), ".")
+		if !ok || object.IsDir() {
+			continue
+		}
+		number, err := strconv.Atoi(partNumber)
+		if err != nil {
+			continue
+		}
+		info, err := object.Info()
+		if err != nil {
+			return nil, eris.Wrapf(err, "error getting info for object: %s", object.Name())
+		}
+		parts = append(parts, MultipartUploadPart{
+			PartNumber: number,
+			ETag:       etag,
+			Size:       info.Size(),
+		})
+	}
+	slices.SortFunc(parts, func(a, b MultipartUploadPart) int {
+		return cmp.Compare(a.PartNumber, b.PartNumber)
+	})
+	return parts, nil
+}
+
+// getLocalPartFileName returns name of the file, which keeps content of the part.
+func getLocalPartFileName(part MultipartUploadPart) string {
+	return fmt.Sprintf("%d.%s", part.PartNumber, part.ETag)
+}
+
+// appendLocalFile appends content of the file at the provided path to the writer.
+func appendLocalFile(writer io.Writer, path string) error {
+	// path is built by the storage itself
+	// #nosec G304
+	file, err := os.Open(path)
+	if err != nil {
+		return eris.Wrap(err, "unable to open file")
+	}
+	//nolint:errcheck
+	defer file.Close()
+
+	if _, err := io.Copy(writer, file); err != nil {
+		return eris.Wrap(err, "unable to write file")
+	}
+	return nil
+}
@@ -2,10 +2,14 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,6 +70,59 @@ func TestGetArtifact_Error(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestLocal_Stat_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot := t.TempDir()
+	err := os.WriteFile(filepath.Join(runArtifactRoot, "file.txt"), []byte("artifact content"), 0o600)
+	require.Nil(t, err)
+	err = os.MkdirAll(filepath.Join(runArtifactRoot, "subdir"), os.ModePerm)
+	require.Nil(t, err)
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	stat, err := storage.Stat(context.Background(), "file://"+runArtifactRoot, "file.txt")
+	require.Nil(t, err)
+
+	// verify
+	info, err := os.Stat(filepath.Join(runArtifactRoot, "file.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(16), stat.Size)
+	assert.Equal(t, info.ModTime(), stat.LastModified)
+	assert.Regexp(t, `^"[0-9a-f]+-10"$`, stat.ETag)
+
+	// directories and non-existent files are reported as non-existent objects.
+	_, err = storage.Stat(context.Background(), runArtifactRoot, "subdir")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = storage.Stat(context.Background(), runArtifactRoot, "non-existent-file")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestLocal_GetRange_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot := t.TempDir()
+	err := os.WriteFile(filepath.Join(runArtifactRoot, "file.txt"), []byte("artifact content"), 0o600)
+	require.Nil(t, err)
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	file, err := storage.GetRange(context.Background(), runArtifactRoot, "file.txt", 9, 4)
+	require.Nil(t, err)
+	//nolint:errcheck
+	defer file.Close()
+
+	// verify
+	content, err := io.ReadAll(file)
+	require.Nil(t, err)
+	assert.Equal(t, "cont", string(content))
+
+	_, err = storage.GetRange(context.Background(), runArtifactRoot, "non-existent-file", 0, 1)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
 func TestLocal_ListArtifacts_Ok(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -132,3 +189,98 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 		})
 	}
 }
+
+func TestLocal_Delete_Ok(t *testing.T) {
+	// setup
+	artifactRoot := filepath.Join(t.TempDir(), "artifacts")
+	require.Nil(t, os.MkdirAll(filepath.Join(artifactRoot, "dir"), fs.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "dir", "file.txt"), []byte("content"), 0o600))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// invoke and verify
+	require.Nil(t, storage.Delete(context.Background(), artifactRoot, "dir/file.txt"))
+	_, err = os.Stat(filepath.Join(artifactRoot, "dir", "file.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the empty path deletes the whole root.
+	require.Nil(t, storage.Delete(context.Background(), artifactRoot, ""))
+	_, err = os.Stat(artifactRoot)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLocal_Delete_Error(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("content"), 0o600))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// the root of the storage is not deleted by the paths resolved to it.
+	for _, path := range []string{".", "./", "dir/.."} {
+		assert.NotNil(t, storage.Delete(context.Background(), artifactRoot, path))
+		assert.NotNil(t, storage.Delete(context.Background(), "file://"+artifactRoot+"/", path))
+	}
+
+	// verify
+	_, err = os.Stat(filepath.Join(artifactRoot, "file.txt"))
+	assert.Nil(t, err)
+}
+
+func TestLocal_Put_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// invoke
+	require.Nil(t, storage.Put(context.Background(), artifactRoot, "dir/file.txt", strings.NewReader("content")))
+
+	// verify
+	data, err := os.ReadFile(filepath.Join(artifactRoot, "dir/file.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, "content", string(data))
+	entries, err := os.ReadDir(filepath.Join(artifactRoot, "dir"))
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+}
+
+func TestLocal_Put_Error(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("content"), 0o600))
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// invoke. the upload fails in the middle of the body.
+	reader := io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(errors.New("connection reset")))
+	assert.NotNil(t, storage.Put(context.Background(), artifactRoot, "file.txt", reader))
+
+	// verify. the existing artifact is untouched and nothing else is left behind.
+	data, err := os.ReadFile(filepath.Join(artifactRoot, "file.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, "content", string(data))
+	entries, err := os.ReadDir(artifactRoot)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+}
+
+func TestLocal_List_HidesUploadedFiles(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(artifactRoot, "file.txt"), []byte("content"), 0o600))
+	require.Nil(t, os.WriteFile(
+		filepath.Join(artifactRoot, ".other.txt.123"+localUploadFileSuffix), []byte("partial"), 0o600,
+	))
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// invoke
+	objects, err := storage.List(context.Background(), artifactRoot, "")
+	require.Nil(t, err)
+
+	// verify
+	assert.Equal(t, []ArtifactObject{{Path: "file.txt", Size: 7}}, objects)
+}
@@ -14,6 +14,20 @@ type MockArtifactStorageProvider struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, artifactURI, path
+func (_m *MockArtifactStorageProvider) Delete(ctx context.Context, artifactURI string, path string) error {
+	ret := _m.Called(ctx, artifactURI, path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, artifactURI, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, artifactURI, path
 func (_m *MockArtifactStorageProvider) Get(ctx context.Context, artifactURI string, path string) (io.ReadCloser, error) {
 	ret := _m.Called(ctx, artifactURI, path)
@@ -40,6 +54,32 @@ func (_m *MockArtifactStorageProvider) Get(ctx context.Context, artifactURI stri
 	return r0, r1
 }
 
+// GetRange provides a mock function with given fields: ctx, artifactURI, path, offset, length
+func (_m *MockArtifactStorageProvider) GetRange(ctx context.Context, artifactURI string, path string, offset int64, length int64) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, artifactURI, path, offset, length)
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) (io.ReadCloser, error)); ok {
+		return rf(ctx, artifactURI, path, offset, length)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) io.ReadCloser); ok {
+		r0 = rf(ctx, artifactURI, path, offset, length)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64) error); ok {
+		r1 = rf(ctx, artifactURI, path, offset, length)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, artifactURI, path
 func (_m *MockArtifactStorageProvider) List(ctx context.Context, artifactURI string, path string) ([]ArtifactObject, error) {
 	ret := _m.Called(ctx, artifactURI, path)
@@ -66,6 +106,46 @@ func (_m *MockArtifactStorageProvider) List(ctx context.Context, artifactURI str
 	return r0, r1
 }
 
+// Put provides a mock function with given fields: ctx, artifactURI, path, reader
+func (_m *MockArtifactStorageProvider) Put(ctx context.Context, artifactURI string, path string, reader io.Reader) error {
+	ret := _m.Called(ctx, artifactURI, path, reader)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) error); ok {
+		r0 = rf(ctx, artifactURI, path, reader)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Stat provides a mock function with given fields: ctx, artifactURI, path
+func (_m *MockArtifactStorageProvider) Stat(ctx context.Context, artifactURI string, path string) (*ArtifactObjectStat, error) {
+	ret := _m.Called(ctx, artifactURI, path)
+
+	var r0 *ArtifactObjectStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*ArtifactObjectStat, error)); ok {
+		return rf(ctx, artifactURI, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *ArtifactObjectStat); ok {
+		r0 = rf(ctx, artifactURI, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ArtifactObjectStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, artifactURI, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockArtifactStorageProvider creates a new instance of MockArtifactStorageProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockArtifactStorageProvider(t interface {
@@ -3,12 +3,17 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/rotisserie/eris"
@@ -22,9 +27,14 @@ const (
 	S3StorageName = "s3"
 )
 
+// multipartUploadURLExpiration is a lifetime of presigned urls to upload parts of multipart upload.
+const multipartUploadURLExpiration = 6 * time.Hour
+
 // S3 represents S3 adapter to work with artifacts.
 type S3 struct {
-	client *s3.Client
+	client        *s3.Client
+	uploader      *manager.Uploader
+	presignClient *s3.PresignClient
 }
 
 // NewS3 creates new S3 instance.
@@ -55,8 +65,11 @@ func NewS3(ctx context.Context, config *config.ServiceConfig) (*S3, error) {
 		return nil, eris.Wrap(err, "error loading configuration for S3 client")
 	}
 
+	client := s3.NewFromConfig(cfg, clientOptions...)
 	return &S3{
-		s3.NewFromConfig(cfg, clientOptions...),
+		client:        client,
+		uploader:      manager.NewUploader(client),
+		presignClient: s3.NewPresignClient(client),
 	}, nil
 }
 
@@ -144,3 +157,347 @@ func (s S3) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return resp.Body, nil
 }
+
+// Stat implements ArtifactStorageProvider interface.
+func (s S3) Stat(ctx context.Context, artifactURI, path string) (*ArtifactObjectStat, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. get object metadata from s3 storage.
+	resp, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+	})
+	if err != nil {
+		var s3NotFound *types.NotFound
+		if errors.As(err, &s3NotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object")
+	}
+
+	return &ArtifactObjectStat{
+		Size:         aws.ToInt64(resp.ContentLength),
+		LastModified: aws.ToTime(resp.LastModified),
+		ETag:         aws.ToString(resp.ETag),
+	}, nil
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s S3) GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	}
+
+	// 2. get object range from s3 storage.
+	resp, err := s.client.GetObject(ctx, input)
+	if err != nil {
+		var s3NoSuchKey *types.NoSuchKey
+		if errors.As(err, &s3NoSuchKey) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object range")
+	}
+
+	return resp.Body, nil
+}
+
+// Put writes content of the reader into the object at the storage location.
+func (s S3) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Body:   reader,
+	}
+
+	// 2. put object into s3 storage. large objects are uploaded in parts,
+	// so the size of the object doesn't need to be known in advance.
+	if _, err := s.uploader.Upload(ctx, input); err != nil {
+		return eris.Wrap(err, "error putting object")
+	}
+	return nil
+}
+
+// Delete deletes the object or all the objects under the `directory` at the storage location.
+func (s S3) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. collect the object itself, if it exists, and all the objects under the `directory`.
+	var objects []types.ObjectIdentifier
+	if _, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}); err != nil {
+		var s3NotFound *types.NotFound
+		if !errors.As(err, &s3NotFound) {
+			return eris.Wrap(err, "error getting object")
+		}
+	} else {
+		objects = append(objects, types.ObjectIdentifier{Key: aws.String(key)})
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(key + "/"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return eris.Wrap(err, "error getting s3 page objects")
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+		}
+	}
+	if len(objects) == 0 {
+		return eris.Wrap(fs.ErrNotExist, "object does not exist")
+	}
+
+	// 3. delete objects in batches, because S3 accepts at most 1000 keys per request.
+	for len(objects) > 0 {
+		batch := objects[:min(len(objects), 1000)]
+		objects = objects[len(batch):]
+		if _, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &types.Delete{
+				Objects: batch,
+				Quiet:   aws.Bool(true),
+			},
+		}); err != nil {
+			return eris.Wrap(err, "error deleting objects")
+		}
+	}
+	return nil
+}
+
+// GetPresignedURL implements PresignedArtifactStorageProvider interface.
+func (s S3) GetPresignedURL(ctx context.Context, artifactURI, path string, expiration time.Duration) (string, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. presign url to get object from s3 storage.
+	presigned, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+	}, s3.WithPresignExpires(expiration))
+	if err != nil {
+		return "", eris.Wrap(err, "error presigning url")
+	}
+	return presigned.URL, nil
+}
+
+// CreateMultipartUpload implements MultipartArtifactStorageProvider interface.
+// Every part gets a presigned url, so the client is able to upload it directly to S3.
+func (s S3) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int,
+) (*MultipartUpload, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. initiate multipart upload.
+	resp, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating multipart upload")
+	}
+
+	// 3. presign urls for each part.
+	upload := MultipartUpload{
+		UploadID:    *resp.UploadId,
+		Credentials: make([]MultipartUploadCredential, numParts),
+	}
+	for i := range upload.Credentials {
+		partNumber := i + 1
+		presigned, err := s.presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(bucketName),
+			Key:        aws.String(key),
+			UploadId:   resp.UploadId,
+			PartNumber: aws.Int32(int32(partNumber)),
+		}, s3.WithPresignExpires(multipartUploadURLExpiration))
+		if err != nil {
+			return nil, eris.Wrapf(err, "error presigning url for part: %d", partNumber)
+		}
+		headers := make(map[string]string, len(presigned.SignedHeader))
+		for name := range presigned.SignedHeader {
+			// `Host` header is set by http client itself.
+			if name != "Host" {
+				headers[name] = presigned.SignedHeader.Get(name)
+			}
+		}
+		upload.Credentials[i] = MultipartUploadCredential{
+			PartNumber: partNumber,
+			URL:        presigned.URL,
+			Headers:    headers,
+		}
+	}
+
+	return &upload, nil
+}
+
+// UploadPart implements MultipartArtifactStorageProvider interface.
+func (s S3) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader, size int64,
+) (*MultipartUploadPart, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.UploadPartInput{
+		Bucket:     aws.String(bucketName),
+		Key:        aws.String(filepath.Join(prefix, path)),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(int32(partNumber)),
+		Body:       reader,
+	}
+
+	// 2. S3 needs to know the part size in advance. streamed parts can't be hashed to sign the request,
+	// so they are sent with unsigned payload.
+	if size < 0 {
+		return nil, eris.New("part size is unknown")
+	}
+	input.ContentLength = aws.Int64(size)
+	var options []func(*s3.Options)
+	if _, ok := reader.(io.Seeker); !ok {
+		options = append(options, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	}
+
+	// 3. upload the part into s3 storage.
+	resp, err := s.client.UploadPart(ctx, input, options...)
+	if err != nil {
+		return nil, wrapS3MultipartUploadError(err, "error uploading part")
+	}
+
+	return &MultipartUploadPart{
+		PartNumber: partNumber,
+		ETag:       aws.ToString(resp.ETag),
+		Size:       size,
+	}, nil
+}
+
+// ListParts implements MultipartArtifactStorageProvider interface.
+func (s S3) ListParts(ctx context.Context, artifactURI, path, uploadID string) ([]MultipartUploadPart, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. read uploaded parts from s3 storage.
+	var parts []MultipartUploadPart
+	paginator := s3.NewListPartsPaginator(s.client, &s3.ListPartsInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, wrapS3MultipartUploadError(err, "error getting s3 page parts")
+		}
+		for _, part := range page.Parts {
+			parts = append(parts, MultipartUploadPart{
+				PartNumber: int(aws.ToInt32(part.PartNumber)),
+				ETag:       aws.ToString(part.ETag),
+				Size:       aws.ToInt64(part.Size),
+			})
+		}
+	}
+
+	return parts, nil
+}
+
+// CompleteMultipartUpload implements MultipartArtifactStorageProvider interface.
+func (s S3) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	// 1. create s3 request input. S3 requires the parts to be in ascending order.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	completedParts := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completedParts[i] = types.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int32(int32(part.PartNumber)),
+		}
+	}
+	sort.Slice(completedParts, func(i, j int) bool {
+		return *completedParts[i].PartNumber < *completedParts[j].PartNumber
+	})
+
+	// 2. complete multipart upload.
+	if _, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+	}); err != nil {
+		return wrapS3MultipartUploadError(err, "error completing multipart upload")
+	}
+	return nil
+}
+
+// AbortMultipartUpload implements MultipartArtifactStorageProvider interface.
+func (s S3) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. abort multipart upload.
+	if _, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		return wrapS3MultipartUploadError(err, "error aborting multipart upload")
+	}
+	return nil
+}
+
+// wrapS3MultipartUploadError wraps s3 error and converts `NoSuchUpload` error into fs.ErrNotExist.
+func wrapS3MultipartUploadError(err error, msg string) error {
+	// errors.Is is not working for s3 errors, so we need to use errors.As instead.
+	var s3NoSuchUpload *types.NoSuchUpload
+	if errors.As(err, &s3NoSuchUpload) {
+		return eris.Wrap(fs.ErrNotExist, "multipart upload does not exist")
+	}
+	return eris.Wrap(err, msg)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/rotisserie/eris"
 
@@ -33,12 +34,87 @@ func (o ArtifactObject) IsDirectory() bool {
 	return o.IsDir
 }
 
+// ArtifactObjectStat represents metadata of Artifact object, which is required to serve conditional and range requests.
+type ArtifactObjectStat struct {
+	Size         int64 // artifact object size in bytes.
+	LastModified time.Time
+	ETag         string // quoted entity tag, as it is sent in `ETag` header.
+}
+
 // ArtifactStorageProvider provides an interface to work with artifact storage.
 type ArtifactStorageProvider interface {
+	// Stat returns metadata of specific artifact. Directories are reported as not existing objects.
+	Stat(ctx context.Context, artifactURI, path string) (*ArtifactObjectStat, error)
 	// Get returns an io.ReadCloser for specific artifact.
 	Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error)
+	// GetRange returns an io.ReadCloser for `length` bytes of specific artifact starting at `offset`.
+	GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error)
 	// List lists all artifact object under provided path.
 	List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error)
+	// Put writes content of the reader into specific artifact.
+	Put(ctx context.Context, artifactURI, path string, reader io.Reader) error
+	// Delete deletes specific artifact. When path points to a directory, its whole content is deleted.
+	Delete(ctx context.Context, artifactURI, path string) error
+}
+
+// MultipartUpload represents multipart upload agnostic to selected storage.
+type MultipartUpload struct {
+	UploadID    string
+	Credentials []MultipartUploadCredential
+}
+
+// MultipartUploadCredential represents information required to upload a single part of multipart upload.
+// Empty URL means that storage can't provide direct access, so the part has to be uploaded through the proxy.
+type MultipartUploadCredential struct {
+	PartNumber int
+	URL        string
+	Headers    map[string]string
+}
+
+// MultipartUploadPart represents already uploaded part of multipart upload.
+type MultipartUploadPart struct {
+	PartNumber int
+	ETag       string
+	Size       int64
+}
+
+// MultipartArtifactStorageProvider provides an interface to work with multipart uploads.
+// It is implemented only by the storages, which are able to assemble an artifact from the separately uploaded parts.
+type MultipartArtifactStorageProvider interface {
+	// CreateMultipartUpload initiates new multipart upload of specific artifact.
+	CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (*MultipartUpload, error)
+	// UploadPart writes content of the reader as a single part of multipart upload.
+	// The size is the length of the content, or -1 when it is unknown.
+	UploadPart(
+		ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader, size int64,
+	) (*MultipartUploadPart, error)
+	// ListParts lists already uploaded parts of multipart upload.
+	ListParts(ctx context.Context, artifactURI, path, uploadID string) ([]MultipartUploadPart, error)
+	// CompleteMultipartUpload assembles specific artifact from the uploaded parts.
+	CompleteMultipartUpload(
+		ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+	) error
+	// AbortMultipartUpload aborts multipart upload and deletes all the uploaded parts.
+	AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error
+}
+
+// PresignedArtifactStorageProvider provides an interface to download artifacts directly from the storage.
+// It is implemented only by the storages, which are able to presign urls.
+type PresignedArtifactStorageProvider interface {
+	// GetPresignedURL returns short-lived url to download specific artifact directly from the storage.
+	GetPresignedURL(ctx context.Context, artifactURI, path string, expiration time.Duration) (string, error)
+}
+
+// StatArtifactStorageProvider provides an interface to read artifacts, which metadata is already known.
+// It is implemented only by the storages, which would otherwise get the metadata once again before reading.
+type StatArtifactStorageProvider interface {
+	// GetWithStat returns an io.ReadCloser for specific artifact described by the stat.
+	GetWithStat(ctx context.Context, artifactURI, path string, stat *ArtifactObjectStat) (io.ReadCloser, error)
+	// GetRangeWithStat returns an io.ReadCloser for `length` bytes of specific artifact described by the stat
+	// starting at `offset`.
+	GetRangeWithStat(
+		ctx context.Context, artifactURI, path string, stat *ArtifactObjectStat, offset, length int64,
+	) (io.ReadCloser, error)
 }
 
 // ArtifactStorageFactoryProvider provides an interface provider to work with Artifact Storage.
@@ -50,15 +126,29 @@ type ArtifactStorageFactoryProvider interface {
 // ArtifactStorageFactory represents Artifact Storage .
 type ArtifactStorageFactory struct {
 	config      *config.ServiceConfig
+	cache       *Cache
 	storageList sync.Map
 }
 
 // NewArtifactStorageFactory creates new Artifact Storage Factory instance.
 func NewArtifactStorageFactory(config *config.ServiceConfig) (*ArtifactStorageFactory, error) {
-	return &ArtifactStorageFactory{
+	factory := ArtifactStorageFactory{
 		config:      config,
 		storageList: sync.Map{},
-	}, nil
+	}
+	if config.ArtifactsCacheDir != "" {
+		cache, err := NewCache(config.ArtifactsCacheDir, config.ArtifactsCacheMaxSize)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing artifact cache")
+		}
+		factory.cache = cache
+	}
+	return &factory, nil
+}
+
+// GetCache returns the cache of the remote artifact storages, if it has been enabled.
+func (s *ArtifactStorageFactory) GetCache() *Cache {
+	return s.cache
 }
 
 // GetStorage returns Artifact storage based on provided runArtifactPath.
@@ -90,6 +180,12 @@ func (s *ArtifactStorageFactory) GetStorage(
 		if err != nil {
 			return nil, eris.Wrap(err, "error initializing s3 artifact storage")
 		}
+	case AzureStorageName, AzureShortStorageName:
+		var err error
+		storage, err = NewAzure(s.config)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing azure artifact storage")
+		}
 	case "", LocalStorageName:
 		var err error
 		storage, err = NewLocal(s.config)
@@ -100,6 +196,11 @@ func (s *ArtifactStorageFactory) GetStorage(
 		return nil, eris.Errorf("unsupported schema has been provided: %s", u.Scheme)
 	}
 
+	// only the remote storages are cached, there is no reason to copy local files.
+	if s.cache != nil && storageName != "" && storageName != LocalStorageName {
+		storage = s.cache.Wrap(storage)
+	}
+
 	s.storageList.Store(storageName, storage)
 	return storage, nil
 }
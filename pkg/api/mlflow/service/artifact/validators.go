@@ -8,38 +8,157 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
 )
 
+// MaxMultipartUploadParts is the maximum number of parts of multipart upload, the same as S3 has.
+const MaxMultipartUploadParts = 10000
+
 // ValidateListArtifactsRequest validates `GET /mlflow/artifacts/list` request.
 func ValidateListArtifactsRequest(req *request.ListArtifactsRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
+	return validatePath(req.Path)
+}
 
-	parsedUrl, err := url.Parse(req.Path)
-	if err != nil {
-		return api.NewInvalidParameterValueError("error parsing 'path' parameter")
+// ValidateGetArtifactRequest validates `GET /artifacts/get` request.
+func ValidateGetArtifactRequest(req *request.GetArtifactRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
-	if parsedUrl.Scheme != "" || parsedUrl.Host != "" || parsedUrl.RawQuery != "" ||
-		parsedUrl.RawFragment != "" || parsedUrl.User != nil {
-		return api.NewInvalidParameterValueError("provided 'path' parameter is invalid")
+	return validatePath(req.Path)
+}
+
+// ValidateDownloadArtifactsRequest validates `GET /mlflow/artifacts/download` request.
+func ValidateDownloadArtifactsRequest(req *request.DownloadArtifactsRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
-	if filepath.IsAbs(parsedUrl.Path) {
-		return api.NewInvalidParameterValueError("provided 'path' parameter is invalid")
+	if req.Format != "" && !slices.Contains(SupportedArchiveFormats, req.Format) {
+		return api.NewInvalidParameterValueError(
+			"Parameter 'format' must be one of %s, got '%s'", strings.Join(SupportedArchiveFormats, ", "), req.Format,
+		)
 	}
-	if slices.Contains(strings.Split(parsedUrl.Path, "/"), "..") {
+	return validatePath(req.Path)
+}
+
+// ValidateListProxiedArtifactsRequest validates `GET /mlflow-artifacts/artifacts` request.
+func ValidateListProxiedArtifactsRequest(req *request.ListProxiedArtifactsRequest) error {
+	return validateProxiedPath(req.Path)
+}
+
+// ValidateProxiedArtifactRequest validates `GET|PUT|DELETE /mlflow-artifacts/artifacts/{path}` requests.
+func ValidateProxiedArtifactRequest(req *request.ProxiedArtifactRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateCreateMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/create/{path}` request.
+func ValidateCreateMultipartUploadRequest(req *request.CreateMultipartUploadRequest) error {
+	if err := validateRequiredPath(req.Path); err != nil {
+		return err
+	}
+	if req.NumParts < 1 || req.NumParts > MaxMultipartUploadParts {
+		return api.NewInvalidParameterValueError(
+			"Parameter 'num_parts' must be between 1 and %d, got %d", MaxMultipartUploadParts, req.NumParts,
+		)
+	}
+	return nil
+}
+
+// ValidateUploadMultipartUploadPartRequest validates `PUT /mlflow-artifacts/mpu/upload/{path}` request.
+func ValidateUploadMultipartUploadPartRequest(req *request.UploadMultipartUploadPartRequest) error {
+	if err := validateRequiredPath(req.Path); err != nil {
+		return err
+	}
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	return validatePartNumber(req.PartNumber)
+}
+
+// ValidateListMultipartUploadPartsRequest validates `GET /mlflow-artifacts/mpu/parts/{path}` request.
+func ValidateListMultipartUploadPartsRequest(req *request.ListMultipartUploadPartsRequest) error {
+	if err := validateRequiredPath(req.Path); err != nil {
+		return err
+	}
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	return nil
+}
+
+// ValidateCompleteMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/complete/{path}` request.
+func ValidateCompleteMultipartUploadRequest(req *request.CompleteMultipartUploadRequest) error {
+	if err := validateRequiredPath(req.Path); err != nil {
+		return err
+	}
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	if len(req.Parts) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'parts'")
+	}
+	for _, part := range req.Parts {
+		if err := validatePartNumber(part.PartNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAbortMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/abort/{path}` request.
+func ValidateAbortMultipartUploadRequest(req *request.AbortMultipartUploadRequest) error {
+	if err := validateRequiredPath(req.Path); err != nil {
+		return err
+	}
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	return nil
+}
+
+// validatePartNumber validates that part number is in the range supported by the storages.
+func validatePartNumber(partNumber int) error {
+	if partNumber < 1 || partNumber > MaxMultipartUploadParts {
+		return api.NewInvalidParameterValueError(
+			"Parameter 'part_number' must be between 1 and %d, got %d", MaxMultipartUploadParts, partNumber,
+		)
+	}
+	return nil
+}
+
+// validateRequiredPath validates that proxied artifact path has been provided and is valid.
+// The root of the storage itself is never a valid artifact path.
+func validateRequiredPath(path string) error {
+	if path == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+	if err := validateProxiedPath(path); err != nil {
+		return err
+	}
+	if filepath.Clean(path) == "." {
 		return api.NewInvalidParameterValueError("provided 'path' parameter is invalid")
 	}
 	return nil
 }
 
-// ValidateGetArtifactRequest validates `GET /artifacts/get` request.
-func ValidateGetArtifactRequest(req *request.GetArtifactRequest) error {
-	if req.RunID == "" && req.RunUUID == "" {
-		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+// validateProxiedPath validates that proxied artifact path is valid and doesn't point into
+// the staging directory of multipart uploads, which is kept in the root of the local storage.
+func validateProxiedPath(path string) error {
+	if err := validatePath(path); err != nil {
+		return err
 	}
+	root, _, _ := strings.Cut(filepath.ToSlash(filepath.Clean(path)), "/")
+	if root == storage.LocalMultipartUploadsDir {
+		return api.NewInvalidParameterValueError("provided 'path' parameter is invalid")
+	}
+	return nil
+}
 
-	parsedUrl, err := url.Parse(req.Path)
+// validatePath validates that artifact path is relative and doesn't escape the artifact root.
+func validatePath(path string) error {
+	parsedUrl, err := url.Parse(path)
 	if err != nil {
 		return api.NewInvalidParameterValueError("error parsing 'path' parameter")
 	}
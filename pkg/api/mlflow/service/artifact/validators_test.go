@@ -300,3 +300,222 @@ func TestValidateGetArtifactRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDownloadArtifactsRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name: "RootDirectoryWithDefaultFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name: "ZipFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Path:   "foo/bar",
+				Format: ArchiveFormatZip,
+			},
+		},
+		{
+			name: "TarGzFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunUUID: "run_id",
+				Path:    "foo/bar",
+				Format:  ArchiveFormatTarGz,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateDownloadArtifactsRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateDownloadArtifactsRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DownloadArtifactsRequest{},
+		},
+		{
+			name:  "UnsupportedFormat",
+			error: api.NewInvalidParameterValueError("Parameter 'format' must be one of zip, tar.gz, got 'rar'"),
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Format: "rar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+				Path:  "foo/../../bar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDownloadArtifactsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateProxiedArtifactRequest_Ok(t *testing.T) {
+	err := ValidateProxiedArtifactRequest(&request.ProxiedArtifactRequest{
+		Path: "foo/bar.txt",
+	})
+	assert.Nil(t, err)
+}
+
+func TestValidateProxiedArtifactRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.ProxiedArtifactRequest
+	}{
+		{
+			name:    "EmptyPathProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.ProxiedArtifactRequest{},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: "foo/../../bar",
+			},
+		},
+		{
+			name:  "IncorrectLeadingSlash",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: "/foo.bar",
+			},
+		},
+		{
+			name:  "RootPath",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: ".",
+			},
+		},
+		{
+			name:  "RootPathWithTrailingSlash",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: "./",
+			},
+		},
+		{
+			name:  "PathResolvedToRoot",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: "a/..",
+			},
+		},
+		{
+			name:  "MultipartUploadsDirectory",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: ".multipart-uploads",
+			},
+		},
+		{
+			name:  "PathInsideMultipartUploadsDirectory",
+			error: api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+			request: &request.ProxiedArtifactRequest{
+				Path: "./.multipart-uploads/upload-id/1.etag",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateProxiedArtifactRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCreateMultipartUploadRequest_Ok(t *testing.T) {
+	err := ValidateCreateMultipartUploadRequest(&request.CreateMultipartUploadRequest{
+		Path:     "foo/bar.bin",
+		NumParts: 10000,
+	})
+	assert.Nil(t, err)
+}
+
+func TestValidateCreateMultipartUploadRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateMultipartUploadRequest
+	}{
+		{
+			name:    "EmptyPathProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.CreateMultipartUploadRequest{NumParts: 1},
+		},
+		{
+			name:    "IncorrectNumPartsProperty",
+			error:   api.NewInvalidParameterValueError("Parameter 'num_parts' must be between 1 and 10000, got 10001"),
+			request: &request.CreateMultipartUploadRequest{Path: "foo.bin", NumParts: 10001},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateMultipartUploadRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCompleteMultipartUploadRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CompleteMultipartUploadRequest
+	}{
+		{
+			name:    "EmptyUploadIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'"),
+			request: &request.CompleteMultipartUploadRequest{Path: "foo.bin"},
+		},
+		{
+			name:    "EmptyPartsProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'parts'"),
+			request: &request.CompleteMultipartUploadRequest{Path: "foo.bin", UploadID: "id"},
+		},
+		{
+			name:  "IncorrectPartNumberProperty",
+			error: api.NewInvalidParameterValueError("Parameter 'part_number' must be between 1 and 10000, got 0"),
+			request: &request.CompleteMultipartUploadRequest{
+				Path:     "foo.bin",
+				UploadID: "id",
+				Parts:    []request.MultipartUploadPartPartialRequest{{ETag: "etag"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCompleteMultipartUploadRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
@@ -20,29 +21,11 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
-//nolint:lll
-var (
-	filterAnd       = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond      = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
-)
-
-// supported expression list.
-const (
-	InExpression            = "IN"
-	NotInExpression         = "NOT IN"
-	LikeExpression          = "LIKE"
-	ILikeExpression         = "ILIKE"
-	EqualExpression         = "="
-	NotEqualExpression      = "!="
-	LessExpression          = "<"
-	LessOrEqualExpression   = "<="
-	GraterExpression        = ">"
-	GraterOrEqualExpression = ">="
-)
+var experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
 
 // Service provides service layer to work with `metric` business logic.
 type Service struct {
@@ -319,83 +302,13 @@ func (s Service) SearchExperiments(
 
 	// Filter
 	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-
-			switch entity {
-			case "", "attribute", "attributes", "attr":
-				switch key {
-				case "creation_time", "last_update_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "name":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-						if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-							key = fmt.Sprintf("LOWER(%s)", key)
-							comparison = LikeExpression
-							value = strings.ToLower(value.(string))
-						}
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
-					)
-				}
-				query.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError("invalid tag comparison operator '%s'", comparison)
-				}
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("value %s ?", comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-				query.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
-					database.DB.Select(
-						"experiment_id", "value",
-					).Where("key = ?", key).Where(where, value).Model(&database.ExperimentTag{}),
-				)
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['tag', 'attribute']", entity,
-				)
+		comparisons, err := filter.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+		}
+		for n, comparison := range comparisons {
+			if err := applySearchExperimentsFilter(query, n, &comparison); err != nil {
+				return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
 			}
 		}
 	}
@@ -441,3 +354,73 @@ func (s Service) SearchExperiments(
 
 	return exps, limit, offset, nil
 }
+
+// applySearchExperimentsFilter applies single comparison of `SearchExperiments` filter to the query.
+func applySearchExperimentsFilter(query *gorm.DB, n int, comparison *filter.Comparison) error {
+	dialector := database.DB.Dialector.Name()
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		var value any
+		switch comparison.Key {
+		case "creation_time", "last_update_time":
+			if err := comparison.ValidateOperator("numeric attribute", filter.NumericOperators); err != nil {
+				return err
+			}
+			v, err := comparison.IntegerValue()
+			if err != nil {
+				return err
+			}
+			value = v
+		case "name":
+			if err := comparison.ValidateOperator("string attribute", filter.StringOperators); err != nil {
+				return err
+			}
+			value = comparison.Value()
+		default:
+			return filter.NewError(
+				comparison.KeyPosition,
+				"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']",
+				comparison.Key,
+			)
+		}
+		query.Where(comparison.Condition(comparison.Key, value, dialector))
+	case "tag", "tags":
+		if err := comparison.ValidateOperator(
+			"tag", filter.StringOperators, filter.ListOperators, filter.NullOperators,
+		); err != nil {
+			return err
+		}
+		// experiments, which don't have the tag at all, are matched by `IS NULL`.
+		switch comparison.Operator {
+		case filter.IsNullOperator:
+			query.Where(
+				"experiments.experiment_id NOT IN (?)",
+				database.DB.Select("experiment_id").Where("key = ?", comparison.Key).Model(&database.ExperimentTag{}),
+			)
+		case filter.IsNotNullOperator:
+			query.Where(
+				"experiments.experiment_id IN (?)",
+				database.DB.Select("experiment_id").Where("key = ?", comparison.Key).Model(&database.ExperimentTag{}),
+			)
+		default:
+			table := fmt.Sprintf("filter_%d", n)
+			query.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
+				database.DB.Select(
+					"experiment_id", "value",
+				).Where(
+					"key = ?", comparison.Key,
+				).Where(
+					comparison.Condition("value", comparison.Value(), dialector),
+				).Model(&database.ExperimentTag{}),
+			)
+		}
+	default:
+		return filter.NewError(
+			comparison.Position,
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']",
+			comparison.Entity,
+		)
+	}
+	return nil
+}
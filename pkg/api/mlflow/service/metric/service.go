@@ -2,7 +2,6 @@ package metric
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
@@ -29,27 +28,53 @@ func NewService(
 
 func (s Service) GetMetricHistory(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryRequest,
-) ([]models.Metric, error) {
+) ([]models.Metric, int, int, error) {
 	if err := ValidateGetMetricHistoryRequest(req); err != nil {
-		return nil, err
+		return nil, 0, 0, err
+	}
+
+	// like MLflow, the history is paged only when `max_results` or `page_token` is provided,
+	// otherwise the whole history is returned.
+	limit, offset := 0, 0
+	if req.MaxResults != 0 || req.PageToken != "" {
+		limit = int(req.MaxResults)
+		if limit == 0 {
+			limit = repositories.MetricHistoryDefaultLimit
+		}
+		var err error
+		if offset, err = request.DecodePageToken(req.PageToken); err != nil {
+			return nil, 0, 0, err
+		}
 	}
 
 	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
 	if err != nil {
-		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+		return nil, 0, 0, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
 	}
 	if run == nil {
-		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+		return nil, 0, 0, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
-	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req.MetricKey)
+	// one more metric is requested to find out whether there is a next page.
+	pageLimit := 0
+	if limit > 0 {
+		pageLimit = limit + 1
+	}
+	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(
+		ctx,
+		run.ID,
+		req.MetricKey,
+		newMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep),
+		pageLimit,
+		offset,
+	)
 	if err != nil {
-		return nil, api.NewInternalError(
+		return nil, 0, 0, api.NewInternalError(
 			"unable to get metric history for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
 		)
 	}
 
-	return metrics, nil
+	return metrics, limit, offset, nil
 }
 
 func (s Service) GetMetricHistoryBulk(
@@ -63,6 +88,7 @@ func (s Service) GetMetricHistoryBulk(
 		namespace.ID,
 		req.RunIDs,
 		req.MetricKey,
+		newMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep),
 		req.MaxResults,
 	)
 	if err != nil {
@@ -75,13 +101,13 @@ func (s Service) GetMetricHistoryBulk(
 
 func (s Service) GetMetricHistories(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoriesRequest,
-) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
+) (repositories.MetricIterator, error) {
 	adjustGetMetricHistoriesRequestForNamespace(namespace, req)
 	if err := ValidateGetMetricHistoriesRequest(req); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	rows, iterator, err := s.metricRepository.GetMetricHistories(
+	iterator, err := s.metricRepository.GetMetricHistories(
 		ctx,
 		namespace.ID,
 		req.ExperimentIDs,
@@ -90,10 +116,23 @@ func (s Service) GetMetricHistories(
 		req.ViewType,
 		req.MaxResults,
 		req.Context,
+		newMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep),
 	)
 	if err != nil {
-		return nil, nil, api.NewInternalError("Unable to search runs: %s", err)
+		return nil, api.NewInternalError("Unable to search runs: %s", err)
 	}
 
-	return rows, iterator, nil
+	return iterator, nil
+}
+
+// newMetricSampling converts downsampling request parameters into repositories.MetricSampling.
+func newMetricSampling(
+	numPoints int32, method request.SamplingMethod, startStep, endStep *int64,
+) repositories.MetricSampling {
+	return repositories.MetricSampling{
+		NumPoints: int(numPoints),
+		Method:    method,
+		StartStep: startStep,
+		EndStep:   endStep,
+	}
 }
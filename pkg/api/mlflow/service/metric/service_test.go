@@ -2,7 +2,6 @@ package metric
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"testing"
 
@@ -18,55 +17,115 @@ import (
 )
 
 func TestService_GetMetricHistory_Ok(t *testing.T) {
-	// init repository mocks.
-	runRepository := repositories.MockRunRepositoryProvider{}
-	runRepository.On(
-		"GetByNamespaceIDAndRunID",
-		context.TODO(),
-		uint(1),
-		"1",
-	).Return(&models.Run{
-		ID: "1",
-	}, nil)
-
-	metricRepository := repositories.MockMetricRepositoryProvider{}
-	metricRepository.On(
-		"GetMetricHistoryByRunIDAndKey",
-		context.TODO(),
-		"1",
-		"key",
-	).Return([]models.Metric{
+	testData := []struct {
+		name            string
+		request         *request.GetMetricHistoryRequest
+		repositoryLimit int
+		limit           int
+		offset          int
+	}{
 		{
-			Key:       "key",
-			Step:      1,
-			Value:     1.1,
-			Timestamp: 1234567890,
-		},
-	}, nil)
-
-	// call service under testing.
-	service := NewService(&runRepository, &metricRepository)
-	metrics, err := service.GetMetricHistory(
-		context.TODO(),
-		&models.Namespace{
-			ID: 1,
+			name: "WithPaging",
+			request: &request.GetMetricHistoryRequest{
+				RunID:      "1",
+				MetricKey:  "key",
+				MaxResults: 10,
+				// {"offset":20}
+				PageToken: "eyJvZmZzZXQiOjIwfQo=",
+				NumPoints: 100,
+				Sampling:  request.SamplingMethodMinMax,
+				StartStep: common.GetPointer[int64](5),
+			},
+			repositoryLimit: 11,
+			limit:           10,
+			offset:          20,
 		},
-		&request.GetMetricHistoryRequest{
-			RunID:     "1",
-			MetricKey: "key",
+		{
+			name: "WithPageTokenOnly",
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				// {"offset":20}
+				PageToken: "eyJvZmZzZXQiOjIwfQo=",
+				NumPoints: 100,
+				Sampling:  request.SamplingMethodMinMax,
+				StartStep: common.GetPointer[int64](5),
+			},
+			repositoryLimit: repositories.MetricHistoryDefaultLimit + 1,
+			limit:           repositories.MetricHistoryDefaultLimit,
+			offset:          20,
 		},
-	)
-
-	// compare results.
-	require.Nil(t, err)
-	assert.Equal(t, []models.Metric{
 		{
-			Key:       "key",
-			Step:      1,
-			Value:     1.1,
-			Timestamp: 1234567890,
+			name: "WithoutPaging",
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				NumPoints: 100,
+				Sampling:  request.SamplingMethodMinMax,
+				StartStep: common.GetPointer[int64](5),
+			},
 		},
-	}, metrics)
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// init repository mocks.
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetByNamespaceIDAndRunID",
+				context.TODO(),
+				uint(1),
+				"1",
+			).Return(&models.Run{
+				ID: "1",
+			}, nil)
+
+			metricRepository := repositories.MockMetricRepositoryProvider{}
+			metricRepository.On(
+				"GetMetricHistoryByRunIDAndKey",
+				context.TODO(),
+				"1",
+				"key",
+				repositories.MetricSampling{
+					NumPoints: 100,
+					Method:    request.SamplingMethodMinMax,
+					StartStep: common.GetPointer[int64](5),
+				},
+				tt.repositoryLimit,
+				tt.offset,
+			).Return([]models.Metric{
+				{
+					Key:       "key",
+					Step:      1,
+					Value:     1.1,
+					Timestamp: 1234567890,
+				},
+			}, nil)
+
+			// call service under testing.
+			service := NewService(&runRepository, &metricRepository)
+			metrics, limit, offset, err := service.GetMetricHistory(
+				context.TODO(),
+				&models.Namespace{
+					ID: 1,
+				},
+				tt.request,
+			)
+
+			// compare results.
+			require.Nil(t, err)
+			assert.Equal(t, tt.limit, limit)
+			assert.Equal(t, tt.offset, offset)
+			assert.Equal(t, []models.Metric{
+				{
+					Key:       "key",
+					Step:      1,
+					Value:     1.1,
+					Timestamp: 1234567890,
+				},
+			}, metrics)
+		})
+	}
 }
 
 func TestService_GetMetricHistory_Error(t *testing.T) {
@@ -129,16 +188,115 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 					context.TODO(),
 					"1",
 					"key",
+					repositories.MetricSampling{},
+					repositories.MetricHistoryDefaultLimit+1,
+					0,
 				).Return(nil, errors.New("database error"))
 				return NewService(&runRepository, &metricRepository)
 			},
 		},
+		{
+			name: "NegativeMaxResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value -1",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:      "1",
+				MetricKey:  "key",
+				MaxResults: -1,
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "IncorrectNumPoints",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_points' supplied. It must be at least 3, but got value 2",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				NumPoints: 2,
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name:  "UnsupportedSampling",
+			error: api.NewInvalidParameterValueError("Invalid sampling 'unsupported'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				NumPoints: 10,
+				Sampling:  "unsupported",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "SamplingWithoutNumPoints",
+			error: api.NewInvalidParameterValueError(
+				"Missing value for parameter 'num_points' required by 'sampling'",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				Sampling:  request.SamplingMethodLTTB,
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "IncorrectStepRange",
+			error: api.NewInvalidParameterValueError(
+				"Invalid step range supplied. 'start_step' 10 is greater than 'end_step' 5",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				StartStep: common.GetPointer[int64](10),
+				EndStep:   common.GetPointer[int64](5),
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "IncorrectPageToken",
+			error: api.NewInvalidParameterValueError(
+				"invalid page_token 'incorrect': invalid character '\\x8a' looking for beginning of value",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				PageToken: "incorrect",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, _, _, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -155,6 +313,11 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 		uint(1),
 		[]string{"1", "2"},
 		"key",
+		repositories.MetricSampling{
+			NumPoints: 50,
+			StartStep: common.GetPointer[int64](1),
+			EndStep:   common.GetPointer[int64](100),
+		},
 		10,
 	).Return([]models.Metric{
 		{
@@ -173,6 +336,9 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 		RunIDs:     []string{"1", "2"},
 		MetricKey:  "key",
 		MaxResults: 10,
+		NumPoints:  50,
+		StartStep:  common.GetPointer[int64](1),
+		EndStep:    common.GetPointer[int64](100),
 	})
 
 	// compare results.
@@ -251,6 +417,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 					uint(1),
 					[]string{"1"},
 					"key",
+					repositories.MetricSampling{},
 					10,
 				).Return(nil, errors.New("database error"))
 				return NewService(&runRepository, &metricRepository)
@@ -272,9 +439,8 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 		name         string
 		namespace    *models.Namespace
 		request      *request.GetMetricHistoriesRequest
-		expectedRows *sql.Rows
+		expectedIter repositories.MetricIterator
 		expectedErr  error
-		expectedIter func(*sql.Rows, interface{}) error
 	}{
 		{
 			name: "WithExplicitExperimentIDs",
@@ -288,11 +454,8 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 				ViewType:      request.ViewTypeActiveOnly,
 				MaxResults:    1,
 			},
-			expectedRows: &sql.Rows{},
-			expectedIter: func(*sql.Rows, interface{}) error {
-				return nil
-			},
-			expectedErr: nil,
+			expectedIter: &repositories.MockMetricIterator{},
+			expectedErr:  nil,
 		},
 		{
 			name: "WithDefaultExperimentID",
@@ -306,11 +469,8 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 				ViewType:      request.ViewTypeActiveOnly,
 				MaxResults:    1,
 			},
-			expectedRows: &sql.Rows{},
-			expectedIter: func(*sql.Rows, interface{}) error {
-				return nil
-			},
-			expectedErr: nil,
+			expectedIter: &repositories.MockMetricIterator{},
+			expectedErr:  nil,
 		},
 	}
 
@@ -336,20 +496,17 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 				request.ViewTypeActiveOnly,
 				int32(1),
 				map[string]string(nil),
+				repositories.MetricSampling{},
 			).Return(
-				tt.expectedRows,
 				tt.expectedIter,
 				nil,
 			)
 
 			// call service under testing.
 			service := NewService(&runRepository, &metricRepository)
-			//nolint:rowserrcheck,sqlclosecheck
-			rows, iterator, err := service.GetMetricHistories(context.TODO(), tt.namespace, tt.request)
+			iterator, err := service.GetMetricHistories(context.TODO(), tt.namespace, tt.request)
 			assert.Equal(t, tt.expectedErr, err)
-			assert.Equal(t, tt.expectedRows, rows)
-			require.Nil(t, rows.Err())
-			assert.NotNil(t, iterator)
+			assert.Equal(t, tt.expectedIter, iterator)
 		})
 	}
 }
@@ -425,8 +582,8 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 					request.ViewTypeAll,
 					int32(1),
 					map[string]string(nil),
+					repositories.MetricSampling{},
 				).Return(
-					nil,
 					nil,
 					errors.New("database error"),
 				)
@@ -438,8 +595,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			//nolint:rowserrcheck,sqlclosecheck
-			_, _, err := tt.service().GetMetricHistories(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, err := tt.service().GetMetricHistories(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -7,7 +7,9 @@ import (
 
 const (
 	MaxResultsForMetricHistoriesRequest  = 1000000000
+	MaxResultsForMetricHistoryRequest    = 1000000
 	MaxRunIDsForMetricHistoryBulkRequest = 200
+	MinNumPointsForMetricSampling        = 3
 )
 
 // AllowedViewTypeList supported list of ViewType.
@@ -20,6 +22,15 @@ var (
 	}
 )
 
+// AllowedSamplingMethodList supported list of SamplingMethod.
+var (
+	AllowedSamplingMethodList = map[request.SamplingMethod]struct{}{
+		"":                           {},
+		request.SamplingMethodLTTB:   {},
+		request.SamplingMethodMinMax: {},
+	}
+)
+
 // ValidateGetMetricHistoryRequest validates `GET /mlflow/metrics/get-history` request.
 func ValidateGetMetricHistoryRequest(req *request.GetMetricHistoryRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
@@ -28,7 +39,19 @@ func ValidateGetMetricHistoryRequest(req *request.GetMetricHistoryRequest) error
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'")
 	}
-	return nil
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value %d",
+			req.MaxResults,
+		)
+	}
+	if req.MaxResults > MaxResultsForMetricHistoryRequest {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d, but got value %d",
+			MaxResultsForMetricHistoryRequest, req.MaxResults,
+		)
+	}
+	return validateMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep)
 }
 
 // ValidateGetMetricHistoryBulkRequest validates `GET /mlflow/metrics/get-history-bulk` request.
@@ -46,7 +69,7 @@ func ValidateGetMetricHistoryBulkRequest(req *request.GetMetricHistoryBulkReques
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("GetMetricHistoryBulk request must specify a metric_key.")
 	}
-	return nil
+	return validateMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep)
 }
 
 // ValidateGetMetricHistoriesRequest validates `GET /mlflow/metrics/get-histories` request.
@@ -66,5 +89,27 @@ func ValidateGetMetricHistoriesRequest(req *request.GetMetricHistoriesRequest) e
 	if req.MaxResults > MaxResultsForMetricHistoriesRequest {
 		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied.")
 	}
+	return validateMetricSampling(req.NumPoints, req.Sampling, req.StartStep, req.EndStep)
+}
+
+// validateMetricSampling validates downsampling parameters of the metric history endpoints.
+func validateMetricSampling(numPoints int32, method request.SamplingMethod, startStep, endStep *int64) error {
+	if numPoints != 0 && numPoints < MinNumPointsForMetricSampling {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'num_points' supplied. It must be at least %d, but got value %d",
+			MinNumPointsForMetricSampling, numPoints,
+		)
+	}
+	if _, ok := AllowedSamplingMethodList[method]; !ok {
+		return api.NewInvalidParameterValueError("Invalid sampling '%s'", method)
+	}
+	if method != "" && numPoints == 0 {
+		return api.NewInvalidParameterValueError("Missing value for parameter 'num_points' required by 'sampling'")
+	}
+	if startStep != nil && endStep != nil && *startStep > *endStep {
+		return api.NewInvalidParameterValueError(
+			"Invalid step range supplied. 'start_step' %d is greater than 'end_step' %d", *startStep, *endStep,
+		)
+	}
 	return nil
 }
@@ -37,6 +37,28 @@ func TestValidateGetMetricHistoryRequest_Error(t *testing.T) {
 				RunID: "id",
 			},
 		},
+		{
+			name: "NegativeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value -1",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:      "id",
+				MetricKey:  "key",
+				MaxResults: -1,
+			},
+		},
+		{
+			name: "TooLargeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000000, but got value 1000001",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:      "id",
+				MetricKey:  "key",
+				MaxResults: MaxResultsForMetricHistoryRequest + 1,
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -2,26 +2,800 @@ package model
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
 )
 
+var modelOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
 // Service provides service layer to work with `model` business logic.
-type Service struct{}
+type Service struct {
+	runRepository             repositories.RunRepositoryProvider
+	modelVersionRepository    repositories.ModelVersionRepositoryProvider
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider
+}
 
 // NewService creates new Service instance.
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	modelVersionRepository repositories.ModelVersionRepositoryProvider,
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider,
+) *Service {
+	return &Service{
+		runRepository:             runRepository,
+		modelVersionRepository:    modelVersionRepository,
+		registeredModelRepository: registeredModelRepository,
+	}
+}
+
+// CreateRegisteredModel creates new Registered Model entity.
+func (s Service) CreateRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.CreateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateCreateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("error getting registered model with name: '%s', error: %s", req.Name, err)
+	}
+	if registeredModel != nil {
+		return nil, api.NewResourceAlreadyExistsError("Registered Model (name=%s) already exists.", req.Name)
+	}
+
+	registeredModel = convertors.ConvertCreateRegisteredModelRequestToDBModel(ns.ID, req)
+	if err := s.registeredModelRepository.Create(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("error inserting registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// GetRegisteredModel returns existing Registered Model entity by its name.
+func (s Service) GetRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.GetRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateGetRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getRegisteredModel(ctx, ns, req.Name)
+}
+
+// RenameRegisteredModel renames existing Registered Model entity.
+func (s Service) RenameRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.RenameRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateRenameRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	existingModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.NewName)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"error getting registered model with name: '%s', error: %s", req.NewName, err,
+		)
+	}
+	if existingModel != nil {
+		return nil, api.NewResourceAlreadyExistsError("Registered Model (name=%s) already exists.", req.NewName)
+	}
+
+	registeredModel.Name = req.NewName
+	registeredModel.LastUpdatedTime = newTimestamp()
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to rename registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// UpdateRegisteredModel updates description of existing Registered Model entity.
+func (s Service) UpdateRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.UpdateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateUpdateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredModel.Description = req.Description
+	registeredModel.LastUpdatedTime = newTimestamp()
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to update registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// DeleteRegisteredModel deletes existing Registered Model entity together with all its versions.
+func (s Service) DeleteRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.Delete(ctx, registeredModel); err != nil {
+		return api.NewInternalError("unable to delete registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// GetLatestVersions returns the latest Model Version entity for each requested stage.
+func (s Service) GetLatestVersions(
+	ctx context.Context, ns *models.Namespace, req *request.GetLatestVersionsRequest,
+) (*models.RegisteredModel, []models.ModelVersion, error) {
+	if err := ValidateGetLatestVersionsRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stages := make([]models.ModelVersionStage, len(req.Stages))
+	for n, stage := range req.Stages {
+		// stages have been already validated, so it is safe to ignore the flag here.
+		stages[n], _ = models.NewModelVersionStage(stage)
+	}
+
+	return registeredModel, registeredModel.LatestVersions(stages...), nil
+}
+
+// SetRegisteredModelTag creates or updates a tag of existing Registered Model entity.
+func (s Service) SetRegisteredModelTag(
+	ctx context.Context, ns *models.Namespace, req *request.SetRegisteredModelTagRequest,
+) error {
+	if err := ValidateSetRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	tag := convertors.ConvertSetRegisteredModelTagRequestToDBModel(registeredModel, req)
+	if err := s.registeredModelRepository.SetTag(ctx, tag); err != nil {
+		return api.NewInternalError("unable to set tag for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelTag deletes a tag of existing Registered Model entity.
+func (s Service) DeleteRegisteredModelTag(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelTagRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.DeleteTag(ctx, &models.RegisteredModelTag{
+		Key:               req.Key,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete tag for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// SetRegisteredModelAlias creates or moves an alias of existing Registered Model entity.
+func (s Service) SetRegisteredModelAlias(
+	ctx context.Context, ns *models.Namespace, req *request.SetRegisteredModelAliasRequest,
+) error {
+	if err := ValidateSetRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.SetAlias(ctx, &models.RegisteredModelAlias{
+		Alias:             req.Alias,
+		Version:           modelVersion.Version,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to set alias for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelAlias deletes an alias of existing Registered Model entity.
+func (s Service) DeleteRegisteredModelAlias(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelAliasRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.DeleteAlias(ctx, &models.RegisteredModelAlias{
+		Alias:             req.Alias,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete alias for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// GetModelVersionByAlias returns existing Model Version entity which the alias points to.
+func (s Service) GetModelVersionByAlias(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionByAliasRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateGetModelVersionByAliasRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, alias := range registeredModel.Aliases {
+		if alias.Alias == req.Alias {
+			return s.getModelVersion(ctx, ns, req.Name, fmt.Sprint(alias.Version))
+		}
+	}
+
+	return nil, nil, api.NewResourceDoesNotExistError(
+		"Registered model alias %s not found for model %s", req.Alias, req.Name,
+	)
+}
+
+// CreateModelVersion creates new Model Version entity for existing Registered Model entity.
+func (s Service) CreateModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.CreateModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateCreateModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.RunID != "" {
+		run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, ns.ID, req.RunID)
+		if err != nil {
+			return nil, nil, api.NewInternalError("unable to find run '%s': %s", req.RunID, err)
+		}
+		if run == nil {
+			return nil, nil, api.NewResourceDoesNotExistError("Run with id=%s not found", req.RunID)
+		}
+	}
+
+	modelVersion := convertors.ConvertCreateModelVersionRequestToDBModel(registeredModel, req)
+	if err := s.modelVersionRepository.Create(ctx, modelVersion); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to create model version for registered model '%s': %s", req.Name, err,
+		)
+	}
+
+	return registeredModel, modelVersion, nil
+}
+
+// GetModelVersion returns existing Model Version entity.
+func (s Service) GetModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateGetModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+	return s.getModelVersion(ctx, ns, req.Name, req.Version)
+}
+
+// UpdateModelVersion updates description of existing Model Version entity.
+func (s Service) UpdateModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.UpdateModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateUpdateModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modelVersion.Description = req.Description
+	modelVersion.LastUpdatedTime = newTimestamp()
+	if err := s.modelVersionRepository.Update(ctx, modelVersion); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to update model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return registeredModel, modelVersion, nil
+}
+
+// DeleteModelVersion deletes existing Model Version entity.
+func (s Service) DeleteModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteModelVersionRequest,
+) error {
+	if err := ValidateDeleteModelVersionRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	modelVersion.LastUpdatedTime = newTimestamp()
+	if err := s.modelVersionRepository.Delete(ctx, modelVersion); err != nil {
+		return api.NewInternalError(
+			"unable to delete model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
+}
+
+// GetModelVersionDownloadURI returns existing Model Version entity, which holds the download URI.
+func (s Service) GetModelVersionDownloadURI(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionDownloadURIRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateGetModelVersionDownloadURIRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelVersion, nil
+}
+
+// TransitionModelVersionStage moves existing Model Version entity into the requested stage.
+func (s Service) TransitionModelVersionStage(
+	ctx context.Context, ns *models.Namespace, req *request.TransitionModelVersionStageRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateTransitionModelVersionStageRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// stage has been already validated, so it is safe to ignore the flag here.
+	stage, _ := models.NewModelVersionStage(req.Stage)
+	modelVersion.CurrentStage = stage
+	modelVersion.LastUpdatedTime = newTimestamp()
+
+	// the same as MLflow does, only `Staging` and `Production` stages are exclusive.
+	archiveExistingVersions := req.ArchiveExistingVersions &&
+		(stage == models.ModelVersionStageStaging || stage == models.ModelVersionStageProduction)
+	if err := s.modelVersionRepository.TransitionStage(ctx, modelVersion, archiveExistingVersions); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to transition stage of model version '%s' of registered model '%s': %s",
+			req.Version, req.Name, err,
+		)
+	}
+
+	return registeredModel, modelVersion, nil
+}
+
+// SetModelVersionTag creates or updates a tag of existing Model Version entity.
+func (s Service) SetModelVersionTag(
+	ctx context.Context, ns *models.Namespace, req *request.SetModelVersionTagRequest,
+) error {
+	if err := ValidateSetModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	tag := convertors.ConvertSetModelVersionTagRequestToDBModel(modelVersion, req)
+	if err := s.modelVersionRepository.SetTag(ctx, tag); err != nil {
+		return api.NewInternalError(
+			"unable to set tag for model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteModelVersionTag deletes a tag of existing Model Version entity.
+func (s Service) DeleteModelVersionTag(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteModelVersionTagRequest,
+) error {
+	if err := ValidateDeleteModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.modelVersionRepository.DeleteTag(ctx, &models.ModelVersionTag{
+		Key:            req.Key,
+		ModelVersionID: modelVersion.ID,
+	}); err != nil {
+		return api.NewInternalError(
+			"unable to delete tag for model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
+}
+
+// SearchRegisteredModels searches Registered Model entities using provided filter and ordering.
+func (s Service) SearchRegisteredModels(
+	ctx context.Context, ns *models.Namespace, req *request.SearchRegisteredModelsRequest,
+) ([]models.RegisteredModel, int, int, error) {
+	if err := ValidateSearchRegisteredModelsRequest(req); err != nil {
+		return nil, 0, 0, err
+	}
+
+	db := s.registeredModelRepository.GetDB()
+	query := db.WithContext(ctx).Where("registered_models.namespace_id = ?", ns.ID)
+
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = 100
+	}
+	offset, err := request.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	query.Limit(limit + 1).Offset(offset)
+
+	if req.Filter != "" {
+		comparisons, err := filter.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+		}
+		for n, comparison := range comparisons {
+			if err := applySearchRegisteredModelsFilter(db, query, n, &comparison); err != nil {
+				return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+			}
+		}
+	}
+
+	nameOrder := false
+	for _, o := range req.OrderBy {
+		components := modelOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		var column string
+		switch components[1] {
+		case "name":
+			nameOrder = true
+			column = "name"
+		case "creation_timestamp":
+			column = "creation_time"
+		case "last_updated_timestamp", "timestamp":
+			column = "last_updated_time"
+		default:
+			return nil, 0, 0, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are ['name', 'creation_timestamp', 'last_updated_timestamp']`,
+				components[1],
+			)
+		}
+		query.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "registered_models", Name: column},
+			Desc:   strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	if !nameOrder {
+		query.Order("registered_models.name ASC")
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := query.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions", "current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	).Preload(
+		"Versions.Tags",
+	).Find(&registeredModels).Error; err != nil {
+		return nil, 0, 0, api.NewInternalError("unable to search registered models: %s", err)
+	}
+
+	return registeredModels, limit, offset, nil
+}
+
+// SearchModelVersions searches Model Version entities using provided filter and ordering.
+func (s Service) SearchModelVersions(
+	ctx context.Context, ns *models.Namespace, req *request.SearchModelVersionsRequest,
+) ([]models.ModelVersion, int, int, error) {
+	if err := ValidateSearchModelVersionsRequest(req); err != nil {
+		return nil, 0, 0, err
+	}
+
+	db := s.modelVersionRepository.GetDB()
+	query := db.WithContext(ctx).Joins(
+		"JOIN registered_models ON registered_models.id = model_versions.registered_model_id",
+	).Where(
+		"registered_models.namespace_id = ?", ns.ID,
+	).Where(
+		"model_versions.current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	)
+
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = 10000
+	}
+	offset, err := request.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	query.Limit(limit + 1).Offset(offset)
+
+	if req.Filter != "" {
+		comparisons, err := filter.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+		}
+		for n, comparison := range comparisons {
+			if err := applySearchModelVersionsFilter(db, query, n, &comparison); err != nil {
+				return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+			}
+		}
+	}
+
+	for _, o := range req.OrderBy {
+		components := modelOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		var column clause.Column
+		switch components[1] {
+		case "name":
+			column = clause.Column{Table: "registered_models", Name: "name"}
+		case "version_number":
+			column = clause.Column{Table: "model_versions", Name: "version"}
+		case "creation_timestamp":
+			column = clause.Column{Table: "model_versions", Name: "creation_time"}
+		case "last_updated_timestamp", "timestamp":
+			column = clause.Column{Table: "model_versions", Name: "last_updated_time"}
+		default:
+			return nil, 0, 0, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are `+
+					`['name', 'version_number', 'creation_timestamp', 'last_updated_timestamp']`,
+				components[1],
+			)
+		}
+		query.Order(clause.OrderByColumn{
+			Column: column,
+			Desc:   strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	query.Order(
+		"model_versions.last_updated_time DESC",
+	).Order(
+		"registered_models.name ASC",
+	).Order(
+		"model_versions.version DESC",
+	)
+
+	var modelVersions []models.ModelVersion
+	if err := query.Preload(
+		"Tags",
+	).Preload(
+		"RegisteredModel",
+	).Preload(
+		"RegisteredModel.Aliases",
+	).Find(&modelVersions).Error; err != nil {
+		return nil, 0, 0, api.NewInternalError("unable to search model versions: %s", err)
+	}
+
+	return modelVersions, limit, offset, nil
+}
+
+// getRegisteredModel returns existing Registered Model entity or `RESOURCE_DOES_NOT_EXIST` error.
+func (s Service) getRegisteredModel(
+	ctx context.Context, ns *models.Namespace, name string,
+) (*models.RegisteredModel, error) {
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get registered model by name '%s': %s", name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("Registered Model with name=%s not found", name)
+	}
+	return registeredModel, nil
+}
+
+// getModelVersion returns existing Model Version entity together with its Registered Model entity
+// or `RESOURCE_DOES_NOT_EXIST` error.
+func (s Service) getModelVersion(
+	ctx context.Context, ns *models.Namespace, name, version string,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	registeredModel, err := s.getRegisteredModel(ctx, ns, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsedVersion, err := strconv.ParseInt(version, 10, 32)
+	if err != nil {
+		return nil, nil, api.NewBadRequestError("unable to parse model version '%s': %s", version, err)
+	}
+
+	modelVersion, err := s.modelVersionRepository.GetByRegisteredModelIDAndVersion(
+		ctx, registeredModel.ID, int32(parsedVersion),
+	)
+	if err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to get model version '%s' of registered model '%s': %s", version, name, err,
+		)
+	}
+	if modelVersion == nil {
+		return nil, nil, api.NewResourceDoesNotExistError(
+			"Model Version (name=%s, version=%s) not found", name, version,
+		)
+	}
+	return registeredModel, modelVersion, nil
+}
+
+// applySearchRegisteredModelsFilter applies single comparison of `SearchRegisteredModels` filter to the query.
+func applySearchRegisteredModelsFilter(db, query *gorm.DB, n int, comparison *filter.Comparison) error {
+	dialector := db.Dialector.Name()
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		if comparison.Key != "name" {
+			return filter.NewError(
+				comparison.KeyPosition, "invalid attribute '%s'. Valid values are ['name']", comparison.Key,
+			)
+		}
+		if err := comparison.ValidateOperator("string attribute", filter.StringOperators); err != nil {
+			return err
+		}
+		query.Where(comparison.Condition("registered_models.name", comparison.Value(), dialector))
+	case "tag", "tags":
+		if err := comparison.ValidateOperator("tag", filter.StringOperators); err != nil {
+			return err
+		}
+		table := fmt.Sprintf("filter_%d", n)
+		query.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON registered_models.id = %s.registered_model_id", table, table),
+			db.Select(
+				"registered_model_id",
+			).Where(
+				"key = ?", comparison.Key,
+			).Where(
+				comparison.Condition("value", comparison.Value(), dialector),
+			).Model(&models.RegisteredModelTag{}),
+		)
+	default:
+		return filter.NewError(
+			comparison.Position,
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']",
+			comparison.Entity,
+		)
+	}
+	return nil
 }
 
-func (s Service) SearchModelVersions(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"model_versions": []any{},
-	}, nil
+// applySearchModelVersionsFilter applies single comparison of `SearchModelVersions` filter to the query.
+func applySearchModelVersionsFilter(db, query *gorm.DB, n int, comparison *filter.Comparison) error {
+	dialector := db.Dialector.Name()
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		var (
+			column string
+			value  any
+		)
+		switch comparison.Key {
+		case "name", "source_path":
+			if err := comparison.ValidateOperator("string attribute", filter.StringOperators); err != nil {
+				return err
+			}
+			column, value = "registered_models.name", comparison.Value()
+			if comparison.Key == "source_path" {
+				column = "model_versions.source"
+			}
+		case "run_id":
+			if err := comparison.ValidateOperator(
+				"run_id", []filter.Operator{filter.EqualOperator, filter.NotEqualOperator}, filter.ListOperators,
+			); err != nil {
+				return err
+			}
+			column, value = "model_versions.run_id", comparison.Value()
+		case "version_number":
+			if err := comparison.ValidateOperator("numeric attribute", filter.NumericOperators); err != nil {
+				return err
+			}
+			v, err := comparison.IntegerValue()
+			if err != nil {
+				return err
+			}
+			column, value = "model_versions.version", v
+		default:
+			return filter.NewError(
+				comparison.KeyPosition,
+				"invalid attribute '%s'. Valid values are ['name', 'run_id', 'source_path', 'version_number']",
+				comparison.Key,
+			)
+		}
+		query.Where(comparison.Condition(column, value, dialector))
+	case "tag", "tags":
+		if err := comparison.ValidateOperator("tag", filter.StringOperators); err != nil {
+			return err
+		}
+		table := fmt.Sprintf("filter_%d", n)
+		query.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON model_versions.id = %s.model_version_id", table, table),
+			db.Select(
+				"model_version_id",
+			).Where(
+				"key = ?", comparison.Key,
+			).Where(
+				comparison.Condition("value", comparison.Value(), dialector),
+			).Model(&models.ModelVersionTag{}),
+		)
+	default:
+		return filter.NewError(
+			comparison.Position,
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']",
+			comparison.Entity,
+		)
+	}
+	return nil
 }
 
-func (s Service) SearchRegisteredModels(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"registered_models": []any{},
-	}, nil
+// newTimestamp returns current timestamp in milliseconds.
+func newTimestamp() sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
 }
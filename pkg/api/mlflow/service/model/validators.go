@@ -0,0 +1,289 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+const (
+	MaxResultsForSearchRegisteredModelsRequest = 1000
+	MaxResultsForSearchModelVersionsRequest    = 200000
+)
+
+// reservedAliasRegexp matches aliases which can't be assigned by the user,
+// because they would clash with version numbers (`v1`, `v2`, ...) and the `latest` keyword.
+var reservedAliasRegexp = regexp.MustCompile(`(?i)^(latest|v\d+)$`)
+
+// ValidateCreateRegisteredModelRequest validates `POST /mlflow/registered-models/create` request.
+func ValidateCreateRegisteredModelRequest(req *request.CreateRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetRegisteredModelRequest validates `GET /mlflow/registered-models/get` request.
+func ValidateGetRegisteredModelRequest(req *request.GetRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateRenameRegisteredModelRequest validates `POST /mlflow/registered-models/rename` request.
+func ValidateRenameRegisteredModelRequest(req *request.RenameRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.NewName == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'new_name'")
+	}
+	return nil
+}
+
+// ValidateUpdateRegisteredModelRequest validates `PATCH /mlflow/registered-models/update` request.
+func ValidateUpdateRegisteredModelRequest(req *request.UpdateRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateDeleteRegisteredModelRequest validates `DELETE /mlflow/registered-models/delete` request.
+func ValidateDeleteRegisteredModelRequest(req *request.DeleteRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateSearchRegisteredModelsRequest validates `GET /mlflow/registered-models/search` request.
+func ValidateSearchRegisteredModelsRequest(req *request.SearchRegisteredModelsRequest) error {
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value %d",
+			req.MaxResults,
+		)
+	}
+	if req.MaxResults > MaxResultsForSearchRegisteredModelsRequest {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d, but got value %d",
+			MaxResultsForSearchRegisteredModelsRequest,
+			req.MaxResults,
+		)
+	}
+	return nil
+}
+
+// ValidateGetLatestVersionsRequest validates `POST /mlflow/registered-models/get-latest-versions` request.
+func ValidateGetLatestVersionsRequest(req *request.GetLatestVersionsRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	for _, stage := range req.Stages {
+		if err := validateStage(stage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSetRegisteredModelTagRequest validates `POST /mlflow/registered-models/set-tag` request.
+func ValidateSetRegisteredModelTagRequest(req *request.SetRegisteredModelTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateDeleteRegisteredModelTagRequest validates `DELETE /mlflow/registered-models/delete-tag` request.
+func ValidateDeleteRegisteredModelTagRequest(req *request.DeleteRegisteredModelTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateSetRegisteredModelAliasRequest validates `POST /mlflow/registered-models/alias` request.
+func ValidateSetRegisteredModelAliasRequest(req *request.SetRegisteredModelAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	if reservedAliasRegexp.MatchString(req.Alias) {
+		return api.NewInvalidParameterValueError(
+			"Invalid alias name: '%s'. Aliases 'latest' and 'v<number>' are reserved", req.Alias,
+		)
+	}
+	return validateVersion(req.Version)
+}
+
+// ValidateDeleteRegisteredModelAliasRequest validates `DELETE /mlflow/registered-models/alias` request.
+func ValidateDeleteRegisteredModelAliasRequest(req *request.DeleteRegisteredModelAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	return nil
+}
+
+// ValidateGetModelVersionByAliasRequest validates `GET /mlflow/registered-models/alias` request.
+func ValidateGetModelVersionByAliasRequest(req *request.GetModelVersionByAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	return nil
+}
+
+// ValidateCreateModelVersionRequest validates `POST /mlflow/model-versions/create` request.
+func ValidateCreateModelVersionRequest(req *request.CreateModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Source == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'source'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetModelVersionRequest validates `GET /mlflow/model-versions/get` request.
+func ValidateGetModelVersionRequest(req *request.GetModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return validateVersion(req.Version)
+}
+
+// ValidateUpdateModelVersionRequest validates `PATCH /mlflow/model-versions/update` request.
+func ValidateUpdateModelVersionRequest(req *request.UpdateModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return validateVersion(req.Version)
+}
+
+// ValidateDeleteModelVersionRequest validates `DELETE /mlflow/model-versions/delete` request.
+func ValidateDeleteModelVersionRequest(req *request.DeleteModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return validateVersion(req.Version)
+}
+
+// ValidateSearchModelVersionsRequest validates `GET /mlflow/model-versions/search` request.
+func ValidateSearchModelVersionsRequest(req *request.SearchModelVersionsRequest) error {
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value %d",
+			req.MaxResults,
+		)
+	}
+	if req.MaxResults > MaxResultsForSearchModelVersionsRequest {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d, but got value %d",
+			MaxResultsForSearchModelVersionsRequest,
+			req.MaxResults,
+		)
+	}
+	return nil
+}
+
+// ValidateGetModelVersionDownloadURIRequest validates `GET /mlflow/model-versions/get-download-uri` request.
+func ValidateGetModelVersionDownloadURIRequest(req *request.GetModelVersionDownloadURIRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return validateVersion(req.Version)
+}
+
+// ValidateTransitionModelVersionStageRequest validates `POST /mlflow/model-versions/transition-stage` request.
+func ValidateTransitionModelVersionStageRequest(req *request.TransitionModelVersionStageRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if err := validateVersion(req.Version); err != nil {
+		return err
+	}
+	if req.Stage == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'stage'")
+	}
+	return validateStage(req.Stage)
+}
+
+// ValidateSetModelVersionTagRequest validates `POST /mlflow/model-versions/set-tag` request.
+func ValidateSetModelVersionTagRequest(req *request.SetModelVersionTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if err := validateVersion(req.Version); err != nil {
+		return err
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateDeleteModelVersionTagRequest validates `DELETE /mlflow/model-versions/delete-tag` request.
+func ValidateDeleteModelVersionTagRequest(req *request.DeleteModelVersionTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if err := validateVersion(req.Version); err != nil {
+		return err
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// validateVersion validates that model version is a positive integer.
+func validateVersion(version string) error {
+	if version == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'version'")
+	}
+	if v, err := strconv.ParseInt(version, 10, 32); err != nil || v < 1 {
+		return api.NewInvalidParameterValueError(
+			"Parameter 'version' must be a positive integer, got '%s'", version,
+		)
+	}
+	return nil
+}
+
+// validateStage validates that the stage is one of the supported model version stages.
+func validateStage(stage string) error {
+	if _, ok := models.NewModelVersionStage(stage); !ok {
+		return api.NewInvalidParameterValueError(
+			"Invalid Model Version stage: %s. Value must be one of None, Staging, Production, Archived.", stage,
+		)
+	}
+	return nil
+}
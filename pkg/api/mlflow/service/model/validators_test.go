@@ -0,0 +1,240 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateCreateRegisteredModelRequest_Ok(t *testing.T) {
+	err := ValidateCreateRegisteredModelRequest(&request.CreateRegisteredModelRequest{
+		Name: "name",
+		Tags: []request.RegisteredModelTagPartialRequest{
+			{Key: "key", Value: "value"},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateRegisteredModelRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:  "EmptyTagKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'"),
+			request: &request.CreateRegisteredModelRequest{
+				Name: "name",
+				Tags: []request.RegisteredModelTagPartialRequest{{Value: "value"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateRegisteredModelRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSetRegisteredModelAliasRequest_Ok(t *testing.T) {
+	err := ValidateSetRegisteredModelAliasRequest(&request.SetRegisteredModelAliasRequest{
+		Name:    "name",
+		Alias:   "champion",
+		Version: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateSetRegisteredModelAliasRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetRegisteredModelAliasRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.SetRegisteredModelAliasRequest{},
+		},
+		{
+			name:    "EmptyAliasProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'alias'"),
+			request: &request.SetRegisteredModelAliasRequest{Name: "name"},
+		},
+		{
+			name: "ReservedLatestAlias",
+			error: api.NewInvalidParameterValueError(
+				"Invalid alias name: 'latest'. Aliases 'latest' and 'v<number>' are reserved",
+			),
+			request: &request.SetRegisteredModelAliasRequest{Name: "name", Alias: "latest", Version: "1"},
+		},
+		{
+			name: "ReservedVersionAlias",
+			error: api.NewInvalidParameterValueError(
+				"Invalid alias name: 'v12'. Aliases 'latest' and 'v<number>' are reserved",
+			),
+			request: &request.SetRegisteredModelAliasRequest{Name: "name", Alias: "v12", Version: "1"},
+		},
+		{
+			name:    "IncorrectVersionProperty",
+			error:   api.NewInvalidParameterValueError("Parameter 'version' must be a positive integer, got '0'"),
+			request: &request.SetRegisteredModelAliasRequest{Name: "name", Alias: "champion", Version: "0"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetRegisteredModelAliasRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCreateModelVersionRequest_Ok(t *testing.T) {
+	err := ValidateCreateModelVersionRequest(&request.CreateModelVersionRequest{
+		Name:   "name",
+		Source: "source",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateModelVersionRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateModelVersionRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateModelVersionRequest{},
+		},
+		{
+			name:    "EmptySourceProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'source'"),
+			request: &request.CreateModelVersionRequest{Name: "name"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateModelVersionRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateTransitionModelVersionStageRequest_Ok(t *testing.T) {
+	err := ValidateTransitionModelVersionStageRequest(&request.TransitionModelVersionStageRequest{
+		Name:    "name",
+		Version: "1",
+		Stage:   "staging",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateTransitionModelVersionStageRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.TransitionModelVersionStageRequest
+	}{
+		{
+			name:    "EmptyVersionProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: &request.TransitionModelVersionStageRequest{Name: "name"},
+		},
+		{
+			name:    "EmptyStageProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+			request: &request.TransitionModelVersionStageRequest{Name: "name", Version: "1"},
+		},
+		{
+			name: "IncorrectStageProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid Model Version stage: unknown. Value must be one of None, Staging, Production, Archived.",
+			),
+			request: &request.TransitionModelVersionStageRequest{Name: "name", Version: "1", Stage: "unknown"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransitionModelVersionStageRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchRegisteredModelsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SearchRegisteredModelsRequest
+	}{
+		{
+			name: "NegativeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value -1",
+			),
+			request: &request.SearchRegisteredModelsRequest{MaxResults: -1},
+		},
+		{
+			name: "TooLargeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000, but got value 1001",
+			),
+			request: &request.SearchRegisteredModelsRequest{MaxResults: MaxResultsForSearchRegisteredModelsRequest + 1},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchRegisteredModelsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchModelVersionsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SearchModelVersionsRequest
+	}{
+		{
+			name: "NegativeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be a positive integer, but got value -1",
+			),
+			request: &request.SearchModelVersionsRequest{MaxResults: -1},
+		},
+		{
+			name: "TooLargeMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 200000, but got value 200001",
+			),
+			request: &request.SearchModelVersionsRequest{MaxResults: MaxResultsForSearchModelVersionsRequest + 1},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchModelVersionsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
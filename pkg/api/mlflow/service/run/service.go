@@ -17,33 +17,16 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 //nolint:lll
-var (
-	runOrder      = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
-	filterAnd     = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond    = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	filterInGroup = regexp.MustCompile(`,\s*`)
-)
-
-// supported expression list.
-const (
-	InExpression            = "IN"
-	NotInExpression         = "NOT IN"
-	LikeExpression          = "LIKE"
-	ILikeExpression         = "ILIKE"
-	EqualExpression         = "="
-	NotEqualExpression      = "!="
-	LessExpression          = "<"
-	LessOrEqualExpression   = "<="
-	GraterExpression        = ">"
-	GraterOrEqualExpression = ">="
-)
+var runOrder = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
 
 // Service provides service layer to work with `run` business logic.
 type Service struct {
@@ -52,6 +35,7 @@ type Service struct {
 	paramRepository      repositories.ParamRepositoryProvider
 	metricRepository     repositories.MetricRepositoryProvider
 	experimentRepository repositories.ExperimentRepositoryProvider
+	datasetRepository    repositories.DatasetRepositoryProvider
 }
 
 // NewService creates new Service instance.
@@ -61,6 +45,7 @@ func NewService(
 	paramRepository repositories.ParamRepositoryProvider,
 	metricRepository repositories.MetricRepositoryProvider,
 	experimentRepository repositories.ExperimentRepositoryProvider,
+	datasetRepository repositories.DatasetRepositoryProvider,
 ) *Service {
 	return &Service{
 		tagRepository:        tagRepository,
@@ -68,6 +53,7 @@ func NewService(
 		paramRepository:      paramRepository,
 		metricRepository:     metricRepository,
 		experimentRepository: experimentRepository,
+		datasetRepository:    datasetRepository,
 	}
 }
 
@@ -153,6 +139,32 @@ func (s Service) GetRun(
 	return run, nil
 }
 
+// GetRunTree returns the run together with all its descendants.
+func (s Service) GetRunTree(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.GetRunTreeRequest,
+) (*models.Run, []models.Run, error) {
+	if err := ValidateGetRunTreeRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return nil, nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	descendants, err := s.runRepository.GetDescendantsByNamespaceIDAndRunID(ctx, namespace.ID, run.ID)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to get descendants of run '%s': %s", run.ID, err)
+	}
+
+	return run, descendants, nil
+}
+
 // nolint:gocyclo
 // TODO:get back and fix `gocyclo` problem.
 func (s Service) SearchRuns(
@@ -216,147 +228,13 @@ func (s Service) SearchRuns(
 
 	// Filter
 	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-
-			var kind any
-			switch entity {
-			case "", "attribute", "attributes", "attr", "run":
-				switch key {
-				case "start_time", "end_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_name":
-					key = "mlflow.runName"
-					kind = &database.Tag{}
-					fallthrough
-				case "status", "user_id", "artifact_uri":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_id":
-					key = "run_uuid"
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					case InExpression, NotInExpression:
-						if !strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid list definition '%s'", value)
-						}
-						var values []string
-						for _, v := range filterInGroup.Split(value.(string)[1:len(value.(string))-1], -1) {
-							values = append(values, strings.Trim(v, "'"))
-						}
-						value = values
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						`invalid attribute '%s'. `+
-							`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
-						key,
-					)
-				}
-			case "metric", "metrics":
-				switch comparison {
-				case GraterExpression, GraterOrEqualExpression,
-					NotEqualExpression, EqualExpression, LessExpression, LessOrEqualExpression:
-					v, err := strconv.ParseFloat(value.(string), 64)
-					if err != nil {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-					}
-					value = v
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid metric comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.LatestMetric{}
-			case "parameter", "parameters", "param", "params":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid param comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Param{}
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid tag comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Tag{}
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']", entity,
-				)
-			}
-
-			if kind == nil {
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					key = fmt.Sprintf("LOWER(runs.%s)", key)
-					comparison = LikeExpression
-					value = strings.ToLower(value.(string))
-					tx.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-				} else {
-					tx.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-				}
-			} else {
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("value %s ?", comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-				tx.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-					database.DB.Select("run_uuid", "value").Where("key = ?", key).Where(where, value).Model(kind),
-				)
+		comparisons, err := filter.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
+		}
+		for n, comparison := range comparisons {
+			if err := applySearchRunsFilter(tx, n, &comparison); err != nil {
+				return nil, 0, 0, api.NewInvalidParameterValueError("%s", err)
 			}
 		}
 	}
@@ -417,6 +295,8 @@ func (s Service) SearchRuns(
 	tx.Preload("LatestMetrics").
 		Preload("Params").
 		Preload("Tags").
+		Preload("Inputs.Dataset").
+		Preload("Inputs.Tags").
 		Find(&runs)
 	if tx.Error != nil {
 		return nil, 0, 0, api.NewInternalError("unable to search runs: %s", tx.Error)
@@ -425,6 +305,174 @@ func (s Service) SearchRuns(
 	return runs, limit, offset, nil
 }
 
+// applySearchRunsFilter applies single comparison of `SearchRuns` filter to the query.
+func applySearchRunsFilter(tx *gorm.DB, n int, comparison *filter.Comparison) error {
+	key, column := comparison.Key, "value"
+	var kind, value any
+	var valueTypes []string
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr", "run":
+		switch key {
+		case "start_time", "end_time":
+			if err := comparison.ValidateOperator(
+				"numeric attribute", filter.NumericOperators, filter.NullOperators,
+			); err != nil {
+				return err
+			}
+			v, err := comparison.IntegerValue()
+			if err != nil {
+				return err
+			}
+			value = v
+		case "run_name":
+			key, kind = convertors.TagKeyRunName, &database.Tag{}
+			fallthrough
+		case "status", "user_id", "artifact_uri":
+			if err := comparison.ValidateOperator(
+				"string attribute", filter.StringOperators, filter.NullOperators,
+			); err != nil {
+				return err
+			}
+			value = comparison.Value()
+		case "run_id":
+			key = "run_uuid"
+			if err := comparison.ValidateOperator(
+				"string attribute", filter.StringOperators, filter.ListOperators,
+			); err != nil {
+				return err
+			}
+			value = comparison.Value()
+		default:
+			return filter.NewError(
+				comparison.KeyPosition,
+				`invalid attribute '%s'. `+
+					`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
+				key,
+			)
+		}
+	case "metric", "metrics":
+		if err := comparison.ValidateOperator("metric", filter.NumericOperators, filter.NullOperators); err != nil {
+			return err
+		}
+		v, err := comparison.FloatValue()
+		if err != nil {
+			return err
+		}
+		kind, value = &database.LatestMetric{}, v
+	case "parameter", "parameters", "param", "params":
+		if err := comparison.ValidateOperator(
+			"param", filter.NumericOperators, filter.StringOperators, filter.ListOperators, filter.NullOperators,
+		); err != nil {
+			return err
+		}
+		kind, value = &database.Param{}, comparison.Value()
+		// numbers are compared only with the params of the numeric types, anything else is compared as strings.
+		if comparison.IsNumeric() {
+			v, err := comparison.FloatValue()
+			if err != nil {
+				return err
+			}
+			column, value = "value_numeric", v
+			valueTypes = []string{common.ParamTypeInt, common.ParamTypeFloat}
+		}
+	case "tag", "tags":
+		if err := comparison.ValidateOperator(
+			"tag", filter.StringOperators, filter.ListOperators, filter.NullOperators,
+		); err != nil {
+			return err
+		}
+		kind, value = &database.Tag{}, comparison.Value()
+	case "dataset", "datasets":
+		return applySearchRunsDatasetFilter(tx, n, comparison)
+	default:
+		return filter.NewError(
+			comparison.Position,
+			"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute', 'dataset']",
+			comparison.Entity,
+		)
+	}
+
+	dialector := database.DB.Dialector.Name()
+	if kind == nil {
+		tx.Where(comparison.Condition(fmt.Sprintf("runs.%s", key), value, dialector))
+		return nil
+	}
+
+	// runs, which don't have the key at all, are matched by `IS NULL`.
+	switch comparison.Operator {
+	case filter.IsNullOperator:
+		tx.Where("runs.run_uuid NOT IN (?)", database.DB.Select("run_uuid").Where("key = ?", key).Model(kind))
+	case filter.IsNotNullOperator:
+		tx.Where("runs.run_uuid IN (?)", database.DB.Select("run_uuid").Where("key = ?", key).Model(kind))
+	default:
+		condition := database.DB.Where(comparison.Condition(column, value, dialector))
+		if valueTypes != nil {
+			condition = condition.Where("value_type IN ?", valueTypes)
+			// the params of the other types are always different from the number, so they are
+			// compared with it as strings.
+			if comparison.Operator == filter.NotEqualOperator {
+				condition = condition.Or(
+					database.DB.Where(
+						"value_type NOT IN ?", valueTypes,
+					).Where(
+						comparison.Condition("value", comparison.Value(), dialector),
+					),
+				)
+			}
+		}
+		subQuery := database.DB.Select(
+			"run_uuid", "value",
+		).Where(
+			"key = ?", key,
+		).Where(
+			condition,
+		).Model(kind)
+		table := fmt.Sprintf("filter_%d", n)
+		tx.Joins(fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table), subQuery)
+	}
+	return nil
+}
+
+// applySearchRunsDatasetFilter applies `datasets.<key>` comparison of `SearchRuns` filter to the query.
+// Datasets are linked to the runs through the inputs, so they are filtered separately.
+func applySearchRunsDatasetFilter(tx *gorm.DB, n int, comparison *filter.Comparison) error {
+	if err := comparison.ValidateOperator("dataset", filter.StringOperators, filter.ListOperators); err != nil {
+		return err
+	}
+
+	subQuery := database.DB.Model(
+		&database.Input{},
+	).Distinct(
+		"inputs.run_uuid",
+	).Joins(
+		"JOIN datasets ON datasets.id = inputs.dataset_id",
+	)
+	var column string
+	switch comparison.Key {
+	case "name", "digest":
+		column = fmt.Sprintf("datasets.%s", comparison.Key)
+	case "context":
+		subQuery = subQuery.Joins(
+			"JOIN input_tags ON input_tags.input_id = inputs.id AND input_tags.key = ?",
+			convertors.TagKeyDatasetContext,
+		)
+		column = "input_tags.value"
+	default:
+		return filter.NewError(
+			comparison.KeyPosition,
+			"invalid dataset attribute '%s'. Valid values are ['name', 'digest', 'context']",
+			comparison.Key,
+		)
+	}
+
+	table := fmt.Sprintf("filter_%d", n)
+	tx.Joins(
+		fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
+		subQuery.Where(comparison.Condition(column, comparison.Value(), database.DB.Dialector.Name())),
+	)
+	return nil
+}
+
 // DeleteRun handles delete models.Run entity business logic.
 func (s Service) DeleteRun(
 	ctx context.Context, namespace *models.Namespace, req *request.DeleteRunRequest,
@@ -627,3 +675,31 @@ func (s Service) LogBatch(
 
 	return nil
 }
+
+// LogInputs handles logic of dataset inputs logging for the run.
+func (s Service) LogInputs(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogInputsRequest,
+) error {
+	if err := ValidateLogInputsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.RunID, models.LifecycleStageActive,
+	)
+	if err != nil {
+		return api.NewInternalError("Unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("Unable to find active run '%s'", req.RunID)
+	}
+
+	inputs := convertors.ConvertLogInputsRequestToDBModel(run.ID, req)
+	if err := s.datasetRepository.CreateRunInputs(ctx, run, inputs); err != nil {
+		return api.NewInternalError("unable to insert inputs for run '%s': %s", run.ID, err)
+	}
+
+	return nil
+}
@@ -67,6 +67,7 @@ func TestService_CreateRun_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&experimentRepository,
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	run, err := service.CreateRun(context.TODO(), &ns, &request.CreateRunRequest{
 		ExperimentID: "0", // default experiment id provided by the client is "0"
@@ -122,6 +123,7 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -145,6 +147,7 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&experimentRepository,
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -197,6 +200,7 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&experimentRepository,
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -233,6 +237,7 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -256,6 +261,7 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -296,6 +302,7 @@ func TestService_RestoreRun_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.RestoreRun(context.TODO(), &models.Namespace{ID: 1}, &request.RestoreRunRequest{RunID: "1"})
 
@@ -321,6 +328,7 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -344,6 +352,7 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -379,6 +388,7 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -420,6 +430,7 @@ func TestService_SetRunTag_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.SetRunTag(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -456,6 +467,7 @@ func TestService_DeleteRun_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.DeleteRun(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteRunRequest{RunID: "1"})
 
@@ -481,6 +493,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -504,6 +517,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -527,6 +541,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -560,6 +575,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -595,6 +611,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -619,6 +636,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -643,6 +661,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -678,6 +697,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -727,6 +747,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -793,6 +814,7 @@ func TestService_GetRun_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	run, err := service.GetRun(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -854,6 +876,7 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -877,6 +900,7 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -893,6 +917,180 @@ func TestService_GetRun_Error(t *testing.T) {
 	}
 }
 
+func TestService_GetRunTree_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"1",
+	).Return(&models.Run{
+		ID:     "1",
+		Status: models.StatusRunning,
+	}, nil)
+	runRepository.On(
+		"GetDescendantsByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"1",
+	).Return([]models.Run{
+		{
+			ID:     "2",
+			Status: models.StatusFinished,
+			Tags: []models.Tag{
+				{
+					Key:   common.ParentRunIDTagKey,
+					Value: "1",
+				},
+			},
+		},
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
+	)
+	run, descendants, err := service.GetRunTree(context.TODO(), &models.Namespace{
+		ID: 1,
+	}, &request.GetRunTreeRequest{RunID: "1"})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, "1", run.ID)
+	assert.Equal(t, models.StatusRunning, run.Status)
+	assert.Equal(t, []models.Run{
+		{
+			ID:     "2",
+			Status: models.StatusFinished,
+			Tags: []models.Tag{
+				{
+					Key:   common.ParentRunIDTagKey,
+					Value: "1",
+				},
+			},
+		},
+	}, descendants)
+}
+
+func TestService_GetRunTree_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetRunTreeRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError(`Missing value for required parameter 'run_id'`),
+			request: &request.GetRunTreeRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "RunNotFound",
+			error: api.NewResourceDoesNotExistError(`unable to find run '1'`),
+			request: &request.GetRunTreeRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(nil, nil)
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "RunDatabaseError",
+			error: api.NewInternalError(`unable to find run '1': database error`),
+			request: &request.GetRunTreeRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(nil, errors.New("database error"))
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "DescendantsDatabaseError",
+			error: api.NewInternalError(`unable to get descendants of run '1': database error`),
+			request: &request.GetRunTreeRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(&models.Run{ID: "1"}, nil)
+				runRepository.On(
+					"GetDescendantsByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(nil, errors.New("database error"))
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, _, err := tt.service().GetRunTree(context.TODO(), &models.Namespace{
+				ID: 1,
+			}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestService_LogBatch_Ok(t *testing.T) {
 	// init repository mocks.
 	runRepository := repositories.MockRunRepositoryProvider{}
@@ -953,6 +1151,7 @@ func TestService_LogBatch_Ok(t *testing.T) {
 		&paramRepository,
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.LogBatch(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1002,6 +1201,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1026,6 +1226,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1050,6 +1251,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1074,6 +1276,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1106,6 +1309,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1139,9 +1343,10 @@ func TestService_LogBatch_Error(t *testing.T) {
 					100,
 					[]models.Param{
 						{
-							Key:   "key",
-							Value: "value",
-							RunID: "1",
+							Key:       "key",
+							Value:     "value",
+							RunID:     "1",
+							ValueType: common.ParamTypeString,
 						},
 					},
 				).Return(errors.New("database error"))
@@ -1151,6 +1356,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1184,9 +1390,10 @@ func TestService_LogBatch_Error(t *testing.T) {
 					100,
 					[]models.Param{
 						{
-							Key:   "key",
-							Value: "value",
-							RunID: "1",
+							Key:       "key",
+							Value:     "value",
+							RunID:     "1",
+							ValueType: common.ParamTypeString,
 						},
 					},
 				).Return(repositories.ParamConflictError{Message: "param conflict!"})
@@ -1196,6 +1403,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1238,9 +1446,10 @@ func TestService_LogBatch_Error(t *testing.T) {
 					100,
 					[]models.Param{
 						{
-							Key:   "key",
-							Value: "value",
-							RunID: "1",
+							Key:       "key",
+							Value:     "value",
+							RunID:     "1",
+							ValueType: common.ParamTypeString,
 						},
 					},
 				).Return(nil)
@@ -1269,6 +1478,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1333,9 +1543,10 @@ func TestService_LogBatch_Error(t *testing.T) {
 					100,
 					[]models.Param{
 						{
-							Key:   "key",
-							Value: "value",
-							RunID: "1",
+							Key:       "key",
+							Value:     "value",
+							RunID:     "1",
+							ValueType: common.ParamTypeString,
 						},
 					},
 				).Return(nil)
@@ -1364,6 +1575,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1378,6 +1590,156 @@ func TestService_LogBatch_Error(t *testing.T) {
 	}
 }
 
+func TestService_LogInputs_Ok(t *testing.T) {
+	// init repository mocks.
+	run := &models.Run{ID: "1", ExperimentID: 1, LifecycleStage: models.LifecycleStageActive}
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage",
+		context.TODO(),
+		uint(1),
+		"1",
+		models.LifecycleStageActive,
+	).Return(run, nil)
+	datasetRepository := repositories.MockDatasetRepositoryProvider{}
+	datasetRepository.On(
+		"CreateRunInputs",
+		context.TODO(),
+		run,
+		mock.MatchedBy(func(inputs []models.Input) bool {
+			assert.Equal(t, "1", inputs[0].RunID)
+			assert.Equal(t, "dataset", inputs[0].Dataset.Name)
+			assert.Equal(t, "digest", inputs[0].Dataset.Digest)
+			assert.Equal(t, "local", inputs[0].Dataset.SourceType)
+			assert.Equal(t, "{}", inputs[0].Dataset.Source)
+			assert.Equal(t, []models.InputTag{{Key: "mlflow.data.context", Value: "training"}}, inputs[0].Tags)
+			return true
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&datasetRepository,
+	)
+	err := service.LogInputs(context.TODO(), &models.Namespace{
+		ID: 1,
+	}, &request.LogInputsRequest{
+		RunID: "1",
+		Datasets: []request.DatasetInputPartialRequest{
+			{
+				Tags: []request.TagPartialRequest{
+					{
+						Key:   "mlflow.data.context",
+						Value: "training",
+					},
+				},
+				Dataset: request.DatasetPartialRequest{
+					Name:       "dataset",
+					Digest:     "digest",
+					SourceType: "local",
+					Source:     "{}",
+				},
+			},
+		},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+}
+
+func TestService_LogInputs_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogInputsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			error:   api.NewInvalidParameterValueError(`Missing value for required parameter 'run_id'`),
+			request: &request.LogInputsRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "RunNotFoundDatabaseNotFoundError",
+			error: api.NewResourceDoesNotExistError(`Unable to find active run '1'`),
+			request: &request.LogInputsRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage",
+					context.TODO(),
+					uint(1),
+					"1",
+					models.LifecycleStageActive,
+				).Return(nil, nil)
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "CreateRunInputsDatabaseError",
+			error: api.NewInternalError(`unable to insert inputs for run '1': database error`),
+			request: &request.LogInputsRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				run := &models.Run{ID: "1", LifecycleStage: models.LifecycleStageActive}
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage",
+					context.TODO(),
+					uint(1),
+					"1",
+					models.LifecycleStageActive,
+				).Return(run, nil)
+				datasetRepository := repositories.MockDatasetRepositoryProvider{}
+				datasetRepository.On(
+					"CreateRunInputs", context.TODO(), run, []models.Input{},
+				).Return(errors.New("database error"))
+				return NewService(
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&datasetRepository,
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			err := tt.service().LogInputs(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestService_LogMetric_Ok(t *testing.T) {
 	// init repository mocks.
 	runRepository := repositories.MockRunRepositoryProvider{}
@@ -1413,6 +1775,7 @@ func TestService_LogMetric_Ok(t *testing.T) {
 		&repositories.MockParamRepositoryProvider{},
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.LogMetric(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1446,6 +1809,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1462,6 +1826,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1479,6 +1844,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1505,6 +1871,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1533,6 +1900,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1581,6 +1949,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1628,6 +1997,7 @@ func TestService_LogParam_Ok(t *testing.T) {
 		&paramRepository,
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockDatasetRepositoryProvider{},
 	)
 	err := service.LogParam(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1659,6 +2029,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1675,6 +2046,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1701,6 +2073,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1727,6 +2100,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1769,6 +2143,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -1811,6 +2186,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockDatasetRepositoryProvider{},
 				)
 			},
 		},
@@ -9,6 +9,15 @@ const (
 	MaxResultsPerPage = 1000000
 )
 
+// max lengths of dataset input fields, the same as MLflow has.
+const (
+	MaxDatasetNameLength       = 500
+	MaxDatasetDigestLength     = 36
+	MaxDatasetSourceTypeLength = 36
+	MaxInputTagKeyLength       = 255
+	MaxInputTagValueLength     = 500
+)
+
 // AllowedViewTypeList supported list of ViewType.
 var (
 	AllowedViewTypeList = map[request.ViewType]struct{}{
@@ -35,6 +44,14 @@ func ValidateGetRunRequest(req *request.GetRunRequest) error {
 	return nil
 }
 
+// ValidateGetRunTreeRequest validates `GET /mlflow/runs/get-tree` request.
+func ValidateGetRunTreeRequest(req *request.GetRunTreeRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	return nil
+}
+
 // ValidateDeleteRunRequest validates `POST /mlflow/runs/delete` request.
 func ValidateDeleteRunRequest(req *request.DeleteRunRequest) error {
 	if req.RunID == "" {
@@ -107,6 +124,52 @@ func ValidateLogBatchRequest(req *request.LogBatchRequest) error {
 	return nil
 }
 
+// ValidateLogInputsRequest validates `POST /mlflow/runs/log-inputs` request.
+func ValidateLogInputsRequest(req *request.LogInputsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+
+	for _, datasetInput := range req.Datasets {
+		for _, field := range []struct {
+			name      string
+			value     string
+			maxLength int
+		}{
+			{name: "dataset.name", value: datasetInput.Dataset.Name, maxLength: MaxDatasetNameLength},
+			{name: "dataset.digest", value: datasetInput.Dataset.Digest, maxLength: MaxDatasetDigestLength},
+			{name: "dataset.source_type", value: datasetInput.Dataset.SourceType, maxLength: MaxDatasetSourceTypeLength},
+			{name: "dataset.source", value: datasetInput.Dataset.Source},
+		} {
+			if field.value == "" {
+				return api.NewInvalidParameterValueError("Missing value for required parameter '%s'", field.name)
+			}
+			if field.maxLength > 0 && len(field.value) > field.maxLength {
+				return api.NewInvalidParameterValueError(
+					"Parameter '%s' exceeds the maximum length of %d characters", field.name, field.maxLength,
+				)
+			}
+		}
+
+		for _, tag := range datasetInput.Tags {
+			if tag.Key == "" {
+				return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+			}
+			if len(tag.Key) > MaxInputTagKeyLength {
+				return api.NewInvalidParameterValueError(
+					"Parameter 'tags.key' exceeds the maximum length of %d characters", MaxInputTagKeyLength,
+				)
+			}
+			if len(tag.Value) > MaxInputTagValueLength {
+				return api.NewInvalidParameterValueError(
+					"Parameter 'tags.value' exceeds the maximum length of %d characters", MaxInputTagValueLength,
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateSearchRunsRequest validates `POST /mlflow/runs/search` request.
 func ValidateSearchRunsRequest(req *request.SearchRunsRequest) error {
 	if _, ok := AllowedViewTypeList[req.ViewType]; !ok {
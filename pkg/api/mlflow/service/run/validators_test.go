@@ -1,6 +1,7 @@
 package run
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -299,6 +300,100 @@ func TestValidateLogBatchRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateLogInputsRequest_Ok(t *testing.T) {
+	err := ValidateLogInputsRequest(&request.LogInputsRequest{
+		RunID: "id",
+		Datasets: []request.DatasetInputPartialRequest{
+			{
+				Tags: []request.TagPartialRequest{{Key: "mlflow.data.context", Value: "training"}},
+				Dataset: request.DatasetPartialRequest{
+					Name:       "name",
+					Digest:     "digest",
+					SourceType: "local",
+					Source:     "{}",
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogInputsRequest_Error(t *testing.T) {
+	dataset := request.DatasetPartialRequest{
+		Name:       "name",
+		Digest:     "digest",
+		SourceType: "local",
+		Source:     "{}",
+	}
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogInputsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogInputsRequest{},
+		},
+		{
+			name:  "EmptyDatasetName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.name'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{
+					{Dataset: request.DatasetPartialRequest{Digest: "digest", SourceType: "local", Source: "{}"}},
+				},
+			},
+		},
+		{
+			name:  "EmptyDatasetSource",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.source'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{
+					{Dataset: request.DatasetPartialRequest{Name: "name", Digest: "digest", SourceType: "local"}},
+				},
+			},
+		},
+		{
+			name: "TooLongDatasetDigest",
+			error: api.NewInvalidParameterValueError(
+				"Parameter 'dataset.digest' exceeds the maximum length of 36 characters",
+			),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{
+					{
+						Dataset: request.DatasetPartialRequest{
+							Name:       "name",
+							Digest:     strings.Repeat("a", 37),
+							SourceType: "local",
+							Source:     "{}",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{
+					{Tags: []request.TagPartialRequest{{Value: "training"}}, Dataset: dataset},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogInputsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateSearchRunsRequest_Ok(t *testing.T) {
 	err := ValidateSearchRunsRequest(&request.SearchRunsRequest{
 		ViewType:   request.ViewTypeAll,
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	mlflowConfig "github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/pkg/gc"
+)
+
+var GCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Permanently removes deleted runs and experiments",
+	Long: `The gc command will permanently remove runs and experiments, which
+         have been deleted longer than the retention period ago, together
+         with their artifacts and metric contexts, which are not used anymore.`,
+	RunE: gcCmd,
+}
+
+func gcCmd(cmd *cobra.Command, args []string) error {
+	config := mlflowConfig.NewServiceConfig()
+	// artifacts of the runs could have been served by the `mlflow-artifacts` proxy.
+	config.ServeArtifacts = true
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	db, err := database.NewDBProvider(
+		config.DatabaseURI,
+		config.DatabaseSlowThreshold,
+		config.DatabasePoolMax,
+	)
+	if err != nil {
+		return fmt.Errorf("error connecting to DB: %w", err)
+	}
+	//nolint:errcheck
+	defer db.Close()
+
+	if err := database.CheckAndMigrateDB(false, db.GormDB()); err != nil {
+		return fmt.Errorf("error checking database schema: %w", err)
+	}
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(config)
+	if err != nil {
+		return fmt.Errorf("error creating artifact storage factory: %w", err)
+	}
+
+	dryRun := viper.GetBool("dry-run")
+	result, err := gc.NewCollector(
+		config,
+		repositories.NewRunRepository(db.GormDB()),
+		repositories.NewMetricRepository(db.GormDB()),
+		repositories.NewNamespaceRepository(db.GormDB()),
+		repositories.NewExperimentRepository(db.GormDB()),
+		artifactStorageFactory,
+	).Collect(context.Background(), gc.Options{
+		Retention:      viper.GetDuration("retention"),
+		NamespaceCodes: viper.GetStringSlice("namespace"),
+		DryRun:         dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	action := "removed"
+	if dryRun {
+		action = "would be removed"
+	}
+	for _, experiment := range result.Experiments {
+		fmt.Printf(
+			"experiment %d (%s) with %d runs %s\n", *experiment.ID, experiment.Name, len(experiment.Runs), action,
+		)
+	}
+	for _, run := range result.Runs {
+		fmt.Printf("run %s (%s) %s\n", run.ID, run.Name, action)
+	}
+	fmt.Printf("%d experiments and %d runs %s\n", len(result.Experiments), len(result.Runs), action)
+	if !dryRun {
+		fmt.Printf("%d orphaned contexts removed\n", result.Contexts)
+	}
+	return nil
+}
+
+// nolint:errcheck,gosec
+func init() {
+	RootCmd.AddCommand(GCCmd)
+
+	GCCmd.Flags().Duration(
+		"retention", 30*24*time.Hour, "Remove runs and experiments, which have been deleted longer than this ago",
+	)
+	GCCmd.Flags().StringSlice("namespace", nil, "Only remove runs and experiments of these namespaces (codes)")
+	GCCmd.Flags().Bool("dry-run", false, "Only print runs and experiments, which would be removed")
+	GCCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
+	GCCmd.Flags().Int("database-pool-max", 20, "Maximum number of database connections in the pool")
+	GCCmd.Flags().Duration("database-slow-threshold", 1*time.Second, "Slow SQL warning threshold")
+	GCCmd.Flags().String(
+		"artifacts-destination", "./mlartifacts", "Storage location of the artifacts served by the proxy API",
+	)
+	GCCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
+	GCCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
+	GCCmd.Flags().MarkHidden("gs-endpoint-uri")
+	GCCmd.Flags().String("azure-endpoint-uri", "", "Azure Blob Storage account endpoint url")
+	GCCmd.Flags().String("azure-storage-connection-string", "", "Azure Blob Storage connection string")
+	GCCmd.Flags().String("azure-storage-sas-token", "", "Azure Blob Storage shared access signature token")
+}
@@ -26,6 +26,11 @@ var ServerCmd = &cobra.Command{
 func serverCmd(cmd *cobra.Command, args []string) error {
 	// process config parameters.
 	mlflowConfig := mlflowConfig.NewServiceConfig()
+	// the same as MLflow does, artifacts of the new experiments are proxied by default,
+	// when artifacts serving is enabled.
+	if mlflowConfig.ServeArtifacts && !cmd.Flags().Changed("default-artifact-root") {
+		mlflowConfig.DefaultArtifactRoot = "mlflow-artifacts:/"
+	}
 	if err := mlflowConfig.Validate(); err != nil {
 		return err
 	}
@@ -67,9 +72,32 @@ func init() {
 
 	ServerCmd.Flags().StringP("listen-address", "a", "localhost:5000", "Address (host:post) to listen to")
 	ServerCmd.Flags().String("default-artifact-root", "./artifacts", "Default artifact root")
+	ServerCmd.Flags().Bool("serve-artifacts", false, "Serve artifacts through the mlflow-artifacts proxy API")
+	ServerCmd.Flags().String(
+		"artifacts-destination", "./mlartifacts", "Storage location of the artifacts served by the proxy API",
+	)
+	ServerCmd.Flags().Bool(
+		"artifacts-presigned-redirect", false, "Redirect artifact downloads to presigned urls of the remote storages",
+	)
+	ServerCmd.Flags().Duration(
+		"artifacts-presigned-url-expiry", 15*time.Minute, "Lifetime of the presigned urls of artifact downloads",
+	)
+	ServerCmd.Flags().Int64(
+		"artifacts-archive-max-size", 1<<30,
+		"Maximum total size in bytes of the artifact directories downloaded as archives (0 disables it)",
+	)
+	ServerCmd.Flags().String(
+		"artifacts-cache-dir", "", "Local directory to cache artifacts of the remote storages in (empty disables it)",
+	)
+	ServerCmd.Flags().Int64(
+		"artifacts-cache-max-size", 1<<30, "Maximum total size in bytes of the cached artifacts",
+	)
 	ServerCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
 	ServerCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
 	ServerCmd.Flags().MarkHidden("gs-endpoint-uri")
+	ServerCmd.Flags().String("azure-endpoint-uri", "", "Azure Blob Storage account endpoint url")
+	ServerCmd.Flags().String("azure-storage-connection-string", "", "Azure Blob Storage connection string")
+	ServerCmd.Flags().String("azure-storage-sas-token", "", "Azure Blob Storage shared access signature token")
 	ServerCmd.Flags().String("auth-username", "", "BasicAuth username")
 	ServerCmd.Flags().String("auth-password", "", "BasicAuth password")
 	ServerCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
@@ -78,6 +106,12 @@ func init() {
 	ServerCmd.Flags().Bool("database-migrate", true, "Run database migrations")
 	ServerCmd.Flags().Bool("database-reset", false, "Reinitialize database - WARNING all data will be lost!")
 	ServerCmd.Flags().MarkHidden("database-reset")
+	ServerCmd.Flags().Duration(
+		"gc-interval", 0, "Interval of removing deleted runs and experiments permanently (0 disables it)",
+	)
+	ServerCmd.Flags().Duration(
+		"gc-retention", 30*24*time.Hour, "Remove runs and experiments, which have been deleted longer than this ago",
+	)
 	ServerCmd.Flags().Bool("dev-mode", false, "Development mode - enable CORS")
 	ServerCmd.Flags().MarkHidden("dev-mode")
 	viper.BindEnv("auth-username", "MLFLOW_TRACKING_USERNAME")
@@ -0,0 +1,49 @@
+package bodylimit
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Config represents configuration of the Middleware.
+type Config struct {
+	// Limit is the maximal size of the request body in bytes.
+	Limit int
+	// Next defines the requests, which handlers stream the body of any size themselves.
+	Next func(c *fiber.Ctx) bool
+}
+
+// New creates new Middleware instance, which reads the streamed request body into memory
+// and rejects the requests with the body larger than the limit.
+func New(config Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !c.Request().IsBodyStream() {
+			return c.Next()
+		}
+
+		if config.Next != nil && config.Next(c) {
+			err := c.Next()
+			// the rest of the body, which hasn't been read by the handler, can't be skipped,
+			// so the connection can't be kept alive.
+			if stream := c.Request().BodyStream(); stream != nil {
+				if n, _ := stream.Read(make([]byte, 1)); n > 0 {
+					c.Context().SetConnectionClose()
+				}
+			}
+			return err
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request().BodyStream(), int64(config.Limit)+1))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		if len(body) > config.Limit {
+			c.Context().SetConnectionClose()
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+		c.Request().SetBody(body)
+
+		return c.Next()
+	}
+}
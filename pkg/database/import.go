@@ -26,6 +26,7 @@ type Importer struct {
 	destDB          *gorm.DB
 	sourceDB        *gorm.DB
 	experimentInfos []experimentInfo
+	contextIDs      map[uint]uint
 }
 
 // NewImporter initializes an Importer.
@@ -34,6 +35,7 @@ func NewImporter(input, output *gorm.DB) *Importer {
 		destDB:          output,
 		sourceDB:        input,
 		experimentInfos: []experimentInfo{},
+		contextIDs:      map[uint]uint{},
 	}
 }
 
@@ -43,13 +45,33 @@ func (s *Importer) Import() error {
 		"namespaces",
 		"apps",
 		"dashboards",
+		"shared_tags",
+		"pinned_sequences",
 		"experiments",
 		"experiment_tags",
 		"runs",
+		"run_shared_tags",
 		"tags",
 		"params",
+		"contexts",
 		"metrics",
 		"latest_metrics",
+		"blobs",
+		"distributions",
+		"texts",
+		"figures",
+		"logs",
+		"log_records",
+		"registered_models",
+		"registered_model_tags",
+		"registered_model_aliases",
+		"model_versions",
+		"model_version_tags",
+		"datasets",
+		"inputs",
+		"input_tags",
+		"notes",
+		"note_revisions",
 	}
 	for _, table := range tables {
 		if err := s.importTable(table); err != nil {
@@ -113,6 +135,47 @@ func (s *Importer) importExperiments() error {
 	return nil
 }
 
+// importContexts copies the contents of the contexts table from sourceDB to destDB.
+// Contexts are unique by their JSON, so the existing ones are reused and the new ID is recorded.
+func (s *Importer) importContexts() error {
+	// Start transaction in the destDB
+	err := s.destDB.Transaction(func(destTX *gorm.DB) error {
+		// Query data from the source database
+		rows, err := s.sourceDB.Model(Context{}).Rows()
+		if err != nil {
+			return eris.Wrap(err, "error creating Rows instance from source")
+		}
+		if err := rows.Err(); err != nil {
+			return eris.Wrap(err, "error getting query result")
+		}
+		//nolint:errcheck
+		defer rows.Close()
+
+		count := 0
+		for rows.Next() {
+			var scannedItem Context
+			if err := s.sourceDB.ScanRows(rows, &scannedItem); err != nil {
+				return eris.Wrap(err, "error creating Rows instance from source")
+			}
+			newItem := Context{Json: scannedItem.Json}
+			if err := destTX.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "json"}},
+				UpdateAll: true,
+			}).Create(&newItem).Error; err != nil {
+				return eris.Wrap(err, "error creating destination row")
+			}
+			s.contextIDs[scannedItem.ID] = newItem.ID
+			count++
+		}
+		log.Infof("Importing contexts - found %d records", count)
+		return nil
+	})
+	if err != nil {
+		return eris.Wrap(err, "error copying contexts table")
+	}
+	return nil
+}
+
 // importTable copies the contents of one table (model) from sourceDB
 // while updating the experiment_id to destDB.
 func (s *Importer) importTable(table string) error {
@@ -122,6 +185,11 @@ func (s *Importer) importTable(table string) error {
 		if err := s.importExperiments(); err != nil {
 			return eris.Wrap(err, "error importing table experiments")
 		}
+	// handle special case for contexts.
+	case "contexts":
+		if err := s.importContexts(); err != nil {
+			return eris.Wrap(err, "error importing table contexts")
+		}
 	default:
 		// Start transaction in the destDB
 		err := s.destDB.Transaction(func(destTX *gorm.DB) error {
@@ -204,8 +272,27 @@ func (s *Importer) translateFields(item map[string]any) (map[string]any, error)
 			}
 		}
 	}
+	// items with context_id need to reference the new ID
+	if contextID, ok := item["context_id"]; ok && contextID != nil {
+		var id uint
+		switch v := contextID.(type) {
+		case int32:
+			id = uint(v)
+		case int64:
+			id = uint(v)
+		default:
+			return nil, eris.Errorf("unable to assert %s as uint: %v", "context_id", contextID)
+		}
+		destID, ok := s.contextIDs[id]
+		if !ok {
+			return nil, eris.Errorf("unable to find imported context: %d", id)
+		}
+		item["context_id"] = destID
+	}
 	// items with string uuid need to translate to UUID native type
-	uuidFields := []string{"id", "app_id"}
+	uuidFields := []string{
+		"id", "app_id", "registered_model_id", "model_version_id", "dataset_id", "input_id", "shared_tag_id", "note_id",
+	}
 	for _, field := range uuidFields {
 		if srcUUID, ok := item[field]; ok {
 			// when uuid, this field will be pointer to interface{} and requires some reflection
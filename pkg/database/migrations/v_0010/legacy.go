@@ -0,0 +1,213 @@
+package v_0010
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// legacyTablePrefix is the prefix, which MLflow model registry tables are renamed with
+// while their rows are copied into the new tables.
+const legacyTablePrefix = "legacy_"
+
+// legacyBatchSize is the number of legacy rows copied at once.
+const legacyBatchSize = 1000
+
+// legacyTables are MLflow model registry tables, children first.
+var legacyTables = []string{
+	"model_version_tags",
+	"model_versions",
+	"registered_model_aliases",
+	"registered_model_tags",
+	"registered_models",
+}
+
+type LegacyRegisteredModel struct {
+	Name            string
+	Description     sql.NullString
+	CreationTime    sql.NullInt64
+	LastUpdatedTime sql.NullInt64
+}
+
+func (LegacyRegisteredModel) TableName() string {
+	return legacyTablePrefix + "registered_models"
+}
+
+type LegacyRegisteredModelTag struct {
+	Key   string
+	Value sql.NullString
+	Name  string
+}
+
+func (LegacyRegisteredModelTag) TableName() string {
+	return legacyTablePrefix + "registered_model_tags"
+}
+
+type LegacyRegisteredModelAlias struct {
+	Alias   string
+	Version int32
+	Name    string
+}
+
+func (LegacyRegisteredModelAlias) TableName() string {
+	return legacyTablePrefix + "registered_model_aliases"
+}
+
+type LegacyModelVersion struct {
+	Name            string
+	Version         int32
+	Description     sql.NullString
+	UserID          sql.NullString
+	CurrentStage    sql.NullString
+	Source          sql.NullString
+	RunID           sql.NullString
+	RunLink         sql.NullString
+	Status          sql.NullString
+	StatusMessage   sql.NullString
+	CreationTime    sql.NullInt64
+	LastUpdatedTime sql.NullInt64
+}
+
+func (LegacyModelVersion) TableName() string {
+	return legacyTablePrefix + "model_versions"
+}
+
+type LegacyModelVersionTag struct {
+	Key     string
+	Value   sql.NullString
+	Name    string
+	Version int32
+}
+
+func (LegacyModelVersionTag) TableName() string {
+	return legacyTablePrefix + "model_version_tags"
+}
+
+// getRegisteredModelID derives id of the registered model from its legacy name,
+// so the related rows are linked to it without any lookups.
+func getRegisteredModelID(name string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("registered_models/%s", name)))
+}
+
+// getModelVersionID derives id of the model version from its legacy name and version.
+func getModelVersionID(name string, version int32) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("model_versions/%s/%d", name, version)))
+}
+
+// renameLegacyTables renames existing MLflow model registry tables, so the new tables could be created.
+func renameLegacyTables(tx *gorm.DB) error {
+	for _, table := range legacyTables {
+		if tx.Migrator().HasTable(table) {
+			if err := tx.Migrator().RenameTable(table, legacyTablePrefix+table); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// migrateLegacyTables copies rows of MLflow model registry tables into the new tables
+// of the default namespace and drops the legacy ones.
+func migrateLegacyTables(tx *gorm.DB) error {
+	var namespace Namespace
+	if err := tx.Where(Namespace{Code: "default"}).First(&namespace).Error; err != nil {
+		return err
+	}
+
+	if err := copyLegacyRows(tx, "name", func(row LegacyRegisteredModel) RegisteredModel {
+		return RegisteredModel{
+			ID:              getRegisteredModelID(row.Name),
+			Name:            row.Name,
+			Description:     row.Description.String,
+			CreationTime:    row.CreationTime,
+			LastUpdatedTime: row.LastUpdatedTime,
+			NamespaceID:     namespace.ID,
+		}
+	}); err != nil {
+		return err
+	}
+	if err := copyLegacyRows(tx, "name, key", func(row LegacyRegisteredModelTag) RegisteredModelTag {
+		return RegisteredModelTag{
+			Key:               row.Key,
+			Value:             row.Value.String,
+			RegisteredModelID: getRegisteredModelID(row.Name),
+		}
+	}); err != nil {
+		return err
+	}
+	if err := copyLegacyRows(tx, "name, alias", func(row LegacyRegisteredModelAlias) RegisteredModelAlias {
+		return RegisteredModelAlias{
+			Alias:             row.Alias,
+			Version:           row.Version,
+			RegisteredModelID: getRegisteredModelID(row.Name),
+		}
+	}); err != nil {
+		return err
+	}
+	if err := copyLegacyRows(tx, "name, version", func(row LegacyModelVersion) ModelVersion {
+		return ModelVersion{
+			ID:                getModelVersionID(row.Name, row.Version),
+			RegisteredModelID: getRegisteredModelID(row.Name),
+			Version:           row.Version,
+			Description:       row.Description.String,
+			UserID:            row.UserID.String,
+			CurrentStage:      ModelVersionStage(row.CurrentStage.String),
+			Source:            row.Source.String,
+			RunID:             row.RunID.String,
+			RunLink:           row.RunLink.String,
+			Status:            row.Status.String,
+			StatusMessage:     row.StatusMessage.String,
+			CreationTime:      row.CreationTime,
+			LastUpdatedTime:   row.LastUpdatedTime,
+		}
+	}); err != nil {
+		return err
+	}
+	if err := copyLegacyRows(tx, "name, version, key", func(row LegacyModelVersionTag) ModelVersionTag {
+		return ModelVersionTag{
+			Key:            row.Key,
+			Value:          row.Value.String,
+			ModelVersionID: getModelVersionID(row.Name, row.Version),
+		}
+	}); err != nil {
+		return err
+	}
+
+	for _, table := range legacyTables {
+		if err := tx.Migrator().DropTable(legacyTablePrefix + table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyLegacyRows copies rows of the legacy table in batches ordered by its key.
+// Missing legacy tables are skipped, as older MLflow schemas don't have all of them.
+func copyLegacyRows[L any, N any](tx *gorm.DB, order string, convert func(L) N) error {
+	var legacy L
+	if !tx.Migrator().HasTable(&legacy) {
+		return nil
+	}
+	for offset := 0; ; offset += legacyBatchSize {
+		var rows []L
+		if err := tx.Order(order).Limit(legacyBatchSize).Offset(offset).Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		newRows := make([]N, len(rows))
+		for i, row := range rows {
+			newRows[i] = convert(row)
+		}
+		if err := tx.Omit(clause.Associations).Create(&newRows).Error; err != nil {
+			return err
+		}
+		if len(rows) < legacyBatchSize {
+			return nil
+		}
+	}
+}
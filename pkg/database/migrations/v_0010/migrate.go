@@ -0,0 +1,38 @@
+package v_0010
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "946017491ae9"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// databases coming from MLflow contain model registry tables keyed by the model name,
+		// they are renamed, so the new tables could be created, and their rows are copied into them.
+		legacy := tx.Migrator().HasTable(&RegisteredModel{}) && !tx.Migrator().HasColumn(&RegisteredModel{}, "ID")
+		if legacy {
+			if err := renameLegacyTables(tx); err != nil {
+				return err
+			}
+		}
+		if err := tx.Migrator().AutoMigrate(
+			&RegisteredModel{},
+			&RegisteredModelTag{},
+			&RegisteredModelAlias{},
+			&ModelVersion{},
+			&ModelVersionTag{},
+		); err != nil {
+			return err
+		}
+		if legacy {
+			if err := migrateLegacyTables(tx); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,157 @@
+package v_0011
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// legacyTablePrefix is the prefix, which MLflow dataset tables are renamed with
+// while their rows are copied into the new tables.
+const legacyTablePrefix = "legacy_"
+
+// legacyBatchSize is the number of legacy rows copied at once.
+const legacyBatchSize = 1000
+
+// legacyTables are MLflow dataset tables, children first.
+var legacyTables = []string{"input_tags", "inputs", "datasets"}
+
+type LegacyDataset struct {
+	DatasetUUID       string
+	ExperimentID      int32
+	Name              string
+	Digest            string
+	DatasetSourceType string
+	DatasetSource     string
+	DatasetSchema     sql.NullString
+	DatasetProfile    sql.NullString
+}
+
+func (LegacyDataset) TableName() string {
+	return legacyTablePrefix + "datasets"
+}
+
+type LegacyInput struct {
+	InputUUID       string
+	SourceType      string
+	SourceID        string
+	DestinationType string
+	DestinationID   string
+}
+
+func (LegacyInput) TableName() string {
+	return legacyTablePrefix + "inputs"
+}
+
+type LegacyInputTag struct {
+	InputUUID string
+	Name      string
+	Value     string
+}
+
+func (LegacyInputTag) TableName() string {
+	return legacyTablePrefix + "input_tags"
+}
+
+// getLegacyID returns id of the row migrated from MLflow. MLflow uuids are kept as they are,
+// anything else is turned into the uuid derived from it, so the related rows are linked without any lookups.
+func getLegacyID(legacyUUID string) uuid.UUID {
+	if id, err := uuid.Parse(legacyUUID); err == nil {
+		return id
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(legacyUUID))
+}
+
+// renameLegacyTables renames MLflow dataset tables, so the new tables could be created.
+func renameLegacyTables(tx *gorm.DB) error {
+	for _, table := range legacyTables {
+		if err := tx.Migrator().RenameTable(table, legacyTablePrefix+table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateLegacyTables copies rows of MLflow dataset tables into the new tables and drops the legacy ones.
+// Only the inputs of the existing datasets into the existing runs are supported by the new schema.
+func migrateLegacyTables(tx *gorm.DB) error {
+	if err := copyLegacyRows(tx, "dataset_uuid", func(row LegacyDataset) Dataset {
+		return Dataset{
+			ID:           getLegacyID(row.DatasetUUID),
+			ExperimentID: row.ExperimentID,
+			Name:         row.Name,
+			Digest:       row.Digest,
+			SourceType:   row.DatasetSourceType,
+			Source:       row.DatasetSource,
+			Schema:       row.DatasetSchema.String,
+			Profile:      row.DatasetProfile.String,
+		}
+	}); err != nil {
+		return err
+	}
+
+	runInputs := func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&LegacyInput{}).Where(
+			"source_type = 'DATASET' AND source_id IN (?) AND destination_type = 'RUN' AND destination_id IN (?)",
+			tx.Session(&gorm.Session{NewDB: true}).Model(&LegacyDataset{}).Select("dataset_uuid"),
+			tx.Session(&gorm.Session{NewDB: true}).Model(&Run{}).Select("run_uuid"),
+		)
+	}
+	if err := copyLegacyRows(tx, "input_uuid", func(row LegacyInput) Input {
+		return Input{
+			ID:        getLegacyID(row.InputUUID),
+			DatasetID: getLegacyID(row.SourceID),
+			RunID:     row.DestinationID,
+		}
+	}, runInputs); err != nil {
+		return err
+	}
+	if err := copyLegacyRows(tx, "input_uuid, name", func(row LegacyInputTag) InputTag {
+		return InputTag{
+			Key:     row.Name,
+			Value:   row.Value,
+			InputID: getLegacyID(row.InputUUID),
+		}
+	}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(
+			"input_uuid IN (?)",
+			runInputs(tx.Session(&gorm.Session{NewDB: true})).Select("input_uuid"),
+		)
+	}); err != nil {
+		return err
+	}
+
+	for _, table := range legacyTables {
+		if err := tx.Migrator().DropTable(legacyTablePrefix + table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyLegacyRows copies rows of the legacy table in batches ordered by its key.
+func copyLegacyRows[L any, N any](
+	tx *gorm.DB, order string, convert func(L) N, scopes ...func(*gorm.DB) *gorm.DB,
+) error {
+	for offset := 0; ; offset += legacyBatchSize {
+		var rows []L
+		if err := tx.Scopes(scopes...).Order(order).Limit(legacyBatchSize).Offset(offset).Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		newRows := make([]N, len(rows))
+		for i, row := range rows {
+			newRows[i] = convert(row)
+		}
+		if err := tx.Omit(clause.Associations).Create(&newRows).Error; err != nil {
+			return err
+		}
+		if len(rows) < legacyBatchSize {
+			return nil
+		}
+	}
+}
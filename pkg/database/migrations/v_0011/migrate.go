@@ -0,0 +1,46 @@
+package v_0011
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "3a5f2c8e9b1d"
+
+func Migrate(db *gorm.DB) error {
+	// We need to run this migration without foreign key constraints to avoid
+	// the cascading delete to kick in and delete all the runs and datasets.
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// databases coming from MLflow contain dataset tables keyed by MLflow uuids, they are renamed,
+			// so the new tables could be created, and their rows are copied into them.
+			legacy := tx.Migrator().HasColumn(&Dataset{}, "dataset_uuid")
+			if legacy {
+				if err := renameLegacyTables(tx); err != nil {
+					return err
+				}
+			}
+			// experiments and runs are not changed, but they have to be provided,
+			// so foreign keys of the new tables are created as well.
+			if err := tx.Migrator().AutoMigrate(
+				&Experiment{},
+				&Run{},
+				&Dataset{},
+				&Input{},
+				&InputTag{},
+			); err != nil {
+				return err
+			}
+			if legacy {
+				if err := migrateLegacyTables(tx); err != nil {
+					return err
+				}
+			}
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package v_0012
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "8d4e6f1c2a7b"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// runs are not changed, but they have to be provided,
+		// so the `run_shared_tags` join table is created as well.
+		if err := tx.Migrator().AutoMigrate(
+			&SharedTag{},
+			&Run{},
+		); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,19 @@
+package v_0013
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "c17a9e05d3f2"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().AutoMigrate(&PinnedSequences{}); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,26 @@
+package v_0014
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "6b0f3d7a8e24"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// experiments and runs are not changed, but they have to be provided,
+		// so foreign keys of the new tables are created as well.
+		if err := tx.Migrator().AutoMigrate(
+			&Experiment{},
+			&Run{},
+			&Note{},
+			&NoteRevision{},
+		); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,24 @@
+package v_0015
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "e2b94c07d15a"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// runs are not changed, but they have to be provided,
+		// so foreign keys of the new table are created as well.
+		if err := tx.Migrator().AutoMigrate(
+			&Run{},
+			&Blob{},
+		); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
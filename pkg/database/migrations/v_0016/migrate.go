@@ -0,0 +1,24 @@
+package v_0016
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "9c41d7e5a2b8"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// runs are not changed, but they have to be provided,
+		// so foreign keys of the new table are created as well.
+		if err := tx.Migrator().AutoMigrate(
+			&Run{},
+			&Distribution{},
+		); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,25 @@
+package v_0018
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "b3e1975c0af4"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// runs are not changed, but they have to be provided,
+		// so foreign keys of the new tables are created as well.
+		if err := tx.Migrator().AutoMigrate(
+			&Run{},
+			&Log{},
+			&LogRecord{},
+		); err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
@@ -0,0 +1,59 @@
+package v_0019
+
+import (
+	"gorm.io/gorm"
+)
+
+const Version = "d6a0c38f25e7"
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, field := range []string{
+			"MinValue", "MaxValue", "FirstValue", "FirstIsNan", "FirstStep", "FirstTimestamp", "Count",
+		} {
+			if err := tx.Migrator().AddColumn(&LatestMetric{}, field); err != nil {
+				return err
+			}
+		}
+		if err := tx.Exec(
+			"UPDATE latest_metrics" +
+				"  SET min_value = metrics.min_value, max_value = metrics.max_value, \"count\" = metrics.count" +
+				"  FROM (" +
+				"    SELECT run_uuid, key," +
+				"      MIN(CASE WHEN is_nan THEN NULL ELSE value END) AS min_value," +
+				"      MAX(CASE WHEN is_nan THEN NULL ELSE value END) AS max_value," +
+				"      COUNT(*) AS count" +
+				"    FROM metrics" +
+				"    GROUP BY run_uuid, key" +
+				"  ) AS metrics" +
+				"  WHERE" +
+				"    (latest_metrics.run_uuid, latest_metrics.key) =" +
+				"    (metrics.run_uuid, metrics.key)").
+			Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(
+			"UPDATE latest_metrics" +
+				"  SET first_value = metrics.value, first_is_nan = metrics.is_nan," +
+				"    first_step = metrics.step, first_timestamp = metrics.timestamp" +
+				"  FROM (" +
+				"    SELECT run_uuid, key, value, is_nan, step, timestamp" +
+				"    FROM (" +
+				"      SELECT run_uuid, key, value, is_nan, step, timestamp," +
+				"        ROW_NUMBER() OVER (PARTITION BY run_uuid, key ORDER BY step, timestamp, iter) AS first_rank" +
+				"      FROM metrics" +
+				"    ) AS ranked" +
+				"    WHERE first_rank = 1" +
+				"  ) AS metrics" +
+				"  WHERE" +
+				"    (latest_metrics.run_uuid, latest_metrics.key) =" +
+				"    (metrics.run_uuid, metrics.key)").
+			Error; err != nil {
+			return err
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
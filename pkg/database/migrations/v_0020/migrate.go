@@ -0,0 +1,61 @@
+package v_0020
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const Version = "8e4b1f0a7c3d"
+
+// batchSize is the number of params, which types are inferred at once.
+const batchSize = 1000
+
+func Migrate(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, field := range []string{"ValueType", "ValueNumeric"} {
+			if err := tx.Migrator().AddColumn(&Param{}, field); err != nil {
+				return err
+			}
+		}
+		// the types of the already logged params are inferred the same way as for the new ones.
+		// params are read in batches ordered by their key, and every batch is updated by a single upsert.
+		var last Param
+		for {
+			var params []Param
+			query := tx.Model(&Param{}).Select("run_uuid", "key", "value")
+			if last.RunID != "" {
+				query = query.Where("run_uuid > ? OR (run_uuid = ? AND key > ?)", last.RunID, last.RunID, last.Key)
+			}
+			if err := query.Order("run_uuid, key").Limit(batchSize).Find(&params).Error; err != nil {
+				return err
+			}
+			if len(params) == 0 {
+				break
+			}
+			last = params[len(params)-1]
+
+			updates := make([]Param, 0, len(params))
+			for _, param := range params {
+				param.ValueType, param.ValueNumeric = InferParamType(param.Value)
+				if param.ValueType != ParamTypeString {
+					updates = append(updates, param)
+				}
+			}
+			if len(updates) > 0 {
+				if err := tx.Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "key"}, {Name: "run_uuid"}},
+					DoUpdates: clause.AssignmentColumns([]string{"value_type", "value_numeric"}),
+				}).Create(&updates).Error; err != nil {
+					return err
+				}
+			}
+			if len(params) < batchSize {
+				break
+			}
+		}
+		return tx.Model(&SchemaVersion{}).
+			Where("1 = 1").
+			Update("Version", Version).
+			Error
+	})
+}
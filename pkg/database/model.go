@@ -54,6 +54,8 @@ type Experiment struct {
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
 	Runs             []Run           `gorm:"constraint:OnDelete:CASCADE"`
+	Datasets         []Dataset       `gorm:"constraint:OnDelete:CASCADE"`
+	Notes            []Note          `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 type ExperimentTag struct {
@@ -84,6 +86,15 @@ type Run struct {
 	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
 	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
 	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Inputs         []Input        `gorm:"constraint:OnDelete:CASCADE"`
+	SharedTags     []SharedTag    `gorm:"many2many:run_shared_tags;constraint:OnDelete:CASCADE"`
+	Notes          []Note         `gorm:"constraint:OnDelete:CASCADE"`
+	Blobs          []Blob         `gorm:"constraint:OnDelete:CASCADE"`
+	Distributions  []Distribution `gorm:"constraint:OnDelete:CASCADE"`
+	Texts          []Text         `gorm:"constraint:OnDelete:CASCADE"`
+	Figures        []Figure       `gorm:"constraint:OnDelete:CASCADE"`
+	Logs           []Log          `gorm:"constraint:OnDelete:CASCADE"`
+	LogRecords     []LogRecord    `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 type RowNum int64
@@ -114,9 +125,11 @@ func (rn RowNum) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 }
 
 type Param struct {
-	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
-	Value string `gorm:"type:varchar(500);not null"`
-	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	Key          string   `gorm:"type:varchar(250);not null;primaryKey"`
+	Value        string   `gorm:"type:varchar(500);not null"`
+	RunID        string   `gorm:"column:run_uuid;not null;primaryKey;index"`
+	ValueType    string   `gorm:"type:varchar(8);not null;default:'str'"`
+	ValueNumeric *float64 `gorm:"type:double precision"`
 }
 
 type Tag struct {
@@ -138,15 +151,22 @@ type Metric struct {
 }
 
 type LatestMetric struct {
-	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
-	Value     float64 `gorm:"type:double precision;not null"`
-	Timestamp int64
-	Step      int64  `gorm:"not null"`
-	IsNan     bool   `gorm:"not null"`
-	RunID     string `gorm:"column:run_uuid;not null;primaryKey;index"`
-	LastIter  int64
-	ContextID *uint
-	Context   *Context
+	Key            string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          float64 `gorm:"type:double precision;not null"`
+	Timestamp      int64
+	Step           int64  `gorm:"not null"`
+	IsNan          bool   `gorm:"not null"`
+	RunID          string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	LastIter       int64
+	MinValue       *float64 `gorm:"type:double precision"`
+	MaxValue       *float64 `gorm:"type:double precision"`
+	FirstValue     float64  `gorm:"type:double precision;not null;default:0"`
+	FirstIsNan     bool     `gorm:"not null;default:false"`
+	FirstStep      int64    `gorm:"not null;default:0"`
+	FirstTimestamp int64    `gorm:"not null;default:0"`
+	Count          int64    `gorm:"not null;default:0"`
+	ContextID      *uint
+	Context        *Context
 }
 
 type Context struct {
@@ -154,6 +174,89 @@ type Context struct {
 	Json datatypes.JSON `gorm:"not null;unique;index"`
 }
 
+type ModelVersionStage string
+
+const (
+	ModelVersionStageNone            ModelVersionStage = "None"
+	ModelVersionStageStaging         ModelVersionStage = "Staging"
+	ModelVersionStageProduction      ModelVersionStage = "Production"
+	ModelVersionStageArchived        ModelVersionStage = "Archived"
+	ModelVersionStageDeletedInternal ModelVersionStage = "Deleted_Internal"
+)
+
+type RegisteredModel struct {
+	ID              uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	Name            string                 `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string                 `gorm:"type:varchar(5000)"`
+	CreationTime    sql.NullInt64          `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64          `gorm:"type:bigint"`
+	NamespaceID     uint                   `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace              `gorm:"constraint:OnDelete:CASCADE"`
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type RegisteredModelTag struct {
+	Key               string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string    `gorm:"type:varchar(5000)"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+type RegisteredModelAlias struct {
+	Alias             string    `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int32     `gorm:"not null"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+type ModelVersion struct {
+	ID                uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	RegisteredModelID uuid.UUID         `gorm:"type:uuid;not null;index:,unique,composite:version"`
+	Version           int32             `gorm:"not null;index:,unique,composite:version"`
+	Description       string            `gorm:"type:varchar(5000)"`
+	UserID            string            `gorm:"type:varchar(256)"`
+	CurrentStage      ModelVersionStage `gorm:"type:varchar(20);index"`
+	Source            string            `gorm:"type:varchar(500)"`
+	RunID             string            `gorm:"type:varchar(32);index"`
+	RunLink           string            `gorm:"type:varchar(500)"`
+	Status            string            `gorm:"type:varchar(20)"`
+	StatusMessage     string            `gorm:"type:varchar(500)"`
+	CreationTime      sql.NullInt64     `gorm:"type:bigint"`
+	LastUpdatedTime   sql.NullInt64     `gorm:"type:bigint"`
+	Tags              []ModelVersionTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type ModelVersionTag struct {
+	Key            string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string    `gorm:"type:varchar(5000)"`
+	ModelVersionID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+type Dataset struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ExperimentID int32     `gorm:"not null;index:,unique,composite:dataset"`
+	Name         string    `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string    `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string    `gorm:"type:varchar(36);not null"`
+	Source       string    `gorm:"type:text;not null"`
+	Schema       string    `gorm:"type:text"`
+	Profile      string    `gorm:"type:text"`
+	Inputs       []Input   `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type Input struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	DatasetID uuid.UUID  `gorm:"type:uuid;not null;index:,unique,composite:input"`
+	RunID     string     `gorm:"column:run_uuid;not null;index:,unique,composite:input"`
+	Tags      []InputTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type InputTag struct {
+	Key     string    `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string    `gorm:"type:varchar(500);not null"`
+	InputID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
 type AlembicVersion struct {
 	Version string `gorm:"column:version_num;type:varchar(32);not null;primaryKey"`
 }
@@ -217,6 +320,148 @@ type App struct {
 
 type AppState map[string]any
 
+type SharedTag struct {
+	Base
+	Name        string    `gorm:"type:varchar(256);not null;index:,unique,composite:name" json:"name"`
+	Color       string    `gorm:"type:varchar(32)" json:"color"`
+	Description string    `json:"description"`
+	Namespace   Namespace `json:"-"`
+	NamespaceID uint      `gorm:"not null;index:,unique,composite:name" json:"-"`
+}
+
+type Note struct {
+	Base
+	Content      string         `json:"content"`
+	RunID        *string        `gorm:"column:run_uuid;type:varchar(32);index" json:"-"`
+	ExperimentID *int32         `gorm:"index" json:"-"`
+	Revisions    []NoteRevision `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+}
+
+type NoteAction string
+
+const (
+	NoteActionCreate NoteAction = "create"
+	NoteActionUpdate NoteAction = "update"
+	NoteActionDelete NoteAction = "delete"
+)
+
+type NoteRevision struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement"`
+	NoteID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Action    NoteAction `gorm:"type:varchar(16);not null"`
+	Content   string
+	CreatedAt time.Time
+}
+
+type BlobType string
+
+const (
+	BlobTypeImage BlobType = "images"
+	BlobTypeAudio BlobType = "audios"
+)
+
+// Blob represents a single record of the image or audio sequence of the run.
+// The content of the record is kept in the artifact storage of the run.
+type Blob struct {
+	ID        uint     `gorm:"primaryKey;autoIncrement"`
+	RunID     string   `gorm:"column:run_uuid;type:varchar(32);not null;uniqueIndex:idx_blobs_record,priority:1"`
+	Type      BlobType `gorm:"type:varchar(16);not null;uniqueIndex:idx_blobs_record,priority:2"`
+	Name      string   `gorm:"type:varchar(250);not null;uniqueIndex:idx_blobs_record,priority:3"`
+	ContextID uint     `gorm:"not null;uniqueIndex:idx_blobs_record,priority:4"`
+	Context   Context
+	Step      int64 `gorm:"not null;uniqueIndex:idx_blobs_record,priority:5"`
+	Index     int64 `gorm:"column:idx;not null;uniqueIndex:idx_blobs_record,priority:6"`
+	Timestamp int64 `gorm:"not null"`
+	Caption   string
+	Format    string `gorm:"type:varchar(16)"`
+	Width     int64
+	Height    int64
+	Size      int64 `gorm:"not null"`
+}
+
+// Distribution represents the histogram of a sequence logged at a single step.
+// Weights keep little-endian float64 values of the bins of equal width between Low and High.
+type Distribution struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	RunID     string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64   `gorm:"default:0;not null;primaryKey"`
+	Iter      int64   `gorm:"index"`
+	Timestamp int64   `gorm:"not null"`
+	Low       float64 `gorm:"type:double precision;not null"`
+	High      float64 `gorm:"type:double precision;not null"`
+	BinCount  int64   `gorm:"not null"`
+	Weights   []byte  `gorm:"not null"`
+}
+
+// Text represents the text record of a sequence logged at a single step and index.
+type Text struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;uniqueIndex:idx_texts_record,priority:1"`
+	Name      string `gorm:"type:varchar(250);not null;uniqueIndex:idx_texts_record,priority:2"`
+	ContextID uint   `gorm:"not null;uniqueIndex:idx_texts_record,priority:3"`
+	Context   Context
+	Step      int64  `gorm:"not null;uniqueIndex:idx_texts_record,priority:4"`
+	Index     int64  `gorm:"column:idx;not null;uniqueIndex:idx_texts_record,priority:5"`
+	Timestamp int64  `gorm:"not null"`
+	Data      string `gorm:"not null"`
+}
+
+// Figure represents the Plotly figure of a sequence logged at a single step.
+type Figure struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;uniqueIndex:idx_figures_record,priority:1"`
+	Name      string `gorm:"type:varchar(250);not null;uniqueIndex:idx_figures_record,priority:2"`
+	ContextID uint   `gorm:"not null;uniqueIndex:idx_figures_record,priority:3"`
+	Context   Context
+	Step      int64          `gorm:"not null;uniqueIndex:idx_figures_record,priority:4"`
+	Timestamp int64          `gorm:"not null"`
+	Data      datatypes.JSON `gorm:"not null"`
+}
+
+type LogStream string
+
+const (
+	LogStreamStdout LogStream = "stdout"
+	LogStreamStderr LogStream = "stderr"
+)
+
+// Log represents the line of the terminal output of the run.
+// Lines are ordered by ID, which keeps the order they were appended in.
+type Log struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	RunID     string    `gorm:"column:run_uuid;type:varchar(32);not null;index"`
+	Stream    LogStream `gorm:"type:varchar(16);not null"`
+	Timestamp int64     `gorm:"not null"`
+	Data      string    `gorm:"not null"`
+}
+
+// LogRecord represents the structured log record of the run.
+// Level keeps the numeric value of Python logging levels, the same way as Aim does.
+type LogRecord struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;index"`
+	Level     int    `gorm:"not null"`
+	Message   string `gorm:"not null"`
+	Timestamp int64  `gorm:"not null"`
+	Args      datatypes.JSON
+}
+
+type PinnedSequences struct {
+	NamespaceID uint               `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	Namespace   Namespace          `json:"-"`
+	Sequences   PinnedSequenceList `gorm:"not null" json:"sequences"`
+	UpdatedAt   time.Time          `json:"-"`
+}
+
+type PinnedSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
+type PinnedSequenceList []PinnedSequence
+
 func (s AppState) Value() (driver.Value, error) {
 	v, err := json.Marshal(s)
 	if err != nil {
@@ -240,6 +485,29 @@ func (s AppState) GormDataType() string {
 	return "text"
 }
 
+func (l PinnedSequenceList) Value() (driver.Value, error) {
+	v, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return string(v), nil
+}
+
+func (l *PinnedSequenceList) Scan(v interface{}) error {
+	var nullS sql.NullString
+	if err := nullS.Scan(v); err != nil {
+		return err
+	}
+	if nullS.Valid {
+		return json.Unmarshal([]byte(nullS.String), l)
+	}
+	return nil
+}
+
+func (l PinnedSequenceList) GormDataType() string {
+	return "text"
+}
+
 func NewUUID() string {
 	var r [32]byte
 	u := uuid.New()
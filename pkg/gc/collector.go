@@ -0,0 +1,184 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// runsBatchSize is the maximum number of runs removed from the db in a single statement.
+const runsBatchSize = 500
+
+// Options represents parameters of garbage collection.
+type Options struct {
+	// Retention is how long entities have to stay in `deleted` lifecycle stage before they are removed.
+	Retention time.Duration
+	// NamespaceCodes limits garbage collection to the provided namespaces. Empty means all namespaces.
+	NamespaceCodes []string
+	// DryRun only collects the entities, which would be removed, without removing anything.
+	DryRun bool
+}
+
+// Result represents the entities, which have been (or would have been in dry-run mode) removed.
+type Result struct {
+	// Experiments are the removed experiments together with all their runs.
+	Experiments []models.Experiment
+	// Runs are the removed runs, which do not belong to any of the removed experiments.
+	Runs []models.Run
+	// Contexts is the number of removed orphaned metric contexts.
+	Contexts int64
+}
+
+// Collector permanently removes runs and experiments, which have been deleted long enough ago.
+type Collector struct {
+	config                 *config.ServiceConfig
+	runRepository          repositories.RunRepositoryProvider
+	metricRepository       repositories.MetricRepositoryProvider
+	namespaceRepository    repositories.NamespaceRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// NewCollector creates new Collector instance.
+func NewCollector(
+	config *config.ServiceConfig,
+	runRepository repositories.RunRepositoryProvider,
+	metricRepository repositories.MetricRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *Collector {
+	return &Collector{
+		config:                 config,
+		runRepository:          runRepository,
+		metricRepository:       metricRepository,
+		namespaceRepository:    namespaceRepository,
+		experimentRepository:   experimentRepository,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+// Collect removes runs and experiments, which have been in `deleted` lifecycle stage longer than
+// the retention period, together with their artifacts and the metric contexts left orphaned.
+func (c Collector) Collect(ctx context.Context, options Options) (*Result, error) {
+	namespaceIDs, err := c.getNamespaceIDs(ctx, options.NamespaceCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedBefore := time.Now().Add(-options.Retention).UTC().UnixMilli()
+	experiments, err := c.experimentRepository.GetDeletedBefore(ctx, namespaceIDs, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted experiments")
+	}
+	runs, err := c.runRepository.GetDeletedBefore(ctx, namespaceIDs, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted runs")
+	}
+
+	// runs of the removed experiments are removed together with them.
+	experimentIDs := make(map[int32]struct{}, len(experiments))
+	for _, experiment := range experiments {
+		experimentIDs[*experiment.ID] = struct{}{}
+	}
+	result := Result{Experiments: experiments}
+	for _, run := range runs {
+		if _, ok := experimentIDs[run.ExperimentID]; !ok {
+			result.Runs = append(result.Runs, run)
+		}
+	}
+
+	if options.DryRun {
+		return &result, nil
+	}
+
+	// artifacts go first, so that a failure leaves the db untouched and the next collection retries.
+	for _, experiment := range result.Experiments {
+		for i := range experiment.Runs {
+			if err := c.deleteArtifacts(ctx, &experiment.Runs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range result.Runs {
+		if err := c.deleteArtifacts(ctx, &result.Runs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 0; i < len(result.Runs); i += runsBatchSize {
+		batch := result.Runs[i:min(i+runsBatchSize, len(result.Runs))]
+		ids := make([]string, len(batch))
+		for j, run := range batch {
+			ids[j] = run.ID
+		}
+		if err := c.runRepository.PurgeBatch(ctx, ids); err != nil {
+			return nil, eris.Wrap(err, "error removing deleted runs")
+		}
+	}
+
+	if len(result.Experiments) > 0 {
+		ids := make([]*int32, len(result.Experiments))
+		for i, experiment := range result.Experiments {
+			ids[i] = experiment.ID
+		}
+		if err := c.experimentRepository.DeleteBatch(ctx, ids); err != nil {
+			return nil, eris.Wrap(err, "error removing deleted experiments")
+		}
+	}
+
+	result.Contexts, err = c.metricRepository.DeleteOrphanedContexts(ctx)
+	if err != nil {
+		return nil, eris.Wrap(err, "error removing orphaned contexts")
+	}
+
+	return &result, nil
+}
+
+// getNamespaceIDs converts namespace codes into their IDs.
+func (c Collector) getNamespaceIDs(ctx context.Context, codes []string) ([]uint, error) {
+	ids := make([]uint, 0, len(codes))
+	for _, code := range codes {
+		namespace, err := c.namespaceRepository.GetByCode(ctx, code)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error getting namespace with code: %s", code)
+		}
+		if namespace == nil {
+			return nil, eris.Errorf("unable to find namespace with code: %s", code)
+		}
+		ids = append(ids, namespace.ID)
+	}
+	return ids, nil
+}
+
+// deleteArtifacts deletes all the artifacts of the run. Missing artifacts are not an error.
+func (c Collector) deleteArtifacts(ctx context.Context, run *models.Run) error {
+	if run.ArtifactURI == "" {
+		return nil
+	}
+	artifactURI, err := c.config.ResolveArtifactURI(run.ArtifactURI)
+	if err != nil {
+		return eris.Wrapf(err, "run with id '%s' has incorrect artifact uri", run.ID)
+	}
+	artifactStorage, err := c.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return eris.Wrapf(err, "run with id '%s' has unsupported artifact storage", run.ID)
+	}
+	if err := artifactStorage.Delete(ctx, artifactURI, ""); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return eris.Wrapf(err, "error deleting artifacts of run with id '%s'", run.ID)
+	}
+	log.Debugf("deleted artifacts of run with id '%s': %s", run.ID, run.ArtifactURI)
+	return nil
+}
@@ -0,0 +1,227 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+func TestCollector_Collect_Ok(t *testing.T) {
+	experiments := []models.Experiment{
+		{
+			ID:   common.GetPointer[int32](1),
+			Name: "experiment",
+			Runs: []models.Run{
+				{ID: "run1", ExperimentID: 1, ArtifactURI: "/artifacts/1/run1/artifacts"},
+			},
+		},
+	}
+	runs := []models.Run{
+		{ID: "run1", ExperimentID: 1, ArtifactURI: "/artifacts/1/run1/artifacts"},
+		{ID: "run2", ExperimentID: 2, ArtifactURI: "mlflow-artifacts:/2/run2/artifacts"},
+		{ID: "run3", ExperimentID: 2, ArtifactURI: "/artifacts/2/run3/artifacts"},
+	}
+
+	testData := []struct {
+		name           string
+		options        Options
+		expectedResult *Result
+		setup          func(
+			*repositories.MockRunRepositoryProvider,
+			*repositories.MockMetricRepositoryProvider,
+			*repositories.MockExperimentRepositoryProvider,
+			*storage.MockArtifactStorageProvider,
+		)
+	}{
+		{
+			name:    "DryRun",
+			options: Options{DryRun: true},
+			expectedResult: &Result{
+				Experiments: experiments,
+				Runs:        runs[1:],
+			},
+			setup: func(
+				runRepository *repositories.MockRunRepositoryProvider,
+				metricRepository *repositories.MockMetricRepositoryProvider,
+				experimentRepository *repositories.MockExperimentRepositoryProvider,
+				artifactStorage *storage.MockArtifactStorageProvider,
+			) {
+			},
+		},
+		{
+			name:    "RemoveDeletedEntities",
+			options: Options{},
+			expectedResult: &Result{
+				Experiments: experiments,
+				Runs:        runs[1:],
+				Contexts:    2,
+			},
+			setup: func(
+				runRepository *repositories.MockRunRepositoryProvider,
+				metricRepository *repositories.MockMetricRepositoryProvider,
+				experimentRepository *repositories.MockExperimentRepositoryProvider,
+				artifactStorage *storage.MockArtifactStorageProvider,
+			) {
+				artifactStorage.On(
+					"Delete", context.TODO(), "/artifacts/1/run1/artifacts", "",
+				).Return(nil)
+				artifactStorage.On(
+					"Delete", context.TODO(), "/destination/2/run2/artifacts", "",
+				).Return(nil)
+				artifactStorage.On(
+					"Delete", context.TODO(), "/artifacts/2/run3/artifacts", "",
+				).Return(eris.Wrap(fs.ErrNotExist, "path could not be opened"))
+				runRepository.On("PurgeBatch", context.TODO(), []string{"run2", "run3"}).Return(nil)
+				experimentRepository.On(
+					"DeleteBatch", context.TODO(), []*int32{common.GetPointer[int32](1)},
+				).Return(nil)
+				metricRepository.On("DeleteOrphanedContexts", context.TODO()).Return(int64(2), nil)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetDeletedBefore", context.TODO(), []uint{}, mock.AnythingOfType("int64"),
+			).Return(runs, nil)
+			experimentRepository := repositories.MockExperimentRepositoryProvider{}
+			experimentRepository.On(
+				"GetDeletedBefore", context.TODO(), []uint{}, mock.AnythingOfType("int64"),
+			).Return(experiments, nil)
+			metricRepository := repositories.MockMetricRepositoryProvider{}
+			artifactStorage := storage.MockArtifactStorageProvider{}
+			artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+			artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+			tt.setup(&runRepository, &metricRepository, &experimentRepository, &artifactStorage)
+
+			collector := NewCollector(
+				&config.ServiceConfig{ServeArtifacts: true, ArtifactsDestination: "/destination"},
+				&runRepository,
+				&metricRepository,
+				&repositories.MockNamespaceRepositoryProvider{},
+				&experimentRepository,
+				&artifactStorageFactory,
+			)
+			result, err := collector.Collect(context.TODO(), tt.options)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+
+			runRepository.AssertExpectations(t)
+			metricRepository.AssertExpectations(t)
+			experimentRepository.AssertExpectations(t)
+			artifactStorage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCollector_Collect_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		options Options
+		error   error
+		setup   func(
+			*repositories.MockRunRepositoryProvider,
+			*repositories.MockNamespaceRepositoryProvider,
+			*repositories.MockExperimentRepositoryProvider,
+			*storage.MockArtifactStorageProvider,
+		)
+	}{
+		{
+			name:    "NamespaceNotFound",
+			options: Options{NamespaceCodes: []string{"unknown"}},
+			error:   errors.New("unable to find namespace with code: unknown"),
+			setup: func(
+				runRepository *repositories.MockRunRepositoryProvider,
+				namespaceRepository *repositories.MockNamespaceRepositoryProvider,
+				experimentRepository *repositories.MockExperimentRepositoryProvider,
+				artifactStorage *storage.MockArtifactStorageProvider,
+			) {
+				namespaceRepository.On("GetByCode", context.TODO(), "unknown").Return(nil, nil)
+			},
+		},
+		{
+			name:    "ArtifactsDeletionFailed",
+			options: Options{NamespaceCodes: []string{"default"}},
+			error: errors.New(
+				"error deleting artifacts of run with id 'run1': permission denied",
+			),
+			setup: func(
+				runRepository *repositories.MockRunRepositoryProvider,
+				namespaceRepository *repositories.MockNamespaceRepositoryProvider,
+				experimentRepository *repositories.MockExperimentRepositoryProvider,
+				artifactStorage *storage.MockArtifactStorageProvider,
+			) {
+				namespaceRepository.On(
+					"GetByCode", context.TODO(), "default",
+				).Return(&models.Namespace{ID: 1, Code: "default"}, nil)
+				experimentRepository.On(
+					"GetDeletedBefore", context.TODO(), []uint{1}, mock.AnythingOfType("int64"),
+				).Return(nil, nil)
+				runRepository.On(
+					"GetDeletedBefore", context.TODO(), []uint{1}, mock.AnythingOfType("int64"),
+				).Return([]models.Run{{ID: "run1", ArtifactURI: "/artifacts/0/run1/artifacts"}}, nil)
+				artifactStorage.On(
+					"Delete", context.TODO(), "/artifacts/0/run1/artifacts", "",
+				).Return(errors.New("permission denied"))
+			},
+		},
+		{
+			name:    "ProxiedArtifactsWithoutDestination",
+			options: Options{},
+			error: errors.New(
+				"run with id 'run1' has incorrect artifact uri: artifacts serving is disabled",
+			),
+			setup: func(
+				runRepository *repositories.MockRunRepositoryProvider,
+				namespaceRepository *repositories.MockNamespaceRepositoryProvider,
+				experimentRepository *repositories.MockExperimentRepositoryProvider,
+				artifactStorage *storage.MockArtifactStorageProvider,
+			) {
+				experimentRepository.On(
+					"GetDeletedBefore", context.TODO(), []uint{}, mock.AnythingOfType("int64"),
+				).Return(nil, nil)
+				runRepository.On(
+					"GetDeletedBefore", context.TODO(), []uint{}, mock.AnythingOfType("int64"),
+				).Return([]models.Run{{ID: "run1", ArtifactURI: "mlflow-artifacts:/0/run1/artifacts"}}, nil)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			runRepository := repositories.MockRunRepositoryProvider{}
+			namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+			experimentRepository := repositories.MockExperimentRepositoryProvider{}
+			artifactStorage := storage.MockArtifactStorageProvider{}
+			artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+			artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+			tt.setup(&runRepository, &namespaceRepository, &experimentRepository, &artifactStorage)
+
+			collector := NewCollector(
+				&config.ServiceConfig{},
+				&runRepository,
+				&repositories.MockMetricRepositoryProvider{},
+				&namespaceRepository,
+				&experimentRepository,
+				&artifactStorageFactory,
+			)
+			result, err := collector.Collect(context.TODO(), tt.options)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.error.Error(), err.Error())
+		})
+	}
+}
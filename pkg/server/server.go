@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
 
 	"github.com/G-Research/fasttrackml/pkg/api/admin/service/namespace"
 	aimAPI "github.com/G-Research/fasttrackml/pkg/api/aim"
@@ -31,8 +33,10 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/metric"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/model"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/run"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/bodylimit"
 	namespaceMiddleware "github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/pkg/gc"
 	adminUI "github.com/G-Research/fasttrackml/pkg/ui/admin"
 	adminUIController "github.com/G-Research/fasttrackml/pkg/ui/admin/controller"
 	aimUI "github.com/G-Research/fasttrackml/pkg/ui/aim"
@@ -44,6 +48,7 @@ import (
 
 type Server interface {
 	Listen(address string) error
+	Listener(ln net.Listener) error
 	ShutdownWithTimeout(timeout time.Duration) error
 	Test(req *http.Request, msTimeout ...int) (*http.Response, error)
 }
@@ -74,6 +79,11 @@ func NewServer(ctx context.Context, config *mlflowConfig.ServiceConfig) (Server,
 		return nil, err
 	}
 
+	// start periodic garbage collection of deleted runs and experiments.
+	if config.GCInterval > 0 {
+		go runGC(ctx, config, db, artifactStorageFactory, namespaceRepository)
+	}
+
 	// create fiber app.
 	//nolint:contextcheck
 	app := createApp(config, db, artifactStorageFactory, namespaceRepository)
@@ -136,21 +146,78 @@ func createNamespaceRepository(
 	return repo, nil
 }
 
+// runGC periodically removes deleted runs and experiments, which are older than retention period,
+// until the context is canceled.
+func runGC(
+	ctx context.Context,
+	config *mlflowConfig.ServiceConfig,
+	db database.DBProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+) {
+	collector := gc.NewCollector(
+		config,
+		mlflowRepositories.NewRunRepository(db.GormDB()),
+		mlflowRepositories.NewMetricRepository(db.GormDB()),
+		namespaceRepository,
+		mlflowRepositories.NewExperimentRepository(db.GormDB()),
+		artifactStorageFactory,
+	)
+
+	ticker := time.NewTicker(config.GCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := collector.Collect(ctx, gc.Options{Retention: config.GCRetention})
+			if err != nil {
+				log.Errorf("Error collecting deleted runs and experiments: %+v", err)
+				continue
+			}
+			log.Infof(
+				"Removed %d deleted experiments, %d deleted runs and %d orphaned contexts",
+				len(result.Experiments), len(result.Runs), result.Contexts,
+			)
+		}
+	}
+}
+
+// bodyLimit is the maximal size of the request body, except the uploaded artifacts.
+const bodyLimit = 16 * 1024 * 1024
+
+// uploadReadTimeout is the maximal duration of reading the request of the uploaded artifacts,
+// which replaces the default read timeout, so the large bodies have enough time to arrive.
+const uploadReadTimeout = 24 * time.Hour
+
+// isStreamedUpload checks if the request uploads the artifact or the part of the multipart upload,
+// whose body is streamed into the storage.
+func isStreamedUpload(method, path string) bool {
+	return method == fiber.MethodPut &&
+		(strings.Contains(path, "/mlflow-artifacts/artifacts/") ||
+			strings.Contains(path, "/mlflow-artifacts/mpu/upload/"))
+}
+
 // createApp creates a new fiber app with base configuration.
 func createApp(
 	config *mlflowConfig.ServiceConfig,
 	db database.DBProvider,
-	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+	artifactStorageFactory *storage.ArtifactStorageFactory,
 	namespaceRepository repositories.NamespaceRepositoryProvider,
 ) *fiber.App {
 	app := fiber.New(fiber.Config{
-		BodyLimit:             16 * 1024 * 1024,
-		ReadBufferSize:        16384,
-		ReadTimeout:           5 * time.Second,
-		WriteTimeout:          600 * time.Second,
-		IdleTimeout:           120 * time.Second,
-		ServerHeader:          fmt.Sprintf("FastTrackML/%s", version.Version),
-		DisableStartupMessage: true,
+		// the bodies are streamed, so the artifacts of any size can be uploaded,
+		// while the bodies of the other requests are still limited by bodylimit middleware.
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+		BodyLimit:                    bodyLimit,
+		ReadBufferSize:               16384,
+		ReadTimeout:                  5 * time.Second,
+		WriteTimeout:                 600 * time.Second,
+		IdleTimeout:                  120 * time.Second,
+		ServerHeader:                 fmt.Sprintf("FastTrackML/%s", version.Version),
+		DisableStartupMessage:        true,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			p := string(c.Request().URI().Path())
 			switch {
@@ -158,7 +225,9 @@ func createApp(
 				return aimAPI.ErrorHandler(c, err)
 			case strings.HasPrefix(p, "/api/2.0/mlflow/") ||
 				strings.HasPrefix(p, "/ajax-api/2.0/mlflow/") ||
-				strings.HasPrefix(p, "/mlflow/ajax-api/2.0/mlflow/"):
+				strings.HasPrefix(p, "/mlflow/ajax-api/2.0/mlflow/") ||
+				strings.HasPrefix(p, "/api/2.0/mlflow-artifacts/") ||
+				strings.HasPrefix(p, "/ajax-api/2.0/mlflow-artifacts/"):
 				return mlflowService.ErrorHandler(c, err)
 
 			default:
@@ -167,11 +236,29 @@ func createApp(
 		},
 	})
 
+	// the read deadline is set before the headers are read and is not extended while the body is streamed,
+	// so the uploads get the longer deadline once their headers are received.
+	app.Server().HeaderReceived = func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+		path, _, _ := strings.Cut(string(header.RequestURI()), "?")
+		if isStreamedUpload(string(header.Method()), path) {
+			return fasthttp.RequestConfig{ReadTimeout: uploadReadTimeout}
+		}
+		return fasthttp.RequestConfig{}
+	}
+
 	app.Hooks().OnShutdown(func() error {
 		log.Info("Shutting down database connection")
 		return db.Close()
 	})
 
+	app.Use(bodylimit.New(bodylimit.Config{
+		Limit: bodyLimit,
+		Next: func(c *fiber.Ctx) bool {
+			// artifacts and parts of the multipart uploads are streamed into the storage.
+			return isStreamedUpload(c.Method(), c.Path())
+		},
+	}))
+
 	if config.DevMode {
 		log.Info("Development mode - enabling CORS")
 		app.Use(cors.New())
@@ -207,10 +294,15 @@ func createApp(
 	app.Get("/version", func(c *fiber.Ctx) error {
 		return c.SendString(version.Version)
 	})
+	if cache := artifactStorageFactory.GetCache(); cache != nil {
+		app.Get("/artifacts-cache/stats", func(c *fiber.Ctx) error {
+			return c.JSON(cache.GetStats())
+		})
+	}
 
 	// init `aim` api and ui routes.
 	router := app.Group("/aim/api/")
-	aimAPI.AddRoutes(router)
+	aimAPI.AddRoutes(router, config, artifactStorageFactory)
 	aimUI.AddRoutes(app)
 
 	// init `mlflow` api and ui routes.
@@ -223,13 +315,19 @@ func createApp(
 				mlflowRepositories.NewParamRepository(db.GormDB()),
 				mlflowRepositories.NewMetricRepository(db.GormDB()),
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+				mlflowRepositories.NewDatasetRepository(db.GormDB()),
+			),
+			model.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewModelVersionRepository(db.GormDB()),
+				mlflowRepositories.NewRegisteredModelRepository(db.GormDB()),
 			),
-			model.NewService(),
 			metric.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				mlflowRepositories.NewMetricRepository(db.GormDB()),
 			),
 			artifact.NewService(
+				config,
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				artifactStorageFactory,
 			),
@@ -25,6 +25,10 @@ def get_metric_histories(
     max_results: int = 10000000,
     search_all_experiments: bool = False,
     experiment_names: Optional[List[str]] = None,
+    num_points: Optional[int] = None,
+    sampling: Optional[str] = None,
+    start_step: Optional[int] = None,
+    end_step: Optional[int] = None,
 ) -> pd.DataFrame:
     """
     Get metric histories of Runs that fit the specified criteria.
@@ -48,6 +52,12 @@ def get_metric_histories(
                              than ``None`` or ``[]`` will result in error if ``experiment_ids``
                              is also not ``None`` or ``[]``. ``None`` will default to the active
                              experiment if ``experiment_ids`` is ``None`` or ``[]``.
+    :param num_points: Maximum number of values of every metric history. Longer histories are
+                       downsampled on the server. ``None`` returns all the values.
+    :param sampling: Downsampling method, either ``lttb`` (default) or ``minmax``, which keeps the
+                     minimal and the maximal values of every bucket.
+    :param start_step: Only return metric values logged at this step or later.
+    :param end_step: Only return metric values logged at this step or earlier.
     :return: ``pandas.DataFrame`` of metric timestamps and values, indexed on run ID, metric key,
              and step. If index is ``timestamp``, the columns will be metric steps and values, and
              the index will be run ID, metric key, and timestamp.
@@ -93,6 +103,10 @@ def get_metric_histories(
             "metric_keys": metric_keys,
             "run_view_type": ViewType.to_string(run_view_type).upper(),
             "max_results": max_results,
+            "num_points": num_points or 0,
+            "sampling": sampling or "",
+            "start_step": start_step,
+            "end_step": end_step,
         },
         stream=True,
     )
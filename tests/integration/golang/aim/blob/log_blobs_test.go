@@ -0,0 +1,183 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogBlobsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestLogBlobsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogBlobsTestSuite))
+}
+
+func (s *LogBlobsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             uuid.NewString(),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    s.T().TempDir(),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+}
+
+func (s *LogBlobsTestSuite) Test_Ok() {
+	data := newPNG(s.T(), 3, 2)
+
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunBlobsRequest{
+				Records: []request.BlobRecord{
+					{
+						Name:    "samples",
+						Context: map[string]any{"subset": "train"},
+						Step:    1,
+						Index:   0,
+						Caption: "first",
+						Data:    data,
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/images/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	blobs, err := s.BlobFixtures.GetBlobs(context.Background(), s.run.ID, database.BlobTypeImage)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(blobs))
+	s.Equal("samples", blobs[0].Name)
+	s.Equal(int64(1), blobs[0].Step)
+	s.Equal("first", blobs[0].Caption)
+	s.Equal("png", blobs[0].Format)
+	s.Equal(int64(3), blobs[0].Width)
+	s.Equal(int64(2), blobs[0].Height)
+	s.Equal(int64(len(data)), blobs[0].Size)
+	s.JSONEq(`{"subset":"train"}`, string(blobs[0].Context.Json))
+
+	content, err := os.ReadFile(
+		filepath.Join(s.run.ArtifactURI, "aim", "images", strconv.FormatUint(uint64(blobs[0].ID), 10)),
+	)
+	s.Require().Nil(err)
+	s.Equal(data, content)
+
+	// logging the record for the same step and index once again replaces it.
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunBlobsRequest{
+				Records: []request.BlobRecord{
+					{
+						Name:    "samples",
+						Context: map[string]any{"subset": "train"},
+						Step:    1,
+						Index:   0,
+						Caption: "second",
+						Data:    data,
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/images/log-batch", s.run.ID,
+		),
+	)
+
+	blobs, err = s.BlobFixtures.GetBlobs(context.Background(), s.run.ID, database.BlobTypeImage)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(blobs))
+	s.Equal("second", blobs[0].Caption)
+}
+
+func (s *LogBlobsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		runID   string
+		request request.LogRunBlobsRequest
+		error   string
+	}{
+		{
+			name:  "LogRecordWithoutName",
+			runID: s.run.ID,
+			request: request.LogRunBlobsRequest{
+				Records: []request.BlobRecord{{Data: []byte("data")}},
+			},
+			error: "record name is required",
+		},
+		{
+			name:  "LogRecordWithoutData",
+			runID: s.run.ID,
+			request: request.LogRunBlobsRequest{
+				Records: []request.BlobRecord{{Name: "samples"}},
+			},
+			error: `record "samples" has no data`,
+		},
+		{
+			name:  "LogRecordOfNotFoundRun",
+			runID: "not-existing-id",
+			request: request.LogRunBlobsRequest{
+				Records: []request.BlobRecord{{Name: "samples", Data: []byte("data")}},
+			},
+			error: `unable to find run "not-existing-id"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/audios/log-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
+
+// newPNG creates the content of the png image with the provided dimensions.
+func newPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,169 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchBlobsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestSearchBlobsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchBlobsTestSuite))
+}
+
+func (s *SearchBlobsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             uuid.NewString(),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    s.T().TempDir(),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	var records []request.BlobRecord
+	for step := int64(0); step < 4; step++ {
+		for index := int64(0); index < 2; index++ {
+			records = append(records, request.BlobRecord{
+				Name:    "samples",
+				Context: map[string]any{"subset": "train"},
+				Step:    step,
+				Index:   index,
+				Caption: fmt.Sprintf("sample %d.%d", step, index),
+				Data:    []byte(fmt.Sprintf("audio %d.%d", step, index)),
+			})
+		}
+	}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunBlobsRequest{Records: records},
+		).DoRequest(
+			"/runs/%s/audios/log-batch", s.run.ID,
+		),
+	)
+}
+
+func (s *SearchBlobsTestSuite) Test_Ok() {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchSequencesRequest{
+				Query:         `audios.name == "samples" and audios.context.subset == "train"`,
+				RecordDensity: 2,
+				IndexDensity:  1,
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/audios"),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	prefix := fmt.Sprintf("%s.traces.0", s.run.ID)
+	s.Equal(s.run.Name, decodedData[s.run.ID+".props.name"])
+	s.Equal("samples", decodedData[prefix+".name"])
+	s.Equal("train", decodedData[prefix+".context.subset"])
+	s.Equal(int64(0), decodedData[prefix+".iters.0"])
+	s.Equal(int64(2), decodedData[prefix+".iters.1"])
+	s.Nil(decodedData[prefix+".iters.2"])
+	s.Equal("sample 2.0", decodedData[prefix+".values.1.0.caption"])
+	s.Equal("wav", decodedData[prefix+".values.1.0.format"])
+	s.Nil(decodedData[prefix+".values.1.1.caption"])
+	s.Equal(int64(4), decodedData[s.run.ID+".ranges.record_range_total.1"])
+	s.Equal(int64(2), decodedData[s.run.ID+".ranges.index_range_total.1"])
+
+	// fetch the content of the sampled records.
+	blobs, err := s.BlobFixtures.GetBlobs(context.Background(), s.run.ID, database.BlobTypeAudio)
+	s.Require().Nil(err)
+	uris := []string{
+		decodedData[prefix+".values.0.0.blob_uri"].(string),
+		decodedData[prefix+".values.1.0.blob_uri"].(string),
+	}
+	s.Equal(fmt.Sprint(blobs[0].ID), uris[0])
+	s.Equal(fmt.Sprint(blobs[4].ID), uris[1])
+
+	resp = new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			append(uris, "999999"),
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/audios/get-batch"),
+	)
+
+	expected := new(bytes.Buffer)
+	s.Require().Nil(encoding.EncodeTree(expected, fiber.Map{uris[0]: []byte("audio 0.0")}))
+	s.Require().Nil(encoding.EncodeTree(expected, fiber.Map{uris[1]: []byte("audio 2.0")}))
+	s.Equal(expected.Bytes(), resp.Bytes())
+}
+
+func (s *SearchBlobsTestSuite) Test_GetRunBlobsBatch() {
+	var resp response.GetRunBlobsBatch
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithQuery(
+			struct {
+				RecordRange string `query:"record_range"`
+			}{RecordRange: "1:3"},
+		).WithRequest(
+			request.GetRunSequencesBatchRequest{
+				{Name: "samples", Context: map[string]any{"subset": "train"}},
+				{Name: "samples", Context: map[string]any{"subset": "test"}},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/audios/get-batch", s.run.ID,
+		),
+	)
+	s.Require().Equal(1, len(resp))
+	s.Equal("samples", resp[0].Name)
+	s.Equal(map[string]any{"subset": "train"}, resp[0].Context)
+	s.Equal([]int64{1, 2}, resp[0].Iters)
+	s.Equal([]int64{1, 3}, resp[0].RecordRange)
+	s.Equal([]int64{0, 2}, resp[0].IndexRange)
+	s.Require().Equal(2, len(resp[0].Values))
+	s.Equal(2, len(resp[0].Values[0]))
+	s.Equal("sample 1.1", resp[0].Values[0][1].Caption)
+
+	var info response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info", s.run.ID),
+	)
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "samples", Context: map[string]any{"subset": "train"}},
+	}, info.Traces.Audios)
+	s.Empty(info.Traces.Images)
+}
@@ -0,0 +1,166 @@
+package distribution
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunDistributionsBatchTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestGetRunDistributionsBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunDistributionsBatchTestSuite))
+}
+
+func (s *GetRunDistributionsBatchTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	records := make([]request.DistributionRecord, 10)
+	for i := range records {
+		records[i] = request.DistributionRecord{
+			Name:      "weights",
+			Context:   map[string]any{"layer": "dense"},
+			Step:      int64(i),
+			Timestamp: int64(i+1) * 1000,
+			Weights:   []float64{float64(i), 1},
+			Range:     []float64{0, float64(i + 1)},
+		}
+	}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunDistributionsRequest{Records: records},
+		).DoRequest(
+			"/runs/%s/distributions/log-batch", s.run.ID,
+		),
+	)
+}
+
+func (s *GetRunDistributionsBatchTestSuite) Test_Ok() {
+	tests := []struct {
+		name        string
+		query       any
+		iters       []int64
+		recordRange []int64
+	}{
+		{
+			name: "GetAllDistributions",
+			query: struct {
+				RecordDensity int `query:"record_density"`
+			}{RecordDensity: 0},
+			iters:       []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			recordRange: []int64{0, 10},
+		},
+		{
+			name: "GetSampledDistributions",
+			query: struct {
+				RecordDensity int `query:"record_density"`
+			}{RecordDensity: 5},
+			iters:       []int64{0, 2, 4, 6, 8},
+			recordRange: []int64{0, 10},
+		},
+		{
+			name: "GetSampledDistributionsInsideRange",
+			query: struct {
+				RecordRange   string `query:"record_range"`
+				RecordDensity int    `query:"record_density"`
+			}{RecordRange: "4:", RecordDensity: 3},
+			iters:       []int64{4, 6, 8},
+			recordRange: []int64{4, 10},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.GetRunDistributionsBatch
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithQuery(
+					tt.query,
+				).WithRequest(
+					request.GetRunSequencesBatchRequest{
+						{Name: "weights", Context: map[string]any{"layer": "dense"}},
+						{Name: "weights", Context: map[string]any{"layer": "conv"}},
+						{Name: "biases", Context: map[string]any{"layer": "dense"}},
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/distributions/get-batch", s.run.ID,
+				),
+			)
+			s.Require().Equal(1, len(resp))
+			s.Equal("weights", resp[0].Name)
+			s.Equal(map[string]any{"layer": "dense"}, resp[0].Context)
+			s.Equal(tt.iters, resp[0].Iters)
+			s.Equal(tt.recordRange, resp[0].RecordRange)
+			s.Require().Equal(len(tt.iters), len(resp[0].Values))
+			for i, iter := range tt.iters {
+				value := resp[0].Values[i]
+				s.Equal(float64(iter+1), resp[0].Timestamps[i])
+				s.Equal(int64(2), value.BinCount)
+				s.Equal([]float64{0, float64(iter + 1)}, value.Range)
+				s.Equal("numpy", value.Data.Type)
+				s.Equal(2, value.Data.Shape)
+				s.Equal(
+					float64(iter), math.Float64frombits(binary.LittleEndian.Uint64(value.Data.Blob[:8])),
+				)
+			}
+		})
+	}
+}
+
+func (s *GetRunDistributionsBatchTestSuite) Test_GetRunInfo() {
+	var resp response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithResponse(&resp).DoRequest("/runs/%s/info", s.run.ID),
+	)
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "weights", Context: map[string]any{"layer": "dense"}},
+	}, resp.Traces.Distributions)
+
+	var params map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"sequence": "distributions"},
+		).WithResponse(
+			&params,
+		).DoRequest("/projects/params"),
+	)
+	s.Equal(map[string]any{
+		"weights": []any{map[string]any{"layer": "dense"}},
+	}, params["distributions"])
+}
+
+func (s *GetRunDistributionsBatchTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunSequencesBatchRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/distributions/get-batch", "not-existing-id",
+		),
+	)
+	s.Equal(`unable to find run "not-existing-id"`, resp.Message)
+}
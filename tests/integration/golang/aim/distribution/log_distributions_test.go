@@ -0,0 +1,166 @@
+package distribution
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogDistributionsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestLogDistributionsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogDistributionsTestSuite))
+}
+
+func (s *LogDistributionsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *LogDistributionsTestSuite) Test_Ok() {
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{
+					{
+						Name:    "weights",
+						Context: map[string]any{"layer": "dense"},
+						Step:    0,
+						Weights: []float64{1, 2, 3},
+						Range:   []float64{-1, 1},
+					},
+					{
+						Name:     "weights",
+						Context:  map[string]any{"layer": "dense"},
+						Step:     1,
+						Values:   []float64{0, 1, 2, 3},
+						BinCount: 2,
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/distributions/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	distributions, err := s.DistributionFixtures.GetDistributions(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(distributions))
+	s.Equal("weights", distributions[0].Key)
+	s.JSONEq(`{"layer":"dense"}`, string(distributions[0].Context.Json))
+	s.Equal(int64(1), distributions[0].Iter)
+	s.Equal(int64(3), distributions[0].BinCount)
+	s.Equal(-1.0, distributions[0].Low)
+	s.Equal(1.0, distributions[0].High)
+	s.Equal(int64(2), distributions[1].Iter)
+	s.Equal(int64(2), distributions[1].BinCount)
+	s.Equal(0.0, distributions[1].Low)
+	s.Equal(3.0, distributions[1].High)
+
+	// logging the distribution for the same step once again replaces it and keeps its iteration.
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{
+					{
+						Name:    "weights",
+						Context: map[string]any{"layer": "dense"},
+						Step:    0,
+						Weights: []float64{4, 5},
+						Range:   []float64{0, 2},
+					},
+					{
+						Name:    "weights",
+						Context: map[string]any{"layer": "dense"},
+						Step:    2,
+						Weights: []float64{6},
+						Range:   []float64{0, 1},
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/distributions/log-batch", s.run.ID,
+		),
+	)
+
+	distributions, err = s.DistributionFixtures.GetDistributions(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(3, len(distributions))
+	s.Equal(int64(1), distributions[0].Iter)
+	s.Equal(int64(2), distributions[0].BinCount)
+	s.Equal(int64(3), distributions[2].Iter)
+}
+
+func (s *LogDistributionsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		runID   string
+		request request.LogRunDistributionsRequest
+		error   string
+	}{
+		{
+			name:  "LogRecordWithoutData",
+			runID: s.run.ID,
+			request: request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{{Name: "weights"}},
+			},
+			error: `record "weights" has no data`,
+		},
+		{
+			name:  "LogRecordWithInvalidRange",
+			runID: s.run.ID,
+			request: request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{{Name: "weights", Weights: []float64{1}}},
+			},
+			error: `record "weights" has invalid range []`,
+		},
+		{
+			name:  "LogRecordOfNotFoundRun",
+			runID: "not-existing-id",
+			request: request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{{Name: "weights", Values: []float64{1}}},
+			},
+			error: `unable to find run "not-existing-id"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/distributions/log-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package figure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type FiguresTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestFiguresTestSuite(t *testing.T) {
+	suite.Run(t, new(FiguresTestSuite))
+}
+
+func (s *FiguresTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *FiguresTestSuite) Test_Ok() {
+	records := make([]request.FigureRecord, 3)
+	for i := range records {
+		records[i] = request.FigureRecord{
+			Name:    "roc",
+			Context: map[string]any{"subset": "test"},
+			Step:    int64(i),
+			Data: json.RawMessage(fmt.Sprintf(
+				`{"data":[{"type":"scatter","x":[0,1],"y":[0,%d]}],"layout":{"title":"step %d"}}`, i, i,
+			)),
+		}
+	}
+
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunFiguresRequest{Records: records},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/figures/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	figures, err := s.FigureFixtures.GetFigures(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(3, len(figures))
+	s.Equal("roc", figures[0].Name)
+	s.JSONEq(`{"subset":"test"}`, string(figures[0].Context.Json))
+	s.JSONEq(string(records[1].Data), string(figures[1].Data))
+
+	buf := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchSequencesRequest{
+				Query:       `figures.name == "roc"`,
+				RecordRange: "1:",
+			},
+		).WithResponse(
+			buf,
+		).DoRequest("/runs/search/figures"),
+	)
+
+	decodedData, err := encoding.NewDecoder(buf).Decode()
+	s.Require().Nil(err)
+
+	prefix := fmt.Sprintf("%s.traces.0", s.run.ID)
+	s.Equal("roc", decodedData[prefix+".name"])
+	s.Equal("test", decodedData[prefix+".context.subset"])
+	s.Equal(int64(1), decodedData[prefix+".iters.0"])
+	s.Equal(int64(2), decodedData[prefix+".iters.1"])
+	s.Nil(decodedData[prefix+".iters.2"])
+	s.Equal("step 1", decodedData[prefix+".values.0.data.layout.title"])
+	s.Equal("scatter", decodedData[prefix+".values.1.data.data.0.type"])
+	s.Equal(int64(1), decodedData[s.run.ID+".ranges.record_range_used.0"])
+
+	var info response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info", s.run.ID),
+	)
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "roc", Context: map[string]any{"subset": "test"}},
+	}, info.Traces.Figures)
+}
+
+func (s *FiguresTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		runID   string
+		request request.LogRunFiguresRequest
+		error   string
+	}{
+		{
+			name:  "LogRecordWithoutName",
+			runID: s.run.ID,
+			request: request.LogRunFiguresRequest{
+				Records: []request.FigureRecord{{Data: json.RawMessage(`{}`)}},
+			},
+			error: "record name is required",
+		},
+		{
+			name:  "LogRecordWithInvalidData",
+			runID: s.run.ID,
+			request: request.LogRunFiguresRequest{
+				Records: []request.FigureRecord{{Name: "roc", Data: json.RawMessage(`[1, 2]`)}},
+			},
+			error: `record "roc" has invalid figure data`,
+		},
+		{
+			name:  "LogRecordOfNotFoundRun",
+			runID: "not-existing-id",
+			request: request.LogRunFiguresRequest{
+				Records: []request.FigureRecord{{Name: "roc", Data: json.RawMessage(`{}`)}},
+			},
+			error: `unable to find run "not-existing-id"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/figures/log-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
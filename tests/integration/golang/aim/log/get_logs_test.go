@@ -0,0 +1,166 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetLogsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestGetLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetLogsTestSuite))
+}
+
+func (s *GetLogsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	s.Require().Nil(s.LogFixtures.CreateLogs(context.Background(), []database.Log{
+		{RunID: s.run.ID, Stream: database.LogStreamStdout, Timestamp: 1, Data: "line 0"},
+		{RunID: s.run.ID, Stream: database.LogStreamStderr, Timestamp: 2, Data: "line 1"},
+		{RunID: s.run.ID, Stream: database.LogStreamStdout, Timestamp: 3, Data: "line 2"},
+		{RunID: s.run.ID, Stream: database.LogStreamStdout, Timestamp: 4, Data: "line 3"},
+	}))
+	s.Require().Nil(s.LogFixtures.CreateLogRecords(context.Background(), []database.LogRecord{
+		{RunID: s.run.ID, Level: 20, Message: "started", Timestamp: 1500},
+		{RunID: s.run.ID, Level: 40, Message: "failed", Timestamp: 2500, Args: []byte(`{"step":2}`)},
+	}))
+}
+
+func (s *GetLogsTestSuite) Test_Ok() {
+	tests := []struct {
+		name        string
+		recordRange string
+		expected    map[string]any
+	}{
+		{
+			name: "GetAllLogs",
+			expected: map[string]any{
+				"0": "line 0",
+				"1": "line 1",
+				"2": "line 2",
+				"3": "line 3",
+			},
+		},
+		{
+			name:        "GetLogsRange",
+			recordRange: "1:3",
+			expected: map[string]any{
+				"1": "line 1",
+				"2": "line 2",
+			},
+		},
+		{
+			name:        "GetLogsFromStart",
+			recordRange: "2:",
+			expected: map[string]any{
+				"2": "line 2",
+				"3": "line 3",
+			},
+		},
+		{
+			name:        "GetLogsOutOfRange",
+			recordRange: "10:20",
+			expected:    map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithQuery(
+					map[any]any{"record_range": tt.recordRange},
+				).WithResponse(
+					resp,
+				).DoRequest(
+					"/runs/%s/logs", s.run.ID,
+				),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+			s.Equal(tt.expected, decodedData)
+		})
+	}
+}
+
+func (s *GetLogsTestSuite) Test_LogRecords() {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			map[any]any{"record_range": "1:"},
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/runs/%s/log-records", s.run.ID,
+		),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	s.Equal(map[string]any{
+		"log_records_count": int64(2),
+		"1.message":         "failed",
+		"1.log_level":       int64(40),
+		"1.timestamp":       2.5,
+		"1.args.step":       float64(2),
+	}, decodedData)
+}
+
+func (s *GetLogsTestSuite) Test_RunInfo() {
+	var resp response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"sequence": "logs"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/info", s.run.ID,
+		),
+	)
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "logs", Context: map[string]any{}},
+	}, resp.Traces.Logs)
+}
+
+func (s *GetLogsTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"record_range": "1"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/logs", s.run.ID,
+		),
+	)
+	s.Equal(`record_range: invalid range "1"`, resp.Message)
+
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/logs", "not-existing-id",
+		),
+	)
+	s.Equal(`unable to find run "not-existing-id"`, resp.Message)
+}
@@ -0,0 +1,148 @@
+package log
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogLogsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestLogLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogLogsTestSuite))
+}
+
+func (s *LogLogsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *LogLogsTestSuite) Test_Ok() {
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunLogsRequest{
+				Lines: []request.LogLine{
+					{Data: "epoch 1"},
+					{Stream: "stderr", Timestamp: 1700000000000, Data: "warning: slow step"},
+					{Stream: "stdout", Data: "epoch 2"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/logs/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	lines, err := s.LogFixtures.GetLogs(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(3, len(lines))
+	s.Equal(database.LogStreamStdout, lines[0].Stream)
+	s.Equal("epoch 1", lines[0].Data)
+	s.NotZero(lines[0].Timestamp)
+	s.Equal(database.LogStreamStderr, lines[1].Stream)
+	s.Equal(int64(1700000000000), lines[1].Timestamp)
+	s.Equal("warning: slow step", lines[1].Data)
+	s.Equal("epoch 2", lines[2].Data)
+
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunLogRecordsRequest{
+				Records: []request.LogRecord{
+					{Level: "warning", Message: "loss is nan", Args: map[string]any{"step": 10}},
+					{Message: "checkpoint saved"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/log-records/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	records, err := s.LogFixtures.GetLogRecords(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(records))
+	s.Equal(30, records[0].Level)
+	s.Equal("loss is nan", records[0].Message)
+	s.JSONEq(`{"step":10}`, string(records[0].Args))
+	s.Equal(20, records[1].Level)
+	s.Equal("checkpoint saved", records[1].Message)
+	s.NotZero(records[1].Timestamp)
+}
+
+func (s *LogLogsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		runID   string
+		path    string
+		request any
+		error   string
+	}{
+		{
+			name:  "LogLineWithInvalidStream",
+			runID: s.run.ID,
+			path:  "logs",
+			request: request.LogRunLogsRequest{
+				Lines: []request.LogLine{{Stream: "stdin", Data: "epoch 1"}},
+			},
+			error: `invalid log stream "stdin"`,
+		},
+		{
+			name:  "LogLineOfNotFoundRun",
+			runID: "not-existing-id",
+			path:  "logs",
+			request: request.LogRunLogsRequest{
+				Lines: []request.LogLine{{Data: "epoch 1"}},
+			},
+			error: `unable to find run "not-existing-id"`,
+		},
+		{
+			name:  "LogRecordWithInvalidLevel",
+			runID: s.run.ID,
+			path:  "log-records",
+			request: request.LogRunLogRecordsRequest{
+				Records: []request.LogRecord{{Level: "VERBOSE", Message: "loss is nan"}},
+			},
+			error: `invalid log level "VERBOSE"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/%s/log-batch", tt.runID, tt.path,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
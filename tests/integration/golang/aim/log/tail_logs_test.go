@@ -0,0 +1,141 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TailLogsTestSuite struct {
+	helpers.BaseTestSuite
+	run   *models.Run
+	lines []database.Log
+}
+
+func TestTailLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(TailLogsTestSuite))
+}
+
+func (s *TailLogsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	s.Require().Nil(s.LogFixtures.CreateLogs(context.Background(), []database.Log{
+		{RunID: s.run.ID, Stream: database.LogStreamStdout, Timestamp: 1000, Data: "line 0"},
+		{RunID: s.run.ID, Stream: database.LogStreamStderr, Timestamp: 2000, Data: "line 1"},
+	}))
+	s.lines, err = s.LogFixtures.GetLogs(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+}
+
+func (s *TailLogsTestSuite) Test_FinishedRun() {
+	s.finishRun()
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/runs/%s/logs/tail", s.run.ID,
+		),
+	)
+	s.Equal(
+		fmt.Sprintf(
+			"id: %d\nevent: log\ndata: {\"data\":\"line 0\",\"stream\":\"stdout\",\"timestamp\":1}\n\n"+
+				"id: %d\nevent: log\ndata: {\"data\":\"line 1\",\"stream\":\"stderr\",\"timestamp\":2}\n\n"+
+				"event: end\ndata: {}\n\n",
+			s.lines[0].ID, s.lines[1].ID,
+		),
+		resp.String(),
+	)
+}
+
+func (s *TailLogsTestSuite) Test_LastEventID() {
+	s.finishRun()
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithHeaders(
+			map[string]string{"Last-Event-ID": fmt.Sprintf("%d", s.lines[0].ID)},
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/runs/%s/logs/tail", s.run.ID,
+		),
+	)
+	s.Equal(
+		fmt.Sprintf(
+			"id: %d\nevent: log\ndata: {\"data\":\"line 1\",\"stream\":\"stderr\",\"timestamp\":2}\n\n"+
+				"event: end\ndata: {}\n\n",
+			s.lines[1].ID,
+		),
+		resp.String(),
+	)
+}
+
+func (s *TailLogsTestSuite) Test_RunningRun() {
+	// the line logged while the run is running is streamed before the run is finished.
+	errs := make(chan error, 1)
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		if err := s.LogFixtures.CreateLogs(context.Background(), []database.Log{
+			{RunID: s.run.ID, Stream: database.LogStreamStdout, Timestamp: 3000, Data: "line 2"},
+		}); err != nil {
+			errs <- err
+			return
+		}
+		time.Sleep(2 * time.Second)
+		s.run.Status = models.StatusFinished
+		s.run.EndTime = sql.NullInt64{Int64: time.Now().UnixMilli(), Valid: true}
+		errs <- s.RunFixtures.UpdateRun(context.Background(), s.run)
+	}()
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/runs/%s/logs/tail", s.run.ID,
+		),
+	)
+	s.Require().Nil(<-errs)
+
+	s.Contains(resp.String(), "data: {\"data\":\"line 1\",\"stream\":\"stderr\",\"timestamp\":2}\n\n")
+	s.Contains(resp.String(), "event: log\ndata: {\"data\":\"line 2\",\"stream\":\"stdout\",\"timestamp\":3}\n\n")
+	s.Contains(resp.String(), ": keep-alive\n\n")
+	s.Contains(resp.String(), "event: end\ndata: {}\n\n")
+}
+
+func (s *TailLogsTestSuite) Test_Error() {
+	resp := new(bytes.Buffer)
+	client := s.AIMClient().WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	)
+	s.Require().Nil(client.DoRequest("/runs/%s/logs/tail", "not-existing-id"))
+	s.Equal(404, client.GetStatusCode())
+}
+
+func (s *TailLogsTestSuite) finishRun() {
+	s.run.Status = models.StatusFinished
+	s.Require().Nil(s.RunFixtures.UpdateRun(context.Background(), s.run))
+}
@@ -0,0 +1,324 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type AggregateMetricsTestSuite struct {
+	helpers.BaseTestSuite
+	runs []*models.Run
+}
+
+func TestAggregateMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(AggregateMetricsTestSuite))
+}
+
+func (s *AggregateMetricsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	train, err := s.ContextFixtures.CreateContext(context.Background(), &models.Context{
+		Json: []byte(`{"subset":"train"}`),
+	})
+	s.Require().Nil(err)
+	val, err := s.ContextFixtures.CreateContext(context.Background(), &models.Context{
+		Json: []byte(`{"subset":"val"}`),
+	})
+	s.Require().Nil(err)
+
+	s.runs = nil
+	for i, lr := range []string{"0.1", "0.1", "0.2"} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("id%d", i),
+			Name:           fmt.Sprintf("TestRun_%d", i),
+			Status:         models.StatusFinished,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "lr",
+			Value: lr,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+
+		// the `train` values of the run are i+step, the `val` values are 10 times bigger.
+		for j, c := range []*models.Context{train, val} {
+			for step := int64(0); step < 3; step++ {
+				value := float64(int64(i) + step)
+				if j == 1 {
+					value *= 10
+				}
+				_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+					Key:       "loss",
+					Value:     value,
+					Timestamp: int64(j + 1),
+					Step:      step,
+					RunID:     run.ID,
+					Iter:      step,
+					ContextID: &c.ID,
+				})
+				s.Require().Nil(err)
+			}
+		}
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "loss",
+			Value:     float64((i + 2) * 10),
+			Timestamp: 2,
+			Step:      2,
+			RunID:     run.ID,
+			LastIter:  2,
+			ContextID: &val.ID,
+		})
+		s.Require().Nil(err)
+		s.runs = append(s.runs, run)
+	}
+}
+
+func (s *AggregateMetricsTestSuite) Test_Ok() {
+	tests := []struct {
+		name     string
+		request  request.AggregateMetricsRequest
+		expected map[string]any
+	}{
+		{
+			name: "GroupByParamAndContext",
+			request: request.AggregateMetricsRequest{
+				Query:        `metric.name == "loss"`,
+				GroupBy:      []string{"run.hparams.lr", "metric.context.subset"},
+				Aggregations: []string{"mean", "max"},
+			},
+			expected: map[string]any{
+				"0.name":                        "loss",
+				"0.group.run.hparams.lr":        "0.1",
+				"0.group.metric.context.subset": "train",
+				"0.runs.0":                      s.runs[0].ID,
+				"0.runs.1":                      s.runs[1].ID,
+				"0.steps":                       []float64{0, 1, 2},
+				"0.counts":                      []float64{2, 2, 2},
+				"0.values.mean":                 []float64{0.5, 1.5, 2.5},
+				"0.values.max":                  []float64{1, 2, 3},
+				"1.name":                        "loss",
+				"1.group.run.hparams.lr":        "0.1",
+				"1.group.metric.context.subset": "val",
+				"1.runs.0":                      s.runs[0].ID,
+				"1.runs.1":                      s.runs[1].ID,
+				"1.steps":                       []float64{0, 1, 2},
+				"1.counts":                      []float64{2, 2, 2},
+				"1.values.mean":                 []float64{5, 15, 25},
+				"1.values.max":                  []float64{10, 20, 30},
+				"2.name":                        "loss",
+				"2.group.run.hparams.lr":        "0.2",
+				"2.group.metric.context.subset": "train",
+				"2.runs.0":                      s.runs[2].ID,
+				"2.steps":                       []float64{0, 1, 2},
+				"2.counts":                      []float64{1, 1, 1},
+				"2.values.mean":                 []float64{2, 3, 4},
+				"2.values.max":                  []float64{2, 3, 4},
+				"3.name":                        "loss",
+				"3.group.run.hparams.lr":        "0.2",
+				"3.group.metric.context.subset": "val",
+				"3.runs.0":                      s.runs[2].ID,
+				"3.steps":                       []float64{0, 1, 2},
+				"3.counts":                      []float64{1, 1, 1},
+				"3.values.mean":                 []float64{20, 30, 40},
+				"3.values.max":                  []float64{20, 30, 40},
+			},
+		},
+		{
+			name: "GroupByContextWithSampledSteps",
+			request: request.AggregateMetricsRequest{
+				Query:        `metric.name == "loss"`,
+				Steps:        2,
+				GroupBy:      []string{"metric.context.subset"},
+				Aggregations: []string{"median"},
+			},
+			expected: map[string]any{
+				"0.name":                        "loss",
+				"0.group.metric.context.subset": "train",
+				"0.runs.0":                      s.runs[0].ID,
+				"0.runs.1":                      s.runs[1].ID,
+				"0.runs.2":                      s.runs[2].ID,
+				"0.steps":                       []float64{0, 1},
+				"0.counts":                      []float64{3, 3},
+				"0.values.median":               []float64{1, 2},
+				"1.name":                        "loss",
+				"1.group.metric.context.subset": "val",
+				"1.runs.0":                      s.runs[0].ID,
+				"1.runs.1":                      s.runs[1].ID,
+				"1.runs.2":                      s.runs[2].ID,
+				"1.steps":                       []float64{0, 1},
+				"1.counts":                      []float64{3, 3},
+				"1.values.median":               []float64{10, 20},
+			},
+		},
+		{
+			name: "MergeContexts",
+			request: request.AggregateMetricsRequest{
+				Query:        `metric.name == "loss" and run.lr == "0.2"`,
+				Aggregations: []string{"min", "p50"},
+			},
+			expected: map[string]any{
+				"0.name":       "loss",
+				"0.group":      "<OBJECT>",
+				"0.runs.0":     s.runs[2].ID,
+				"0.steps":      []float64{0, 1, 2},
+				"0.counts":     []float64{2, 2, 2},
+				"0.values.min": []float64{2, 3, 4},
+				"0.values.p50": []float64{11, 16.5, 22},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithQuery(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest(
+					"/runs/search/metric/aggregate",
+				),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+			s.Equal(tt.expected, decodeAggregations(decodedData))
+		})
+	}
+}
+
+func (s *AggregateMetricsTestSuite) Test_SampledSteps() {
+	ctx, err := s.ContextFixtures.CreateContext(context.Background(), &models.Context{
+		Json: []byte(`{}`),
+	})
+	s.Require().Nil(err)
+
+	// the values of the first run are equal to the step, the values of the second one are 3 times bigger.
+	for i, run := range s.runs[:2] {
+		for step := int64(0); step < 10; step++ {
+			_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+				Key:       "accuracy",
+				Value:     float64(step * int64(2*i+1)),
+				Timestamp: 1,
+				Step:      step,
+				RunID:     run.ID,
+				Iter:      step,
+				ContextID: &ctx.ID,
+			})
+			s.Require().Nil(err)
+		}
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "accuracy",
+			Value:     float64(9 * (2*i + 1)),
+			Timestamp: 1,
+			Step:      9,
+			RunID:     run.ID,
+			LastIter:  9,
+			ContextID: &ctx.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			request.AggregateMetricsRequest{
+				Query:        `metric.name == "accuracy"`,
+				Steps:        3,
+				Aggregations: []string{"mean"},
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/runs/search/metric/aggregate",
+		),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	s.Equal(map[string]any{
+		"0.name":        "accuracy",
+		"0.group":       "<OBJECT>",
+		"0.runs.0":      s.runs[0].ID,
+		"0.runs.1":      s.runs[1].ID,
+		"0.steps":       []float64{0, 3, 6},
+		"0.counts":      []float64{2, 2, 2},
+		"0.values.mean": []float64{0, 6, 12},
+	}, decodeAggregations(decodedData))
+}
+
+func (s *AggregateMetricsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request map[any]any
+		error   string
+	}{
+		{
+			name:    "InvalidAggregation",
+			request: map[any]any{"q": `metric.name == "loss"`, "aggregation": "sum"},
+			error:   `invalid aggregation "sum"`,
+		},
+		{
+			name:    "InvalidGroupByField",
+			request: map[any]any{"q": `metric.name == "loss"`, "group_by": "run.name"},
+			error:   `invalid group_by field "run.name"`,
+		},
+		{
+			name:    "NoMetricsSelected",
+			request: map[any]any{"q": `run.name == "TestRun_0"`},
+			error:   "No metrics are selected",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/aggregate",
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
+
+// decodeAggregations replaces the numpy arrays of the decoded response by their values
+// and drops the markers of the nested arrays and objects.
+func decodeAggregations(data map[string]any) map[string]any {
+	result := make(map[string]any, len(data))
+	for key, value := range data {
+		switch {
+		case strings.HasSuffix(key, ".blob"):
+			result[strings.TrimSuffix(key, ".blob")] = value
+		case strings.HasSuffix(key, ".type"), strings.HasSuffix(key, ".dtype"), strings.HasSuffix(key, ".shape"),
+			value == "<ARRAY>":
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
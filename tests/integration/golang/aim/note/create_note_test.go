@@ -0,0 +1,123 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateNoteTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateNoteTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateNoteTestSuite))
+}
+
+func (s *CreateNoteTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "CreateRunNote",
+			path: "/runs/" + run.ID + "/note",
+		},
+		{
+			name: "CreateExperimentNote",
+			path: fmt.Sprintf("/experiments/%d/note", *s.DefaultExperiment.ID),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.CreateNote
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.CreateNoteRequest{Content: "note content"},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.path,
+				),
+			)
+			s.NotEmpty(resp.ID)
+			s.NotEmpty(resp.CreatedAt)
+
+			note, err := s.NoteFixtures.GetNote(context.Background(), resp.ID)
+			s.Require().Nil(err)
+			s.Equal("note content", note.Content)
+			s.False(note.IsArchived)
+
+			revisions, err := s.NoteFixtures.GetNoteRevisions(context.Background(), resp.ID)
+			s.Require().Nil(err)
+			s.Require().Equal(1, len(revisions))
+			s.Equal(database.NoteActionCreate, revisions[0].Action)
+			s.Equal("note content", revisions[0].Content)
+		})
+	}
+}
+
+func (s *CreateNoteTestSuite) Test_Error() {
+	// experiments of the other namespaces are not visible.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace-1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "namespace-1-experiment",
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    namespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "CreateRunNoteWithNotFoundRun",
+			path: "/runs/not-existing-id/note",
+		},
+		{
+			name: "CreateExperimentNoteWithNotFoundExperiment",
+			path: "/experiments/999999/note",
+		},
+		{
+			name: "CreateExperimentNoteWithExperimentOfOtherNamespace",
+			path: fmt.Sprintf("/experiments/%d/note", *experiment.ID),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.CreateNoteRequest{Content: "note content"},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.path,
+				),
+			)
+			s.Contains(resp.Message, "unable to find")
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteNoteTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteNoteTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteNoteTestSuite))
+}
+
+func (s *DeleteNoteTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	runNote, err := s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Content: "run note",
+		RunID:   common.GetPointer(run.ID),
+	})
+	s.Require().Nil(err)
+	experimentNote, err := s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Content:      "experiment note",
+		ExperimentID: s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name string
+		path string
+		note *database.Note
+	}{
+		{
+			name: "DeleteRunNote",
+			path: fmt.Sprintf("/runs/%s/note/%s", run.ID, runNote.ID),
+			note: runNote,
+		},
+		{
+			name: "DeleteExperimentNote",
+			path: fmt.Sprintf("/experiments/%d/note/%s", *s.DefaultExperiment.ID, experimentNote.ID),
+			note: experimentNote,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp map[string]any
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodDelete,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.path,
+				),
+			)
+			s.Equal(map[string]any{"status": "OK"}, resp)
+
+			note, err := s.NoteFixtures.GetNote(context.Background(), tt.note.ID)
+			s.Require().Nil(err)
+			s.True(note.IsArchived)
+
+			revisions, err := s.NoteFixtures.GetNoteRevisions(context.Background(), tt.note.ID)
+			s.Require().Nil(err)
+			s.Require().Equal(1, len(revisions))
+			s.Equal(database.NoteActionDelete, revisions[0].Action)
+
+			var errResp response.Error
+			s.Require().Nil(s.AIMClient().WithResponse(&errResp).DoRequest(tt.path))
+			s.Contains(errResp.Message, "unable to find note")
+		})
+	}
+}
+
+func (s *DeleteNoteTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/note/%s", run.ID, uuid.New(),
+		),
+	)
+	s.Contains(resp.Message, "unable to find note")
+}
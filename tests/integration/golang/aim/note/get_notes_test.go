@@ -0,0 +1,126 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetNotesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetNotesTestSuite(t *testing.T) {
+	suite.Run(t, new(GetNotesTestSuite))
+}
+
+func (s *GetNotesTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	runNote, err := s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Content: "run note",
+		RunID:   common.GetPointer(run.ID),
+	})
+	s.Require().Nil(err)
+	_, err = s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Base:    database.Base{IsArchived: true},
+		Content: "archived run note",
+		RunID:   common.GetPointer(run.ID),
+	})
+	s.Require().Nil(err)
+	experimentNote, err := s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Content:      "experiment note",
+		ExperimentID: s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name         string
+		notesPath    string
+		notePath     string
+		expectedNote *database.Note
+	}{
+		{
+			name:         "GetRunNotes",
+			notesPath:    "/runs/" + run.ID + "/note",
+			notePath:     fmt.Sprintf("/runs/%s/note/%s", run.ID, runNote.ID),
+			expectedNote: runNote,
+		},
+		{
+			name:         "GetExperimentNotes",
+			notesPath:    fmt.Sprintf("/experiments/%d/note", *s.DefaultExperiment.ID),
+			notePath:     fmt.Sprintf("/experiments/%d/note/%s", *s.DefaultExperiment.ID, experimentNote.ID),
+			expectedNote: experimentNote,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var notesResp []response.Note
+			s.Require().Nil(s.AIMClient().WithResponse(&notesResp).DoRequest(tt.notesPath))
+			s.Require().Equal(1, len(notesResp))
+			s.Equal(tt.expectedNote.ID, notesResp[0].ID)
+			s.Equal(tt.expectedNote.Content, notesResp[0].Content)
+
+			var noteResp response.Note
+			s.Require().Nil(s.AIMClient().WithResponse(&noteResp).DoRequest(tt.notePath))
+			s.Equal(tt.expectedNote.ID, noteResp.ID)
+			s.Equal(tt.expectedNote.Content, noteResp.Content)
+			s.False(noteResp.CreatedAt.IsZero())
+			s.False(noteResp.UpdatedAt.IsZero())
+		})
+	}
+}
+
+func (s *GetNotesTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	experimentNote, err := s.NoteFixtures.CreateNote(context.Background(), &database.Note{
+		Content:      "experiment note",
+		ExperimentID: s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name  string
+		path  string
+		error string
+	}{
+		{
+			name:  "GetNotesWithNotFoundRun",
+			path:  "/runs/not-existing-id/note",
+			error: "unable to find run",
+		},
+		{
+			name:  "GetNotesWithNotFoundExperiment",
+			path:  "/experiments/999999/note",
+			error: "unable to find experiment",
+		},
+		{
+			name:  "GetNoteWithNotFoundID",
+			path:  fmt.Sprintf("/runs/%s/note/%s", run.ID, uuid.New()),
+			error: "unable to find note",
+		},
+		{
+			name:  "GetExperimentNoteFromRun",
+			path:  fmt.Sprintf("/runs/%s/note/%s", run.ID, experimentNote.ID),
+			error: "unable to find note",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest(tt.path))
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
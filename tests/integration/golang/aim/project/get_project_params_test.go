@@ -101,5 +101,42 @@ func (s *GetProjectParamsTestSuite) Test_Ok() {
 	s.Equal(map[string]interface{}{"tags": map[string]interface{}{}}, resp.Params)
 }
 
+func (s *GetProjectParamsTestSuite) Test_ParamTypes() {
+	runs, err := s.RunFixtures.CreateExampleRuns(context.Background(), s.DefaultExperiment, 2)
+	s.Require().Nil(err)
+	for i, values := range []map[string]string{
+		{"lr": "0.01", "epochs": "10", "use_bn": "True", "layers": "[64, 32]", "optimizer": "adam"},
+		{"lr": "0.1", "epochs": "12.5", "use_bn": "false", "layers": `{"hidden": 64}`, "optimizer": "1"},
+	} {
+		for key, value := range values {
+			_, err := s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+				Key:   key,
+				Value: value,
+				RunID: runs[i].ID,
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	resp := response.ProjectParamsResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"exclude_params": false, "sequence": "metric"},
+		).WithResponse(
+			&resp,
+		).DoRequest("/projects/params"),
+	)
+	for key, expected := range map[string]string{
+		"lr":        "<class 'float'>",
+		"epochs":    "<class 'float'>",
+		"use_bn":    "<class 'bool'>",
+		"layers":    "<class 'dict'>",
+		"optimizer": "<class 'str'>",
+		"key1":      "<class 'str'>",
+	} {
+		s.Equal(map[string]interface{}{"__example_type__": expected}, resp.Params[key], key)
+	}
+}
+
 func (s *GetProjectParamsTestSuite) Test_Error() {
 }
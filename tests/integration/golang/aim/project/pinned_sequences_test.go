@@ -0,0 +1,156 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PinnedSequencesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestPinnedSequencesTestSuite(t *testing.T) {
+	suite.Run(t, new(PinnedSequencesTestSuite))
+}
+
+func (s *PinnedSequencesTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace-1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+
+	// no sequences are pinned initially.
+	var resp response.ProjectPinnedSequencesResponse
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/projects/pinned-sequences"))
+	s.Equal([]response.ProjectPinnedSequence{}, resp.Sequences)
+
+	tests := []struct {
+		name     string
+		request  request.UpdateProjectPinnedSequencesRequest
+		expected []response.ProjectPinnedSequence
+	}{
+		{
+			name: "PinSequences",
+			request: request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.ProjectPinnedSequence{
+					{Name: "loss", Context: map[string]any{"subset": "train"}},
+					{Name: "accuracy"},
+				},
+			},
+			expected: []response.ProjectPinnedSequence{
+				{Name: "loss", Context: map[string]any{"subset": "train"}},
+				{Name: "accuracy", Context: map[string]any{}},
+			},
+		},
+		{
+			name: "ReplacePinnedSequences",
+			request: request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.ProjectPinnedSequence{
+					{Name: "accuracy", Context: map[string]any{}},
+				},
+			},
+			expected: []response.ProjectPinnedSequence{
+				{Name: "accuracy", Context: map[string]any{}},
+			},
+		},
+		{
+			name:     "UnpinAllSequences",
+			request:  request.UpdateProjectPinnedSequencesRequest{},
+			expected: []response.ProjectPinnedSequence{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var updateResp response.ProjectPinnedSequencesResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&updateResp,
+				).DoRequest(
+					"/projects/pinned-sequences",
+				),
+			)
+			s.Equal(tt.expected, updateResp.Sequences)
+
+			var resp response.ProjectPinnedSequencesResponse
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/projects/pinned-sequences"))
+			s.Equal(tt.expected, resp.Sequences)
+		})
+	}
+
+	// pinned sequences are stored per namespace.
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.ProjectPinnedSequence{{Name: "loss", Context: map[string]any{}}},
+			},
+		).DoRequest(
+			"/projects/pinned-sequences",
+		),
+	)
+	s.Require().Nil(
+		s.AIMClient().WithNamespace(
+			namespace.Code,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/projects/pinned-sequences",
+		),
+	)
+	s.Equal([]response.ProjectPinnedSequence{}, resp.Sequences)
+}
+
+func (s *PinnedSequencesTestSuite) Test_Error() {
+	tests := []struct {
+		name        string
+		requestBody any
+		error       string
+	}{
+		{
+			name: "PinSequenceWithoutName",
+			requestBody: request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.ProjectPinnedSequence{{Context: map[string]any{}}},
+			},
+			error: "sequence name is required",
+		},
+		{
+			name: "PinSequencesWithIncorrectJson",
+			requestBody: map[string]any{
+				"sequences": "loss",
+			},
+			error: "cannot unmarshal",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/projects/pinned-sequences",
+				),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type AddRunTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestAddRunTagTestSuite(t *testing.T) {
+	suite.Run(t, new(AddRunTagTestSuite))
+}
+
+func (s *AddRunTagTestSuite) Test_Ok() {
+	_, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "existing",
+		Color:       "#3E72E7",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		tagName string
+	}{
+		{
+			name:    "AddExistingTag",
+			tagName: "existing",
+		},
+		{
+			name:    "AddNewTag",
+			tagName: "new",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+			s.Require().Nil(err)
+
+			var resp response.AddRunTag
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.AddRunTagRequest{TagName: tt.tagName},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/tags/new", run.ID,
+				),
+			)
+			s.Equal(run.ID, resp.ID)
+			s.Equal("OK", resp.Status)
+
+			runTags, err := s.SharedTagFixtures.GetRunSharedTags(context.Background(), run.ID)
+			s.Require().Nil(err)
+			s.Require().Equal(1, len(runTags))
+			s.Equal(resp.TagID, runTags[0].ID)
+			s.Equal(tt.tagName, runTags[0].Name)
+
+			var info response.GetRunInfo
+			s.Require().Nil(s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info", run.ID))
+			s.Equal([]response.RunTag{{
+				ID:    runTags[0].ID,
+				Name:  runTags[0].Name,
+				Color: runTags[0].Color,
+			}}, info.Props.Tags)
+		})
+	}
+}
+
+func (s *AddRunTagTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name        string
+		runID       string
+		requestBody request.AddRunTagRequest
+		error       string
+	}{
+		{
+			name:        "AddTagToNotFoundRun",
+			runID:       "not-found",
+			requestBody: request.AddRunTagRequest{TagName: "tag"},
+			error:       `unable to find run "not-found"`,
+		},
+		{
+			name:        "AddTagWithoutName",
+			runID:       run.ID,
+			requestBody: request.AddRunTagRequest{},
+			error:       "tag name is required",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/tags/new", tt.runID,
+				),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -143,3 +144,58 @@ func (s *ArchiveBatchTestSuite) Test_Error() {
 		})
 	}
 }
+
+func (s *ArchiveBatchTestSuite) Test_Cascade() {
+	// make runs[1] and runs[2] children of runs[0] and runs[3] grandchild of runs[0].
+	for _, tag := range []models.Tag{
+		{RunID: s.runs[1].ID, Key: common.ParentRunIDTagKey, Value: s.runs[0].ID},
+		{RunID: s.runs[2].ID, Key: common.ParentRunIDTagKey, Value: s.runs[0].ID},
+		{RunID: s.runs[3].ID, Key: common.ParentRunIDTagKey, Value: s.runs[1].ID},
+	} {
+		s.Require().Nil(s.RunFixtures.CreateTag(context.Background(), tag))
+	}
+
+	tests := []struct {
+		name                 string
+		archiveParam         string
+		expectedArchiveCount int
+	}{
+		{
+			name:                 "ArchiveParent",
+			archiveParam:         "true",
+			expectedArchiveCount: 4,
+		},
+		{
+			name:                 "RestoreParent",
+			archiveParam:         "false",
+			expectedArchiveCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := map[string]any{}
+			s.Require().Nil(
+				s.AIMClient().WithMethod(http.MethodPost).WithQuery(map[any]any{
+					"archive": tt.archiveParam,
+				}).WithRequest(
+					[]string{s.runs[0].ID},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/archive-batch",
+				),
+			)
+			s.Equal(map[string]interface{}{"status": "OK"}, resp)
+
+			runs, err := s.RunFixtures.GetRuns(context.Background(), s.runs[0].ExperimentID)
+			s.Require().Nil(err)
+			archiveCount := 0
+			for _, run := range runs {
+				if run.LifecycleStage == models.LifecycleStageDeleted {
+					archiveCount++
+				}
+			}
+			s.Equal(tt.expectedArchiveCount, archiveCount)
+		})
+	}
+}
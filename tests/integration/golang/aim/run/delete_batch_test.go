@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -124,3 +125,33 @@ func (s *DeleteBatchTestSuite) Test_Error() {
 		})
 	}
 }
+
+func (s *DeleteBatchTestSuite) Test_Cascade() {
+	// make runs[1] and runs[2] children of runs[0] and runs[3] grandchild of runs[0].
+	for _, tag := range []models.Tag{
+		{RunID: s.runs[1].ID, Key: common.ParentRunIDTagKey, Value: s.runs[0].ID},
+		{RunID: s.runs[2].ID, Key: common.ParentRunIDTagKey, Value: s.runs[0].ID},
+		{RunID: s.runs[3].ID, Key: common.ParentRunIDTagKey, Value: s.runs[1].ID},
+	} {
+		s.Require().Nil(s.RunFixtures.CreateTag(context.Background(), tag))
+	}
+
+	resp := fiber.Map{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(http.MethodPost).WithRequest(
+			[]string{s.runs[0].ID},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/delete-batch",
+		),
+	)
+	s.Equal(fiber.Map{"status": "OK"}, resp)
+
+	runs, err := s.RunFixtures.GetRuns(context.Background(), s.runs[0].ExperimentID)
+	s.Require().Nil(err)
+	s.Equal(6, len(runs))
+	for _, run := range runs {
+		s.NotContains([]string{s.runs[0].ID, s.runs[1].ID, s.runs[2].ID, s.runs[3].ID}, run.ID)
+	}
+}
@@ -0,0 +1,104 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteRunTagTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+	tag *database.SharedTag
+}
+
+func TestDeleteRunTagTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteRunTagTestSuite))
+}
+
+func (s *DeleteRunTagTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	s.tag, err = s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "tag",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), s.tag, s.run))
+}
+
+func (s *DeleteRunTagTestSuite) Test_Ok() {
+	var resp response.DeleteRunTag
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/tags/%s", s.run.ID, s.tag.ID,
+		),
+	)
+	s.Equal(response.DeleteRunTag{
+		ID:      s.run.ID,
+		Removed: true,
+		Status:  "OK",
+	}, resp)
+
+	runTags, err := s.SharedTagFixtures.GetRunSharedTags(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Equal(0, len(runTags))
+
+	// the tag itself is kept.
+	tags, err := s.SharedTagFixtures.GetSharedTags(context.Background(), s.DefaultNamespace.ID)
+	s.Require().Nil(err)
+	s.Equal(1, len(tags))
+}
+
+func (s *DeleteRunTagTestSuite) Test_Error() {
+	tests := []struct {
+		name  string
+		runID string
+		tagID uuid.UUID
+		error string
+	}{
+		{
+			name:  "DeleteTagOfNotFoundRun",
+			runID: "not-found",
+			tagID: s.tag.ID,
+			error: `unable to find run "not-found"`,
+		},
+		{
+			name:  "DeleteNotFoundTag",
+			runID: s.run.ID,
+			tagID: uuid.Nil,
+			error: `unable to find tag "00000000-0000-0000-0000-000000000000"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodDelete,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/tags/%s", tt.runID, tt.tagID,
+				),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
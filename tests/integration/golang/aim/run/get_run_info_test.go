@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -60,6 +61,22 @@ func (s *GetRunInfoTestSuite) Test_Ok() {
 	}
 }
 
+func (s *GetRunInfoTestSuite) Test_MetricSummary() {
+	var resp response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithResponse(&resp).DoRequest("/runs/%s/info", s.run.ID),
+	)
+	s.Require().Equal(2, len(resp.Traces.Metric))
+	for _, metric := range resp.Traces.Metric {
+		s.Equal(common.GetPointer(125.1), metric.LastValue)
+		s.Equal(common.GetPointer(124.1), metric.FirstValue)
+		s.Equal(common.GetPointer(124.1), metric.MinValue)
+		s.Equal(common.GetPointer(125.1), metric.MaxValue)
+		s.Equal(int64(2), metric.Count)
+		s.Equal(int64(2), metric.LastStep)
+	}
+}
+
 func (s *GetRunInfoTestSuite) Test_Error() {
 	tests := []struct {
 		name  string
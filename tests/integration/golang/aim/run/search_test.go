@@ -14,6 +14,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -217,6 +218,44 @@ func (s *SearchTestSuite) Test_Ok() {
 		RunID: run4.ID,
 	})
 	s.Require().Nil(err)
+	for run, value := range map[*models.Run]string{run1: "0.001", run3: "0.01"} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "lr",
+			Value: value,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+	}
+	for run, value := range map[*models.Run]string{run1: "adam", run3: "2"} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "optimizer",
+			Value: value,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	// attach Aim tags to the runs.
+	sharedTag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "shared",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), sharedTag, run1))
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), sharedTag, run3))
+	otherTag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "other",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), otherTag, run1))
+	archivedTag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Base:        database.Base{IsArchived: true},
+		Name:        "archived",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), archivedTag, run3))
 
 	runs := []*models.Run{run1, run2, run3, run4}
 
@@ -644,6 +683,87 @@ func (s *SearchTestSuite) Test_Ok() {
 				run3,
 			},
 		},
+		{
+			name: "SearchMetricArithmeticOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.metrics['TestMetric'].last * 2 - 1 > 3`,
+			},
+
+			runs: []*models.Run{
+				run3,
+			},
+		},
+		{
+			name: "SearchMetricArithmeticAbsFunction",
+			request: request.SearchRunsRequest{
+				Query: `abs(run.metrics['TestMetric'].last - 3) < 0.5`,
+			},
+
+			runs: []*models.Run{
+				run3,
+			},
+		},
+		{
+			name: "SearchMetricArithmeticModuloOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.metrics['TestMetric'].last % 3 < 1`,
+			},
+
+			runs: []*models.Run{
+				run3,
+			},
+		},
+		{
+			name: "SearchParamNumericOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.lr < 0.005`,
+			},
+
+			runs: []*models.Run{
+				run1,
+			},
+		},
+		{
+			name: "SearchParamArithmeticOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.lr * 100 >= 1`,
+			},
+
+			runs: []*models.Run{
+				run3,
+			},
+		},
+		{
+			name: "SearchParamNumericNotEqualOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.optimizer != 2`,
+			},
+
+			runs: []*models.Run{
+				run1,
+			},
+		},
+		{
+			name: "SearchParamNumericNotInOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.optimizer not in [1, 3]`,
+			},
+
+			runs: []*models.Run{
+				run1,
+				run3,
+			},
+		},
+		{
+			name: "SearchParamStringOperation",
+			request: request.SearchRunsRequest{
+				Query: `run.lr == '0.001'`,
+			},
+
+			runs: []*models.Run{
+				run1,
+			},
+		},
 		{
 			name: "SearchMetricLastStepOperationEqual",
 			request: request.SearchRunsRequest{
@@ -747,6 +867,32 @@ func (s *SearchTestSuite) Test_Ok() {
 				run3,
 			},
 		},
+		{
+			name: "SearchSharedTag",
+			request: request.SearchRunsRequest{
+				Query: `'shared' in run.tags`,
+			},
+			runs: []*models.Run{
+				run1,
+				run3,
+			},
+		},
+		{
+			name: "SearchWithoutSharedTag",
+			request: request.SearchRunsRequest{
+				Query: `'shared' in run.tags and 'other' not in run.tags`,
+			},
+			runs: []*models.Run{
+				run3,
+			},
+		},
+		{
+			name: "SearchArchivedSharedTag",
+			request: request.SearchRunsRequest{
+				Query: `'archived' in run.tags`,
+			},
+			runs: []*models.Run{},
+		},
 		{
 			name: "SearchComplexQuery",
 			request: request.SearchRunsRequest{
@@ -820,3 +966,79 @@ func (s *SearchTestSuite) Test_Ok() {
 		})
 	}
 }
+
+func (s *SearchTestSuite) Test_ArithmeticEdgeCases() {
+	// create test runs with the last values of the metric being zero, negative and positive.
+	var runs []*models.Run
+	for i, value := range []float64{0, -1, 2} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("id%d", i),
+			Name:           fmt.Sprintf("TestRun%d", i),
+			Status:         models.StatusRunning,
+			RowNum:         models.RowNum(i),
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "TestMetric",
+			Value:     value,
+			Timestamp: 1234567890,
+			Step:      1,
+			RunID:     run.ID,
+			LastIter:  1,
+		})
+		s.Require().Nil(err)
+		runs = append(runs, run)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		runs  []*models.Run
+	}{
+		{
+			name:  "DivisionByZero",
+			query: `1 / run.metrics['TestMetric'].last > 0`,
+			runs:  []*models.Run{runs[2]},
+		},
+		{
+			name:  "ModuloByZero",
+			query: `run.metrics['TestMetric'].last % run.metrics['TestMetric'].last == 0`,
+			runs:  []*models.Run{runs[1], runs[2]},
+		},
+		{
+			name:  "ModuloOfNegativeNumber",
+			query: `run.metrics['TestMetric'].last % 3 == 2`,
+			runs:  []*models.Run{runs[1], runs[2]},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithQuery(
+					request.SearchRunsRequest{Query: tt.query},
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/run"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range runs {
+				respNameKey := fmt.Sprintf("%v.props.name", run.ID)
+				if slices.Contains(tt.runs, run) {
+					s.Equal(run.Name, decodedData[respNameKey])
+				} else {
+					s.Nil(decodedData[respNameKey])
+				}
+			}
+		})
+	}
+}
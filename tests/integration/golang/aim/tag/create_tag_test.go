@@ -0,0 +1,113 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateTagTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTagTestSuite))
+}
+
+func (s *CreateTagTestSuite) Test_Ok() {
+	tests := []struct {
+		name        string
+		requestBody request.CreateTagRequest
+	}{
+		{
+			name: "CreateValidTag",
+			requestBody: request.CreateTagRequest{
+				Name:        "tag",
+				Color:       "#3E72E7",
+				Description: "tag description",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.CreateTag
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags",
+				),
+			)
+			s.NotEmpty(resp.ID)
+			s.Equal("OK", resp.Status)
+
+			tags, err := s.SharedTagFixtures.GetSharedTags(context.Background(), s.DefaultNamespace.ID)
+			s.Require().Nil(err)
+			s.Require().Equal(1, len(tags))
+			s.Equal(resp.ID, tags[0].ID)
+			s.Equal(tt.requestBody.Name, tags[0].Name)
+			s.Equal(tt.requestBody.Color, tags[0].Color)
+			s.Equal(tt.requestBody.Description, tags[0].Description)
+		})
+	}
+}
+
+func (s *CreateTagTestSuite) Test_Error() {
+	_, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "existing",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name        string
+		requestBody any
+		error       string
+	}{
+		{
+			name:        "CreateTagWithoutName",
+			requestBody: request.CreateTagRequest{Color: "#3E72E7"},
+			error:       "tag name is required",
+		},
+		{
+			name:        "CreateTagWithExistingName",
+			requestBody: request.CreateTagRequest{Name: "existing"},
+			error:       `tag "existing" already exists`,
+		},
+		{
+			name: "CreateTagWithIncorrectJson",
+			requestBody: map[string]any{
+				"name": 1,
+			},
+			error: "cannot unmarshal",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags",
+				),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteTagTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteTagTestSuite))
+}
+
+func (s *DeleteTagTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	tag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "tag",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), tag, run))
+
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).DoRequest(
+			"/tags/%s", tag.ID,
+		),
+	)
+
+	tags, err := s.SharedTagFixtures.GetSharedTags(context.Background(), s.DefaultNamespace.ID)
+	s.Require().Nil(err)
+	s.Equal(0, len(tags))
+
+	runTags, err := s.SharedTagFixtures.GetRunSharedTags(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(0, len(runTags))
+}
+
+func (s *DeleteTagTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags/%s", uuid.New(),
+		),
+	)
+	s.Equal("Not Found", resp.Message)
+}
@@ -0,0 +1,76 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTagTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTagTestSuite))
+}
+
+func (s *GetTagTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	tag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "tag",
+		Color:       "#3E72E7",
+		Description: "tag description",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), tag, run))
+
+	var resp response.GetTag
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/tags/%s", tag.ID))
+	s.Equal(response.GetTag{
+		ID:          tag.ID,
+		Name:        "tag",
+		Color:       "#3E72E7",
+		Description: "tag description",
+		RunCount:    1,
+	}, resp)
+
+	var runsResp response.GetTagRuns
+	s.Require().Nil(s.AIMClient().WithResponse(&runsResp).DoRequest("/tags/%s/runs", tag.ID))
+	s.Equal(tag.ID, runsResp.ID)
+	s.Require().Equal(1, len(runsResp.Runs))
+	s.Equal(run.ID, runsResp.Runs[0].ID)
+	s.Equal(run.Name, runsResp.Runs[0].Name)
+	s.Equal(s.DefaultExperiment.Name, runsResp.Runs[0].Experiment.Name)
+}
+
+func (s *GetTagTestSuite) Test_Error() {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "GetTagWithNotFoundID",
+			path: "/tags/%s",
+		},
+		{
+			name: "GetTagRunsWithNotFoundID",
+			path: "/tags/%s/runs",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest(tt.path, uuid.New()))
+			s.Equal("Not Found", resp.Message)
+		})
+	}
+}
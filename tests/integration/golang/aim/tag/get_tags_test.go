@@ -0,0 +1,86 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTagsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTagsTestSuite))
+}
+
+func (s *GetTagsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	first, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Name:        "first",
+		Color:       "#3E72E7",
+		Description: "first tag",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.AddSharedTagToRun(context.Background(), first, run))
+
+	second, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+		Base:        database.Base{IsArchived: true},
+		Name:        "second",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	firstTag := response.GetTag{
+		ID:          first.ID,
+		Name:        "first",
+		Color:       "#3E72E7",
+		Description: "first tag",
+		RunCount:    1,
+	}
+	secondTag := response.GetTag{
+		ID:       second.ID,
+		Name:     "second",
+		Archived: true,
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		query    map[any]any
+		expected response.GetTags
+	}{
+		{
+			name:     "GetTags",
+			path:     "/tags",
+			expected: response.GetTags{firstTag, secondTag},
+		},
+		{
+			name:     "SearchTags",
+			path:     "/tags/search",
+			query:    map[any]any{"q": "SEC"},
+			expected: response.GetTags{secondTag},
+		},
+		{
+			name:     "SearchTagsWithoutMatches",
+			path:     "/tags/search",
+			query:    map[any]any{"q": "third"},
+			expected: response.GetTags{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.GetTags
+			s.Require().Nil(s.AIMClient().WithQuery(tt.query).WithResponse(&resp).DoRequest(tt.path))
+			s.Equal(tt.expected, resp)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateTagTestSuite(t *testing.T) {
+	suite.Run(t, &UpdateTagTestSuite{
+		helpers.BaseTestSuite{
+			ResetOnSubTest: true,
+		},
+	})
+}
+
+func (s *UpdateTagTestSuite) Test_Ok() {
+	tests := []struct {
+		name        string
+		requestBody request.UpdateTagRequest
+		expected    database.SharedTag
+	}{
+		{
+			name: "UpdateAllFields",
+			requestBody: request.UpdateTagRequest{
+				Name:        common.GetPointer("new-name"),
+				Color:       common.GetPointer("#000000"),
+				Description: common.GetPointer("new description"),
+			},
+			expected: database.SharedTag{
+				Name:        "new-name",
+				Color:       "#000000",
+				Description: "new description",
+			},
+		},
+		{
+			name: "ArchiveTag",
+			requestBody: request.UpdateTagRequest{
+				Archived: common.GetPointer(true),
+			},
+			expected: database.SharedTag{
+				Base:        database.Base{IsArchived: true},
+				Name:        "tag",
+				Color:       "#3E72E7",
+				Description: "tag description",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+				Name:        "tag",
+				Color:       "#3E72E7",
+				Description: "tag description",
+				NamespaceID: s.DefaultNamespace.ID,
+			})
+			s.Require().Nil(err)
+
+			var resp response.CreateTag
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPut,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags/%s", tag.ID,
+				),
+			)
+			s.Equal(tag.ID, resp.ID)
+			s.Equal("OK", resp.Status)
+
+			tags, err := s.SharedTagFixtures.GetSharedTags(context.Background(), s.DefaultNamespace.ID)
+			s.Require().Nil(err)
+			s.Require().Equal(1, len(tags))
+			s.Equal(tt.expected.Name, tags[0].Name)
+			s.Equal(tt.expected.Color, tags[0].Color)
+			s.Equal(tt.expected.Description, tags[0].Description)
+			s.Equal(tt.expected.IsArchived, tags[0].IsArchived)
+		})
+	}
+}
+
+func (s *UpdateTagTestSuite) Test_Error() {
+	tests := []struct {
+		name        string
+		idParam     func(tag *database.SharedTag) uuid.UUID
+		requestBody request.UpdateTagRequest
+		error       string
+	}{
+		{
+			name: "UpdateTagWithNotFoundID",
+			idParam: func(*database.SharedTag) uuid.UUID {
+				return uuid.New()
+			},
+			requestBody: request.UpdateTagRequest{Name: common.GetPointer("new-name")},
+			error:       "Not Found",
+		},
+		{
+			name: "UpdateTagWithExistingName",
+			idParam: func(tag *database.SharedTag) uuid.UUID {
+				return tag.ID
+			},
+			requestBody: request.UpdateTagRequest{Name: common.GetPointer("existing")},
+			error:       `tag "existing" already exists`,
+		},
+		{
+			name: "UpdateTagWithEmptyName",
+			idParam: func(tag *database.SharedTag) uuid.UUID {
+				return tag.ID
+			},
+			requestBody: request.UpdateTagRequest{Name: common.GetPointer("")},
+			error:       "tag name is required",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tag, err := s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+				Name:        "tag",
+				NamespaceID: s.DefaultNamespace.ID,
+			})
+			s.Require().Nil(err)
+			_, err = s.SharedTagFixtures.CreateSharedTag(context.Background(), &database.SharedTag{
+				Name:        "existing",
+				NamespaceID: s.DefaultNamespace.ID,
+			})
+			s.Require().Nil(err)
+
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPut,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags/%s", tt.idParam(tag),
+				),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package text
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogTextsTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestLogTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogTextsTestSuite))
+}
+
+func (s *LogTextsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *LogTextsTestSuite) Test_Ok() {
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunTextsRequest{
+				Records: []request.TextRecord{
+					{
+						Name:    "generations",
+						Context: map[string]any{"subset": "val"},
+						Step:    1,
+						Index:   0,
+						Data:    "first generation",
+					},
+					{
+						Name:    "generations",
+						Context: map[string]any{"subset": "val"},
+						Step:    1,
+						Index:   1,
+						Data:    "second generation",
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/texts/log-batch", s.run.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "OK"}, resp)
+
+	texts, err := s.TextFixtures.GetTexts(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(texts))
+	s.Equal("generations", texts[0].Name)
+	s.Equal(int64(1), texts[0].Step)
+	s.Equal(int64(0), texts[0].Index)
+	s.Equal("first generation", texts[0].Data)
+	s.NotZero(texts[0].Timestamp)
+	s.JSONEq(`{"subset":"val"}`, string(texts[0].Context.Json))
+	s.Equal(int64(1), texts[1].Index)
+	s.Equal("second generation", texts[1].Data)
+
+	// logging the text for the same step and index once again replaces it.
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunTextsRequest{
+				Records: []request.TextRecord{
+					{
+						Name:    "generations",
+						Context: map[string]any{"subset": "val"},
+						Step:    1,
+						Index:   0,
+						Data:    "updated generation",
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/texts/log-batch", s.run.ID,
+		),
+	)
+
+	texts, err = s.TextFixtures.GetTexts(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(texts))
+	s.Equal("updated generation", texts[0].Data)
+}
+
+func (s *LogTextsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		runID   string
+		request request.LogRunTextsRequest
+		error   string
+	}{
+		{
+			name:  "LogRecordWithoutName",
+			runID: s.run.ID,
+			request: request.LogRunTextsRequest{
+				Records: []request.TextRecord{{Data: "generation"}},
+			},
+			error: "record name is required",
+		},
+		{
+			name:  "LogRecordOfNotFoundRun",
+			runID: "not-existing-id",
+			request: request.LogRunTextsRequest{
+				Records: []request.TextRecord{{Name: "generations", Data: "generation"}},
+			},
+			error: `unable to find run "not-existing-id"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/texts/log-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
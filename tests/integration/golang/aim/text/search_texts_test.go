@@ -0,0 +1,145 @@
+package text
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTextsTestSuite struct {
+	helpers.BaseTestSuite
+	run1 *models.Run
+	run2 *models.Run
+}
+
+func TestSearchTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTextsTestSuite))
+}
+
+func (s *SearchTextsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run1, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	s.run2, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	for _, run := range []*models.Run{s.run1, s.run2} {
+		var records []request.TextRecord
+		for step := int64(0); step < 3; step++ {
+			for index := int64(0); index < 2; index++ {
+				data := fmt.Sprintf("generation %d.%d", step, index)
+				if run == s.run2 && step == 1 && index == 1 {
+					data = "generation failed with error"
+				}
+				records = append(records, request.TextRecord{
+					Name:    "generations",
+					Context: map[string]any{"subset": "val"},
+					Step:    step,
+					Index:   index,
+					Data:    data,
+				})
+			}
+		}
+		s.Require().Nil(
+			s.AIMClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.LogRunTextsRequest{Records: records},
+			).DoRequest(
+				"/runs/%s/texts/log-batch", run.ID,
+			),
+		)
+	}
+}
+
+func (s *SearchTextsTestSuite) Test_Ok() {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchSequencesRequest{
+				Query: `texts.name == "generations" and texts.context.subset == "val"`,
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/texts"),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	for _, run := range []*models.Run{s.run1, s.run2} {
+		prefix := fmt.Sprintf("%s.traces.0", run.ID)
+		s.Equal(run.Name, decodedData[run.ID+".props.name"])
+		s.Equal("generations", decodedData[prefix+".name"])
+		s.Equal("val", decodedData[prefix+".context.subset"])
+		s.Equal(int64(2), decodedData[prefix+".iters.2"])
+		s.Equal("generation 0.0", decodedData[prefix+".values.0.0.data"])
+		s.Equal(int64(1), decodedData[prefix+".values.2.1.index"])
+		s.Equal(int64(3), decodedData[run.ID+".ranges.record_range_total.1"])
+	}
+}
+
+func (s *SearchTextsTestSuite) Test_SearchByContent() {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchSequencesRequest{
+				Query: `re.search("error", texts.data)`,
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/texts"),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	s.Nil(decodedData[s.run1.ID+".props.name"])
+
+	prefix := fmt.Sprintf("%s.traces.0", s.run2.ID)
+	s.Equal("generations", decodedData[prefix+".name"])
+	s.Equal(int64(1), decodedData[prefix+".iters.0"])
+	s.Nil(decodedData[prefix+".iters.1"])
+	s.Equal("generation failed with error", decodedData[prefix+".values.0.0.data"])
+	s.Equal(int64(1), decodedData[prefix+".values.0.0.index"])
+	s.Nil(decodedData[prefix+".values.0.1.data"])
+}
+
+func (s *SearchTextsTestSuite) Test_GetRunInfo() {
+	var resp response.GetRunInfo
+	s.Require().Nil(
+		s.AIMClient().WithResponse(&resp).DoRequest("/runs/%s/info", s.run1.ID),
+	)
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "generations", Context: map[string]any{"subset": "val"}},
+	}, resp.Traces.Texts)
+	s.Empty(resp.Traces.Figures)
+
+	var params map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"sequence": "texts"},
+		).WithResponse(
+			&params,
+		).DoRequest("/projects/params"),
+	)
+	s.Equal(map[string]any{
+		"generations": []any{map[string]any{"subset": "val"}},
+	}, params["texts"])
+}
@@ -203,6 +203,30 @@ func (s *ImportTestSuite) Test_Ok() {
 	}
 }
 
+func (s *ImportTestSuite) Test_Contexts() {
+	s.inputBackend, s.outputBackend = "sqlite", "sqlite"
+	s.Run("sqlite->sqlite", func() {
+		// the same context id is already used by another context in dest DB.
+		s.Require().Nil(s.outputDB.Create(&models.Context{Json: []byte(`{"subset":"validation"}`)}).Error)
+		inputContext := models.Context{Json: []byte(`{"subset":"training"}`)}
+		s.Require().Nil(s.inputDB.Create(&inputContext).Error)
+		s.Require().Nil(s.inputDB.Model(&models.Metric{}).Where("1 = 1").Update("context_id", inputContext.ID).Error)
+
+		importer := database.NewImporter(s.inputDB, s.outputDB)
+		s.Require().Nil(importer.Import())
+
+		// imported metrics reference the context with the same JSON.
+		var contexts []models.Context
+		s.Require().Nil(s.outputDB.Model(
+			&models.Context{},
+		).Where(
+			"id IN (?)", s.outputDB.Model(&models.Metric{}).Select("context_id"),
+		).Find(&contexts).Error)
+		s.Require().Len(contexts, 1)
+		s.JSONEq(`{"subset":"training"}`, string(contexts[0].Json))
+	})
+}
+
 // validateRowCounts will make assertions about the db based on the test setup.
 // a db imported from the test setup db should also pass these
 // assertions.
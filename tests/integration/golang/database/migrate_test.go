@@ -22,17 +22,52 @@ func TestMigrateTestSuite(t *testing.T) {
 }
 
 func (s *MigrateTestSuite) TestMigrate() {
+	modelRegistryData := `
+		INSERT INTO registered_models VALUES('model', 1698026614085, 1698026614086, 'description');
+		INSERT INTO registered_model_tags VALUES('model-tag', 'model-value', 'model');
+		INSERT INTO model_versions VALUES(
+			'model', 1, 1698026614085, 1698026614086, 'version', 'user', 'Production',
+			'source', 'run', 'READY', 'message', 'link'
+		);
+		INSERT INTO model_version_tags VALUES('version-tag', 'version-value', 'model', 1);
+	`
+	datasetData := `
+		INSERT INTO runs VALUES(
+			'run', 'run', 'LOCAL', '', '', 'user', 'FINISHED', 1698026614085, 1698026614086, '', 'active', '', 0, NULL
+		);
+		INSERT INTO datasets VALUES(
+			'4a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 0, 'dataset', 'digest', 'local', 'source', 'schema', 'profile'
+		);
+		INSERT INTO inputs VALUES('5a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'DATASET', '4a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'RUN', 'run');
+		INSERT INTO inputs VALUES('6a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'DATASET', '4a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'RUN', 'gone');
+		INSERT INTO input_tags VALUES('5a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'context', 'train');
+		INSERT INTO input_tags VALUES('6a5b1b7c8d9e4f0a9b1c2d3e4f5a6b7c', 'context', 'test');
+		INSERT INTO params VALUES('int', '10', 'run');
+		INSERT INTO params VALUES('float', '1.5', 'run');
+		INSERT INTO params VALUES('bool', 'True', 'run');
+		INSERT INTO params VALUES('str', 'value', 'run');
+	`
 	tests := []struct {
-		name   string
-		schema string
+		name     string
+		schema   string
+		data     string
+		aliases  int
+		datasets int
+		params   int
 	}{
 		{
 			name:   "MigrateFromMLFlow2.8.0",
 			schema: "mlflow-7f2a7d5fae7d-v2.8.0.sql",
+			data: modelRegistryData + datasetData +
+				`INSERT INTO registered_model_aliases VALUES('champion', 1, 'model');`,
+			aliases:  1,
+			datasets: 1,
+			params:   4,
 		},
 		{
 			name:   "MigrateFromMLFlow1.16.0",
 			schema: "mlflow-c48cb773bb87-v1.16.0.sql",
+			data:   modelRegistryData,
 		},
 	}
 	for _, tt := range tests {
@@ -48,6 +83,8 @@ func (s *MigrateTestSuite) TestMigrate() {
 
 			_, err = mlflowDB.Exec(string(mlflowSql))
 			s.Require().Nil(err)
+			_, err = mlflowDB.Exec(tt.data)
+			s.Require().Nil(err)
 			s.Require().Nil(mlflowDB.Close())
 
 			// make DbProvider using our package
@@ -60,6 +97,71 @@ func (s *MigrateTestSuite) TestMigrate() {
 
 			// run migrations
 			s.Require().Nil(database.CheckAndMigrateDB(true, db.GormDB()))
+
+			// check that MLFlow model registry has been moved into the default namespace.
+			var count int64
+			s.Require().Nil(db.GormDB().Raw(`
+				SELECT COUNT(*) FROM registered_models rm
+				JOIN namespaces n ON n.id = rm.namespace_id AND n.code = 'default'
+				JOIN registered_model_tags rmt ON rmt.registered_model_id = rm.id
+				JOIN model_versions mv ON mv.registered_model_id = rm.id
+				JOIN model_version_tags mvt ON mvt.model_version_id = mv.id
+				WHERE rm.name = 'model' AND rm.description = 'description' AND rm.last_updated_time = 1698026614086
+				AND rmt.key = 'model-tag' AND rmt.value = 'model-value'
+				AND mv.version = 1 AND mv.current_stage = 'Production' AND mv.run_id = 'run' AND mv.run_link = 'link'
+				AND mvt.key = 'version-tag' AND mvt.value = 'version-value'
+			`).Scan(&count).Error)
+			s.Equal(int64(1), count)
+			s.Require().Nil(db.GormDB().Raw(`
+				SELECT COUNT(*) FROM registered_model_aliases rma
+				JOIN registered_models rm ON rm.id = rma.registered_model_id
+				WHERE rm.name = 'model' AND rma.alias = 'champion' AND rma.version = 1
+			`).Scan(&count).Error)
+			s.Equal(int64(tt.aliases), count)
+			s.False(db.GormDB().Migrator().HasTable("legacy_registered_models"))
+
+			// check that MLFlow datasets have been moved, inputs of the missing runs are skipped.
+			s.Require().Nil(db.GormDB().Raw(`
+				SELECT COUNT(*) FROM datasets d
+				JOIN inputs i ON i.dataset_id = d.id
+				JOIN input_tags it ON it.input_id = i.id
+				WHERE d.id = '4a5b1b7c-8d9e-4f0a-9b1c-2d3e4f5a6b7c' AND d.experiment_id = 0
+				AND d.name = 'dataset' AND d.digest = 'digest' AND d.source_type = 'local' AND d.source = 'source'
+				AND d.schema = 'schema' AND d.profile = 'profile'
+				AND i.id = '5a5b1b7c-8d9e-4f0a-9b1c-2d3e4f5a6b7c' AND i.run_uuid = 'run'
+				AND it.key = 'context' AND it.value = 'train'
+			`).Scan(&count).Error)
+			s.Equal(int64(tt.datasets), count)
+			s.Require().Nil(db.GormDB().Raw(`SELECT COUNT(*) FROM input_tags`).Scan(&count).Error)
+			s.Equal(int64(tt.datasets), count)
+			s.False(db.GormDB().Migrator().HasTable("legacy_datasets"))
+
+			// check that the types of the already logged params have been inferred.
+			var params []struct {
+				Key          string
+				ValueType    string
+				ValueNumeric *float64
+			}
+			s.Require().Nil(db.GormDB().Raw(
+				`SELECT key, value_type, value_numeric FROM params ORDER BY key`,
+			).Scan(&params).Error)
+			s.Equal(tt.params, len(params))
+			for _, param := range params {
+				switch param.Key {
+				case "int":
+					s.Equal("int", param.ValueType)
+					s.Equal(10.0, *param.ValueNumeric)
+				case "float":
+					s.Equal("float", param.ValueType)
+					s.Equal(1.5, *param.ValueNumeric)
+				case "bool":
+					s.Equal("bool", param.ValueType)
+					s.Equal(1.0, *param.ValueNumeric)
+				case "str":
+					s.Equal("str", param.ValueType)
+					s.Nil(param.ValueNumeric)
+				}
+			}
 		})
 	}
 }
@@ -16,13 +16,31 @@ type baseFixtures struct {
 // TruncateTables cleans database from the old data.
 func (f baseFixtures) TruncateTables() error {
 	for _, table := range []interface{}{
-		database.Dashboard{}, // TODO update to models when available
-		database.App{},       // TODO update to models when available
+		database.Dashboard{},       // TODO update to models when available
+		database.App{},             // TODO update to models when available
+		database.SharedTag{},       // TODO update to models when available
+		database.PinnedSequences{}, // TODO update to models when available
+		database.NoteRevision{},    // TODO update to models when available
+		database.Note{},            // TODO update to models when available
+		database.Blob{},            // TODO update to models when available
+		database.Distribution{},    // TODO update to models when available
+		database.Text{},            // TODO update to models when available
+		database.Figure{},          // TODO update to models when available
+		database.Log{},             // TODO update to models when available
+		database.LogRecord{},       // TODO update to models when available
+		models.ModelVersionTag{},
+		models.ModelVersion{},
+		models.RegisteredModelTag{},
+		models.RegisteredModelAlias{},
+		models.RegisteredModel{},
 		models.Tag{},
 		models.Param{},
 		models.LatestMetric{},
 		models.Metric{},
 		models.Context{},
+		models.InputTag{},
+		models.Input{},
+		models.Dataset{},
 		models.Run{},
 		models.ExperimentTag{},
 		models.Experiment{},
@@ -0,0 +1,39 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// BlobFixtures represents data fixtures object.
+type BlobFixtures struct {
+	baseFixtures
+}
+
+// NewBlobFixtures creates new instance of BlobFixtures.
+func NewBlobFixtures(db *gorm.DB) (*BlobFixtures, error) {
+	return &BlobFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// GetBlobs fetches all image or audio records of the run.
+func (f BlobFixtures) GetBlobs(
+	ctx context.Context, runID string, blobType database.BlobType,
+) ([]database.Blob, error) {
+	var blobs []database.Blob
+	if err := f.db.WithContext(ctx).
+		Preload("Context").
+		Where("run_uuid = ? AND type = ?", runID, blobType).
+		Order("name").
+		Order("step").
+		Order("idx").
+		Find(&blobs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting %s of run '%s'", blobType, runID)
+	}
+	return blobs, nil
+}
@@ -0,0 +1,36 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// DistributionFixtures represents data fixtures object.
+type DistributionFixtures struct {
+	baseFixtures
+}
+
+// NewDistributionFixtures creates new instance of DistributionFixtures.
+func NewDistributionFixtures(db *gorm.DB) (*DistributionFixtures, error) {
+	return &DistributionFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// GetDistributions fetches all distributions of the run.
+func (f DistributionFixtures) GetDistributions(ctx context.Context, runID string) ([]database.Distribution, error) {
+	var distributions []database.Distribution
+	if err := f.db.WithContext(ctx).
+		Preload("Context").
+		Where("run_uuid = ?", runID).
+		Order("key").
+		Order("step").
+		Find(&distributions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting distributions of run '%s'", runID)
+	}
+	return distributions, nil
+}
@@ -0,0 +1,36 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// FigureFixtures represents data fixtures object.
+type FigureFixtures struct {
+	baseFixtures
+}
+
+// NewFigureFixtures creates new instance of FigureFixtures.
+func NewFigureFixtures(db *gorm.DB) (*FigureFixtures, error) {
+	return &FigureFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// GetFigures fetches all figures of the run.
+func (f FigureFixtures) GetFigures(ctx context.Context, runID string) ([]database.Figure, error) {
+	var figures []database.Figure
+	if err := f.db.WithContext(ctx).
+		Preload("Context").
+		Where("run_uuid = ?", runID).
+		Order("name").
+		Order("step").
+		Find(&figures).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting figures of run '%s'", runID)
+	}
+	return figures, nil
+}
@@ -0,0 +1,62 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// LogFixtures represents data fixtures object.
+type LogFixtures struct {
+	baseFixtures
+}
+
+// NewLogFixtures creates new instance of LogFixtures.
+func NewLogFixtures(db *gorm.DB) (*LogFixtures, error) {
+	return &LogFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateLogs creates new log lines.
+func (f LogFixtures) CreateLogs(ctx context.Context, lines []database.Log) error {
+	if err := f.db.WithContext(ctx).Create(&lines).Error; err != nil {
+		return eris.Wrap(err, "error creating log lines")
+	}
+	return nil
+}
+
+// GetLogs fetches all log lines of the run.
+func (f LogFixtures) GetLogs(ctx context.Context, runID string) ([]database.Log, error) {
+	var lines []database.Log
+	if err := f.db.WithContext(ctx).
+		Where("run_uuid = ?", runID).
+		Order("id").
+		Find(&lines).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting log lines of run '%s'", runID)
+	}
+	return lines, nil
+}
+
+// CreateLogRecords creates new log records.
+func (f LogFixtures) CreateLogRecords(ctx context.Context, records []database.LogRecord) error {
+	if err := f.db.WithContext(ctx).Create(&records).Error; err != nil {
+		return eris.Wrap(err, "error creating log records")
+	}
+	return nil
+}
+
+// GetLogRecords fetches all log records of the run.
+func (f LogFixtures) GetLogRecords(ctx context.Context, runID string) ([]database.LogRecord, error) {
+	var records []database.LogRecord
+	if err := f.db.WithContext(ctx).
+		Where("run_uuid = ?", runID).
+		Order("id").
+		Find(&records).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting log records of run '%s'", runID)
+	}
+	return records, nil
+}
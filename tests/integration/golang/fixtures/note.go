@@ -0,0 +1,49 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// NoteFixtures represents data fixtures object.
+type NoteFixtures struct {
+	baseFixtures
+}
+
+// NewNoteFixtures creates new instance of NoteFixtures.
+func NewNoteFixtures(db *gorm.DB) (*NoteFixtures, error) {
+	return &NoteFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateNote creates a new test Note.
+func (f NoteFixtures) CreateNote(ctx context.Context, note *database.Note) (*database.Note, error) {
+	if err := f.db.WithContext(ctx).Create(note).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test note")
+	}
+	return note, nil
+}
+
+// GetNote fetches the note by its id, including archived ones.
+func (f NoteFixtures) GetNote(ctx context.Context, id uuid.UUID) (*database.Note, error) {
+	var note database.Note
+	if err := f.db.WithContext(ctx).Where("id = ?", id).First(&note).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting note by id: %v", id)
+	}
+	return &note, nil
+}
+
+// GetNoteRevisions fetches the history of the note.
+func (f NoteFixtures) GetNoteRevisions(ctx context.Context, id uuid.UUID) ([]database.NoteRevision, error) {
+	var revisions []database.NoteRevision
+	if err := f.db.WithContext(ctx).Where("note_id = ?", id).Order("id").Find(&revisions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting revisions of note: %v", id)
+	}
+	return revisions, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
@@ -21,8 +22,9 @@ func NewParamFixtures(db *gorm.DB) (*ParamFixtures, error) {
 	}, nil
 }
 
-// CreateParam creates new test Param.
+// CreateParam creates new test Param. The type of its value is inferred the same way as for the logged params.
 func (f ParamFixtures) CreateParam(ctx context.Context, param *models.Param) (*models.Param, error) {
+	param.ValueType, param.ValueNumeric = common.InferParamType(param.Value)
 	if err := f.baseFixtures.db.WithContext(ctx).Create(param).Error; err != nil {
 		return nil, eris.Wrap(err, "error creating test param")
 	}
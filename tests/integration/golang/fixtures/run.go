@@ -9,6 +9,7 @@ import (
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 )
@@ -208,13 +209,19 @@ func (f RunFixtures) CreateMetrics(
 			}
 		}
 		if err := f.baseFixtures.db.WithContext(ctx).Create(&models.LatestMetric{
-			Key:       fmt.Sprintf("key%d", i),
-			Value:     123.1 + float64(count),
-			Timestamp: 1234567890 + int64(count),
-			Step:      int64(count),
-			IsNan:     false,
-			RunID:     run.ID,
-			LastIter:  int64(count),
+			Key:            fmt.Sprintf("key%d", i),
+			Value:          123.1 + float64(count),
+			Timestamp:      1234567890 + int64(count),
+			Step:           int64(count),
+			IsNan:          false,
+			RunID:          run.ID,
+			LastIter:       int64(count),
+			MinValue:       common.GetPointer(123.1 + 1),
+			MaxValue:       common.GetPointer(123.1 + float64(count)),
+			FirstValue:     123.1 + 1,
+			FirstStep:      1,
+			FirstTimestamp: 1234567890 + 1,
+			Count:          int64(count),
 		}).Error; err != nil {
 			return err
 		}
@@ -228,9 +235,10 @@ func (f RunFixtures) CreateParams(
 ) error {
 	for i := 1; i <= count; i++ {
 		err := f.baseFixtures.db.WithContext(ctx).Create(&models.Param{
-			Key:   fmt.Sprintf("key%d", i),
-			Value: fmt.Sprintf("val%d", i),
-			RunID: run.ID,
+			Key:       fmt.Sprintf("key%d", i),
+			Value:     fmt.Sprintf("val%d", i),
+			RunID:     run.ID,
+			ValueType: common.ParamTypeString,
 		}).Error
 		if err != nil {
 			return err
@@ -0,0 +1,76 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// SharedTagFixtures represents data fixtures object.
+type SharedTagFixtures struct {
+	baseFixtures
+}
+
+// NewSharedTagFixtures creates new instance of SharedTagFixtures.
+func NewSharedTagFixtures(db *gorm.DB) (*SharedTagFixtures, error) {
+	return &SharedTagFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateSharedTag creates a new test SharedTag.
+func (f SharedTagFixtures) CreateSharedTag(
+	ctx context.Context, tag *database.SharedTag,
+) (*database.SharedTag, error) {
+	if err := f.db.WithContext(ctx).Create(tag).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test shared tag")
+	}
+	return tag, nil
+}
+
+// AddSharedTagToRun attaches existing SharedTag to the run.
+func (f SharedTagFixtures) AddSharedTagToRun(
+	ctx context.Context, tag *database.SharedTag, run *models.Run,
+) error {
+	if err := f.db.WithContext(ctx).
+		Model(&database.Run{ID: run.ID}).
+		Omit("SharedTags.*").
+		Association("SharedTags").
+		Append(tag); err != nil {
+		return eris.Wrap(err, "error adding test shared tag to run")
+	}
+	return nil
+}
+
+// GetSharedTags fetches all shared tags of the namespace.
+func (f SharedTagFixtures) GetSharedTags(
+	ctx context.Context, namespaceID uint,
+) ([]database.SharedTag, error) {
+	var tags []database.SharedTag
+	if err := f.db.WithContext(ctx).
+		Where("namespace_id = ?", namespaceID).
+		Order("name").
+		Find(&tags).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting 'shared_tag' entities")
+	}
+	return tags, nil
+}
+
+// GetRunSharedTags fetches all shared tags attached to the run.
+func (f SharedTagFixtures) GetRunSharedTags(
+	ctx context.Context, runID string,
+) ([]database.SharedTag, error) {
+	var tags []database.SharedTag
+	if err := f.db.WithContext(ctx).
+		Model(&database.Run{ID: runID}).
+		Order("name").
+		Association("SharedTags").
+		Find(&tags); err != nil {
+		return nil, eris.Wrapf(err, "error getting shared tags of run '%s'", runID)
+	}
+	return tags, nil
+}
@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// TextFixtures represents data fixtures object.
+type TextFixtures struct {
+	baseFixtures
+}
+
+// NewTextFixtures creates new instance of TextFixtures.
+func NewTextFixtures(db *gorm.DB) (*TextFixtures, error) {
+	return &TextFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// GetTexts fetches all texts of the run.
+func (f TextFixtures) GetTexts(ctx context.Context, runID string) ([]database.Text, error) {
+	var texts []database.Text
+	if err := f.db.WithContext(ctx).
+		Preload("Context").
+		Where("run_uuid = ?", runID).
+		Order("name").
+		Order("step").
+		Order("idx").
+		Find(&texts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting texts of run '%s'", runID)
+	}
+	return texts, nil
+}
@@ -0,0 +1,127 @@
+package gc
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/gc"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CollectorTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCollectorTestSuite(t *testing.T) {
+	suite.Run(t, new(CollectorTestSuite))
+}
+
+func (s *CollectorTestSuite) Test_Ok() {
+	// the deleted run is the only one, which uses its metric context.
+	deletedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "deleted",
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    s.T().TempDir(),
+		LifecycleStage: models.LifecycleStageDeleted,
+		DeletedTime:    sql.NullInt64{Int64: 1, Valid: true},
+	})
+	s.Require().Nil(err)
+	metricContext, err := s.ContextFixtures.CreateContext(context.Background(), &models.Context{
+		Json: []byte(`{"subset":"deleted"}`),
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.RunFixtures.CreateMetric(context.Background(), &models.Metric{
+		Key:       "loss",
+		Value:     1,
+		Timestamp: 1,
+		RunID:     deletedRun.ID,
+		ContextID: &metricContext.ID,
+	}))
+
+	// the active run uses its contexts only for the distributions and texts.
+	activeRun, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	var resp map[string]any
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunDistributionsRequest{
+				Records: []request.DistributionRecord{
+					{
+						Name:    "weights",
+						Context: map[string]any{"layer": "dense"},
+						Weights: []float64{1, 2, 3},
+						Range:   []float64{-1, 1},
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/distributions/log-batch", activeRun.ID,
+		),
+	)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogRunTextsRequest{
+				Records: []request.TextRecord{
+					{
+						Name:    "samples",
+						Context: map[string]any{"subset": "text"},
+						Data:    "text",
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/texts/log-batch", activeRun.ID,
+		),
+	)
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(&config.ServiceConfig{})
+	s.Require().Nil(err)
+	db := s.DB().GormDB()
+	result, err := gc.NewCollector(
+		&config.ServiceConfig{},
+		repositories.NewRunRepository(db),
+		repositories.NewMetricRepository(db),
+		repositories.NewNamespaceRepository(db),
+		repositories.NewExperimentRepository(db),
+		artifactStorageFactory,
+	).Collect(context.Background(), gc.Options{Retention: time.Hour})
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(result.Runs))
+	s.Equal(deletedRun.ID, result.Runs[0].ID)
+	s.Equal(int64(1), result.Contexts)
+
+	metricContext, err = s.ContextFixtures.GetContextByJSON(context.Background(), `{"subset":"deleted"}`)
+	s.Require().Nil(err)
+	s.Nil(metricContext)
+	for _, json := range []string{`{"layer":"dense"}`, `{"subset":"text"}`} {
+		sequenceContext, err := s.ContextFixtures.GetContextByJSON(context.Background(), json)
+		s.Require().Nil(err)
+		s.NotNil(sequenceContext)
+	}
+	distributions, err := s.DistributionFixtures.GetDistributions(context.Background(), activeRun.ID)
+	s.Require().Nil(err)
+	s.Equal(1, len(distributions))
+}
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/rotisserie/eris"
+)
+
+type AzureTestSuite struct {
+	BaseTestSuite
+	Client         *azblob.Client
+	testContainers []string
+}
+
+// NewAzureTestSuite creates a new instance of AzureTestSuite.
+func NewAzureTestSuite(testContainers ...string) AzureTestSuite {
+	return AzureTestSuite{
+		testContainers: testContainers,
+	}
+}
+
+func (s *AzureTestSuite) SetupSuite() {
+	s.BaseTestSuite.SetupSuite()
+
+	client, err := NewAzureClient(GetAzureConnectionString())
+	s.Require().Nil(err)
+	s.Client = client
+
+	s.AddSetupHook(func() {
+		s.Require().Nil(s.CreateTestContainers())
+	})
+	s.AddTearDownHook(func() {
+		s.Require().Nil(s.DeleteTestContainers())
+	})
+}
+
+// NewAzureClient creates new instance of Azure Blob Storage client.
+func NewAzureClient(connectionString string) (*azblob.Client, error) {
+	client, err := azblob.NewClientFromConnectionString(connectionString, nil)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating Azure client")
+	}
+	return client, nil
+}
+
+// CreateTestContainers creates the test containers.
+func (s *AzureTestSuite) CreateTestContainers() error {
+	for _, container := range s.testContainers {
+		if _, err := s.Client.CreateContainer(context.Background(), container, nil); err != nil {
+			return eris.Wrapf(err, "failed to create container %q", container)
+		}
+	}
+	return nil
+}
+
+// DeleteTestContainers deletes the test containers together with their blobs.
+func (s *AzureTestSuite) DeleteTestContainers() error {
+	for _, container := range s.testContainers {
+		if _, err := s.Client.DeleteContainer(context.Background(), container, nil); err != nil &&
+			!bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			return eris.Wrapf(err, "failed to delete container %q", container)
+		}
+	}
+	return nil
+}
@@ -30,16 +30,17 @@ const (
 
 // HttpClient represents HTTP client.
 type HttpClient struct {
-	server       server.Server
-	basePath     string
-	namespace    string
-	method       string
-	params       any
-	headers      map[string]string
-	request      any
-	response     any
-	responseType ResponseType
-	statusCode   int
+	server          server.Server
+	basePath        string
+	namespace       string
+	method          string
+	params          any
+	headers         map[string]string
+	request         any
+	response        any
+	responseType    ResponseType
+	statusCode      int
+	responseHeaders http.Header
 }
 
 // NewClient creates new preconfigured HTTP client.
@@ -60,6 +61,11 @@ func NewMlflowApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/api/2.0/mlflow")
 }
 
+// NewMlflowArtifactsApiClient creates new HTTP client for the mlflow-artifacts proxy api
+func NewMlflowArtifactsApiClient(server server.Server) *HttpClient {
+	return NewClient(server, "/api/2.0/mlflow-artifacts")
+}
+
 // NewAimApiClient creates new HTTP client for the aim api
 func NewAimApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/aim/api")
@@ -70,6 +76,11 @@ func NewAdminApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/admin")
 }
 
+// NewRootApiClient creates new HTTP client for the endpoints in the root of the server, e.g. `/health`.
+func NewRootApiClient(server server.Server) *HttpClient {
+	return NewClient(server, "")
+}
+
 // WithMethod sets the HTTP method.
 func (c *HttpClient) WithMethod(method string) *HttpClient {
 	c.method = method
@@ -117,12 +128,22 @@ func (c *HttpClient) GetStatusCode() int {
 	return c.statusCode
 }
 
+// GetResponseHeader returns HTTP header of the last response, if available.
+func (c *HttpClient) GetResponseHeader(key string) string {
+	return c.responseHeaders.Get(key)
+}
+
 // DoRequest do actual HTTP request based on provided parameters.
 // nolint:gocyclo
 func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	// 1. check if request object were provided. if provided then marshal it.
+	// raw io.Reader request is sent as it is.
 	var requestBody io.Reader
-	if c.request != nil {
+	switch request := c.request.(type) {
+	case nil:
+	case io.Reader:
+		requestBody = request
+	default:
 		data, err := json.Marshal(c.request)
 		if err != nil {
 			return eris.Wrap(err, "error marshaling request object")
@@ -183,6 +204,7 @@ func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	}
 
 	c.statusCode = resp.StatusCode
+	c.responseHeaders = resp.Header
 
 	// 8. read and check response data.
 	if c.response != nil {
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -23,7 +24,9 @@ type BaseTestSuite struct {
 	tearDownHooks               []func()
 	AIMClient                   func() *HttpClient
 	MlflowClient                func() *HttpClient
+	MlflowArtifactsClient       func() *HttpClient
 	AdminClient                 func() *HttpClient
+	RootClient                  func() *HttpClient
 	AppFixtures                 *fixtures.AppFixtures
 	RunFixtures                 *fixtures.RunFixtures
 	TagFixtures                 *fixtures.TagFixtures
@@ -33,10 +36,23 @@ type BaseTestSuite struct {
 	ProjectFixtures             *fixtures.ProjectFixtures
 	DashboardFixtures           *fixtures.DashboardFixtures
 	ExperimentFixtures          *fixtures.ExperimentFixtures
+	SharedTagFixtures           *fixtures.SharedTagFixtures
+	NoteFixtures                *fixtures.NoteFixtures
+	BlobFixtures                *fixtures.BlobFixtures
+	DistributionFixtures        *fixtures.DistributionFixtures
+	TextFixtures                *fixtures.TextFixtures
+	FigureFixtures              *fixtures.FigureFixtures
+	LogFixtures                 *fixtures.LogFixtures
 	DefaultExperiment           *models.Experiment
 	NamespaceFixtures           *fixtures.NamespaceFixtures
 	DefaultNamespace            *models.Namespace
 	ResetOnSubTest              bool
+	ServeArtifacts              bool
+	PresignedRedirect           bool
+	ArchiveMaxSize              int64
+	CacheArtifacts              bool
+	ArtifactsCacheDir           string
+	ArtifactsDestination        string
 	SkipCreateDefaultNamespace  bool
 	SkipCreateDefaultExperiment bool
 }
@@ -79,6 +95,26 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.AppFixtures = appFixtures
 
+	blobFixtures, err := fixtures.NewBlobFixtures(db)
+	s.Require().Nil(err)
+	s.BlobFixtures = blobFixtures
+
+	distributionFixtures, err := fixtures.NewDistributionFixtures(db)
+	s.Require().Nil(err)
+	s.DistributionFixtures = distributionFixtures
+
+	textFixtures, err := fixtures.NewTextFixtures(db)
+	s.Require().Nil(err)
+	s.TextFixtures = textFixtures
+
+	figureFixtures, err := fixtures.NewFigureFixtures(db)
+	s.Require().Nil(err)
+	s.FigureFixtures = figureFixtures
+
+	logFixtures, err := fixtures.NewLogFixtures(db)
+	s.Require().Nil(err)
+	s.LogFixtures = logFixtures
+
 	dashboardFixtures, err := fixtures.NewDashboardFixtures(db)
 	s.Require().Nil(err)
 	s.DashboardFixtures = dashboardFixtures
@@ -99,6 +135,10 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.NamespaceFixtures = namespaceFixtures
 
+	noteFixtures, err := fixtures.NewNoteFixtures(db)
+	s.Require().Nil(err)
+	s.NoteFixtures = noteFixtures
+
 	projectFixtures, err := fixtures.NewProjectFixtures(db)
 	s.Require().Nil(err)
 	s.ProjectFixtures = projectFixtures
@@ -111,6 +151,10 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.RunFixtures = runFixtures
 
+	sharedTagFixtures, err := fixtures.NewSharedTagFixtures(db)
+	s.Require().Nil(err)
+	s.SharedTagFixtures = sharedTagFixtures
+
 	tagFixtures, err := fixtures.NewTagFixtures(db)
 	s.Require().Nil(err)
 	s.TagFixtures = tagFixtures
@@ -121,8 +165,7 @@ func (s *BaseTestSuite) closeDB() {
 }
 
 func (s *BaseTestSuite) startServer() {
-	var err error
-	s.server, err = server.NewServer(context.Background(), &config.ServiceConfig{
+	serviceConfig := &config.ServiceConfig{
 		DatabaseURI:           s.db.Dsn(),
 		DatabasePoolMax:       10,
 		DatabaseSlowThreshold: 1 * time.Second,
@@ -130,7 +173,26 @@ func (s *BaseTestSuite) startServer() {
 		DefaultArtifactRoot:   s.T().TempDir(),
 		S3EndpointURI:         GetS3EndpointUri(),
 		GSEndpointURI:         GetGSEndpointUri(),
-	})
+		AzureConnectionString: GetAzureConnectionString(),
+		ArchiveMaxSize:        s.ArchiveMaxSize,
+	}
+	if s.PresignedRedirect {
+		serviceConfig.PresignedRedirect = true
+		serviceConfig.PresignedURLExpiry = 1 * time.Minute
+	}
+	if s.CacheArtifacts {
+		s.ArtifactsCacheDir = s.T().TempDir()
+		serviceConfig.ArtifactsCacheDir = s.ArtifactsCacheDir
+		serviceConfig.ArtifactsCacheMaxSize = 1024 * 1024
+	}
+	if s.ServeArtifacts {
+		s.ArtifactsDestination = s.T().TempDir()
+		serviceConfig.ServeArtifacts = true
+		serviceConfig.ArtifactsDestination = s.ArtifactsDestination
+	}
+
+	var err error
+	s.server, err = server.NewServer(context.Background(), serviceConfig)
 	s.Require().Nil(err)
 
 	s.AIMClient = func() *HttpClient {
@@ -139,9 +201,33 @@ func (s *BaseTestSuite) startServer() {
 	s.MlflowClient = func() *HttpClient {
 		return NewMlflowApiClient(s.server)
 	}
+	s.MlflowArtifactsClient = func() *HttpClient {
+		return NewMlflowArtifactsApiClient(s.server)
+	}
 	s.AdminClient = func() *HttpClient {
 		return NewAdminApiClient(s.server)
 	}
+	s.RootClient = func() *HttpClient {
+		return NewRootApiClient(s.server)
+	}
+}
+
+// ListenServer serves the started server over the real TCP connection and returns its base url,
+// so the behaviour of the network connections, e.g. the read timeouts, can be tested.
+func (s *BaseTestSuite) ListenServer() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().Nil(err)
+	go func() {
+		//nolint:errcheck
+		s.server.Listener(listener)
+	}()
+	return "http://" + listener.Addr().String()
+}
+
+// DB returns the database of the suite, so the components working with the database
+// outside of the server, e.g. the garbage collector, can be tested.
+func (s *BaseTestSuite) DB() database.DBProvider {
+	return s.db
 }
 
 func (s *BaseTestSuite) stopServer() {
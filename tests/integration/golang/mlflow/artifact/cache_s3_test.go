@@ -0,0 +1,104 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CacheS3TestSuite struct {
+	helpers.S3TestSuite
+}
+
+func TestCacheS3TestSuite(t *testing.T) {
+	testSuite := &CacheS3TestSuite{
+		helpers.NewS3TestSuite("bucket1"),
+	}
+	testSuite.CacheArtifacts = true
+	suite.Run(t, testSuite)
+}
+
+func (s *CacheS3TestSuite) Test_Ok() {
+	// 1. create test experiment and run.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Bucket bucket1",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "s3://bucket1/1",
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. upload artifact object to S3.
+	key := fmt.Sprintf("1/%s/artifacts/artifact.file", runID)
+	_, err = s.Client.PutObject(context.Background(), &s3.PutObjectInput{
+		Key:    aws.String(key),
+		Body:   strings.NewReader("content"),
+		Bucket: aws.String("bucket1"),
+	})
+	s.Require().Nil(err)
+
+	// 3. the first download fills the cache and the second one is served from it.
+	s.Equal("content", s.getArtifact(run.ID))
+	s.Equal(storage.CacheStats{Misses: 1, Size: 7, Objects: 1}, s.getCacheStats())
+	s.Equal("content", s.getArtifact(run.ID))
+	s.Equal(storage.CacheStats{Hits: 1, Misses: 1, Size: 7, Objects: 1}, s.getCacheStats())
+
+	// 4. changed object is downloaded from S3 again.
+	_, err = s.Client.PutObject(context.Background(), &s3.PutObjectInput{
+		Key:    aws.String(key),
+		Body:   strings.NewReader("new content"),
+		Bucket: aws.String("bucket1"),
+	})
+	s.Require().Nil(err)
+	s.Equal("new content", s.getArtifact(run.ID))
+	s.Equal(storage.CacheStats{Hits: 1, Misses: 2, Size: 18, Objects: 2}, s.getCacheStats())
+}
+
+// getArtifact downloads `artifact.file` artifact of the run.
+func (s *CacheS3TestSuite) getArtifact(runID string) string {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(s.MlflowClient().WithQuery(
+		request.GetArtifactRequest{
+			RunID: runID,
+			Path:  "artifact.file",
+		},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	).DoRequest(
+		"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+	))
+	return resp.String()
+}
+
+// getCacheStats returns current statistics of the artifact cache.
+func (s *CacheS3TestSuite) getCacheStats() storage.CacheStats {
+	stats := storage.CacheStats{}
+	s.Require().Nil(s.RootClient().WithResponse(&stats).DoRequest("/artifacts-cache/stats"))
+	return stats
+}
@@ -0,0 +1,230 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DownloadArtifactsLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDownloadArtifactsLocalTestSuite(t *testing.T) {
+	suite.Run(t, &DownloadArtifactsLocalTestSuite{
+		helpers.BaseTestSuite{
+			ArchiveMaxSize: 32,
+		},
+	})
+}
+
+// createRunWithArtifacts creates test run with `dir/artifact.file1` and `dir/subdir/artifact.file2` artifacts.
+func (s *DownloadArtifactsLocalTestSuite) createRunWithArtifacts() (*models.Run, string) {
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "dir", "subdir"), fs.ModePerm))
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "empty"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(
+		filepath.Join(runArtifactDir, "dir", "artifact.file1"), []byte("content1"), fs.ModePerm,
+	))
+	s.Require().Nil(os.WriteFile(
+		filepath.Join(runArtifactDir, "dir", "subdir", "artifact.file2"), []byte("content2"), fs.ModePerm,
+	))
+	s.Require().Nil(os.WriteFile(
+		filepath.Join(runArtifactDir, "large.file"), bytes.Repeat([]byte("x"), 32), fs.ModePerm,
+	))
+	return run, runArtifactDir
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Ok() {
+	run, _ := s.createRunWithArtifacts()
+
+	tests := []struct {
+		name                string
+		request             request.DownloadArtifactsRequest
+		expectedContentType string
+		expectedFilename    string
+		expectedFiles       map[string]string
+		readArchive         func(data []byte) map[string]string
+	}{
+		{
+			name: "ZipFormat",
+			request: request.DownloadArtifactsRequest{
+				RunID: run.ID,
+				Path:  "dir",
+			},
+			expectedContentType: "application/zip",
+			expectedFilename:    "dir.zip",
+			expectedFiles: map[string]string{
+				"artifact.file1":        "content1",
+				"subdir/artifact.file2": "content2",
+			},
+			readArchive: s.readZipArchive,
+		},
+		{
+			name: "TarGzFormat",
+			request: request.DownloadArtifactsRequest{
+				RunID:  run.ID,
+				Path:   "dir/subdir",
+				Format: "tar.gz",
+			},
+			expectedContentType: "application/gzip",
+			expectedFilename:    "subdir.tar.gz",
+			expectedFiles: map[string]string{
+				"artifact.file2": "content2",
+			},
+			readArchive: s.readTarGzArchive,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			client := s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute))
+			s.Equal(http.StatusOK, client.GetStatusCode())
+			s.Equal(tt.expectedContentType, client.GetResponseHeader(fiber.HeaderContentType))
+			s.Equal(
+				fmt.Sprintf("attachment; filename=%s", tt.expectedFilename),
+				client.GetResponseHeader(fiber.HeaderContentDisposition),
+			)
+			s.Equal(tt.expectedFiles, tt.readArchive(resp.Bytes()))
+		})
+	}
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Error() {
+	run, runArtifactDir := s.createRunWithArtifacts()
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.DownloadArtifactsRequest{},
+		},
+		{
+			name:  "UnsupportedFormat",
+			error: api.NewInvalidParameterValueError("Parameter 'format' must be one of zip, tar.gz, got 'rar'"),
+			request: request.DownloadArtifactsRequest{
+				RunID:  run.ID,
+				Format: "rar",
+			},
+		},
+		{
+			name: "EmptyDirectoryProvided",
+			error: api.NewResourceDoesNotExistError(
+				"artifact directory for URI: %s/empty is empty or does not exist", runArtifactDir,
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID: run.ID,
+				Path:  "empty",
+			},
+		},
+		{
+			name: "SizeLimitExceeded",
+			error: api.NewInvalidParameterValueError(
+				"total size of artifact directory 48 bytes exceeds the limit of 32 bytes",
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID: run.ID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// readZipArchive returns content of the files in zip archive.
+func (s *DownloadArtifactsLocalTestSuite) readZipArchive(data []byte) map[string]string {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	s.Require().Nil(err)
+	files := map[string]string{}
+	for _, file := range reader.File {
+		content, err := file.Open()
+		s.Require().Nil(err)
+		result, err := io.ReadAll(content)
+		s.Require().Nil(err)
+		files[file.Name] = string(result)
+	}
+	return files
+}
+
+// readTarGzArchive returns content of the files in tar.gz archive.
+func (s *DownloadArtifactsLocalTestSuite) readTarGzArchive(data []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	s.Require().Nil(err)
+	reader := tar.NewReader(gzipReader)
+	files := map[string]string{}
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		s.Require().Nil(err)
+		result, err := io.ReadAll(reader)
+		s.Require().Nil(err)
+		files[header.Name] = string(result)
+	}
+	return files
+}
@@ -0,0 +1,204 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetArtifactAzureTestSuite struct {
+	helpers.AzureTestSuite
+}
+
+func TestGetArtifactAzureTestSuite(t *testing.T) {
+	suite.Run(t, &GetArtifactAzureTestSuite{
+		helpers.NewAzureTestSuite("container1", "container2"),
+	})
+}
+
+func (s *GetArtifactAzureTestSuite) Test_Ok() {
+	tests := []struct {
+		name             string
+		container        string
+		artifactLocation string
+	}{
+		{
+			name:             "TestWasbsWithContainer1",
+			container:        "container1",
+			artifactLocation: "wasbs://container1@devstoreaccount1.blob.core.windows.net/1",
+		},
+		{
+			name:             "TestAzWithContainer2",
+			container:        "container2",
+			artifactLocation: "az://container2/1",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// create test experiment
+			experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             fmt.Sprintf("Test Experiment In Container %s", tt.container),
+				NamespaceID:      s.DefaultNamespace.ID,
+				LifecycleStage:   models.LifecycleStageActive,
+				ArtifactLocation: tt.artifactLocation,
+			})
+			s.Require().Nil(err)
+
+			// create test run
+			runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+			run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+				ID:             runID,
+				Status:         models.StatusRunning,
+				SourceType:     "JOB",
+				ExperimentID:   *experiment.ID,
+				ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+				LifecycleStage: models.LifecycleStageActive,
+			})
+			s.Require().Nil(err)
+
+			// upload artifact root object to Azure
+			_, err = s.Client.UploadBuffer(
+				context.Background(),
+				tt.container,
+				fmt.Sprintf("1/%s/artifacts/artifact.txt", runID),
+				[]byte("content"),
+				nil,
+			)
+			s.Require().Nil(err)
+
+			// upload artifact subdir object to Azure
+			_, err = s.Client.UploadBuffer(
+				context.Background(),
+				tt.container,
+				fmt.Sprintf("1/%s/artifacts/artifact/artifact.txt", runID),
+				[]byte("subdir-object-content"),
+				nil,
+			)
+			s.Require().Nil(err)
+
+			// make API call for root object
+			query := request.GetArtifactRequest{
+				RunID: run.ID,
+				Path:  "artifact.txt",
+			}
+
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				query,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+			))
+			s.Equal("content", resp.String())
+
+			// make API call for subdir object
+			query = request.GetArtifactRequest{
+				RunID: run.ID,
+				Path:  "artifact/artifact.txt",
+			}
+
+			resp = new(bytes.Buffer)
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				query,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+			))
+			s.Equal("subdir-object-content", resp.String())
+		})
+	}
+}
+
+func (s *GetArtifactAzureTestSuite) Test_Error() {
+	// create test experiment
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Container container1",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "az://container1/1",
+	})
+	s.Require().Nil(err)
+
+	// create test run
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	_, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// upload artifact subdir object to Azure
+	_, err = s.Client.UploadBuffer(
+		context.Background(),
+		"container1",
+		fmt.Sprintf("1/%s/artifacts/artifact/artifact.file", runID),
+		[]byte("content"),
+		nil,
+	)
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetArtifactRequest
+	}{
+		{
+			name: "AzureIncompletePath",
+			error: api.NewResourceDoesNotExistError(
+				fmt.Sprintf("error getting artifact object for URI: az:/container1/1/%s/artifacts/artifact", runID),
+			),
+			request: request.GetArtifactRequest{
+				RunID: runID,
+				Path:  "artifact",
+			},
+		},
+		{
+			name: "NonExistentFile",
+			error: api.NewResourceDoesNotExistError(
+				fmt.Sprintf(
+					"error getting artifact object for URI: az:/container1/1/%s/artifacts/non-existent-file", runID,
+				),
+			),
+			request: request.GetArtifactRequest{
+				RunID: runID,
+				Path:  "non-existent-file",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetArtifactRangeLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetArtifactRangeLocalTestSuite(t *testing.T) {
+	suite.Run(t, &GetArtifactRangeLocalTestSuite{
+		helpers.BaseTestSuite{
+			ServeArtifacts: true,
+		},
+	})
+}
+
+func (s *GetArtifactRangeLocalTestSuite) Test_Ok() {
+	// 1. create test experiment and run.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Local Path",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifact.
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "artifact.file"), []byte("0123456789"), fs.ModePerm))
+
+	// 3. make API call without any conditions to get ETag and Last-Modified headers.
+	resp := new(bytes.Buffer)
+	client := s.MlflowClient().WithQuery(
+		request.GetArtifactRequest{
+			RunID: run.ID,
+			Path:  "artifact.file",
+		},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	)
+	s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute))
+	s.Equal(http.StatusOK, client.GetStatusCode())
+	s.Equal("0123456789", resp.String())
+	s.Equal("10", client.GetResponseHeader(fiber.HeaderContentLength))
+	s.Equal("bytes", client.GetResponseHeader(fiber.HeaderAcceptRanges))
+	etag := client.GetResponseHeader(fiber.HeaderETag)
+	s.NotEmpty(etag)
+	lastModified := client.GetResponseHeader(fiber.HeaderLastModified)
+	s.NotEmpty(lastModified)
+	lastModifiedTime, err := http.ParseTime(lastModified)
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name                 string
+		headers              map[string]string
+		expectedStatusCode   int
+		expectedContent      string
+		expectedContentRange string
+	}{
+		{
+			name:                 "Range",
+			headers:              map[string]string{fiber.HeaderRange: "bytes=2-5"},
+			expectedStatusCode:   http.StatusPartialContent,
+			expectedContent:      "2345",
+			expectedContentRange: "bytes 2-5/10",
+		},
+		{
+			name:                 "OpenEndedRange",
+			headers:              map[string]string{fiber.HeaderRange: "bytes=7-"},
+			expectedStatusCode:   http.StatusPartialContent,
+			expectedContent:      "789",
+			expectedContentRange: "bytes 7-9/10",
+		},
+		{
+			name:                 "SuffixRange",
+			headers:              map[string]string{fiber.HeaderRange: "bytes=-3"},
+			expectedStatusCode:   http.StatusPartialContent,
+			expectedContent:      "789",
+			expectedContentRange: "bytes 7-9/10",
+		},
+		{
+			name:                 "RangeExceedsSize",
+			headers:              map[string]string{fiber.HeaderRange: "bytes=8-100"},
+			expectedStatusCode:   http.StatusPartialContent,
+			expectedContent:      "89",
+			expectedContentRange: "bytes 8-9/10",
+		},
+		{
+			name:                 "UnsatisfiableRange",
+			headers:              map[string]string{fiber.HeaderRange: "bytes=10-"},
+			expectedStatusCode:   http.StatusRequestedRangeNotSatisfiable,
+			expectedContent:      http.StatusText(http.StatusRequestedRangeNotSatisfiable),
+			expectedContentRange: "bytes */10",
+		},
+		{
+			name:               "MultipleRangesAreIgnored",
+			headers:            map[string]string{fiber.HeaderRange: "bytes=0-1,3-4"},
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    "0123456789",
+		},
+		{
+			name: "IfRangeMatches",
+			headers: map[string]string{
+				fiber.HeaderRange:   "bytes=0-0",
+				fiber.HeaderIfRange: etag,
+			},
+			expectedStatusCode:   http.StatusPartialContent,
+			expectedContent:      "0",
+			expectedContentRange: "bytes 0-0/10",
+		},
+		{
+			name: "IfRangeDoesNotMatch",
+			headers: map[string]string{
+				fiber.HeaderRange:   "bytes=0-0",
+				fiber.HeaderIfRange: `"outdated"`,
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    "0123456789",
+		},
+		{
+			name:               "IfNoneMatchMatches",
+			headers:            map[string]string{fiber.HeaderIfNoneMatch: `"outdated", ` + etag},
+			expectedStatusCode: http.StatusNotModified,
+		},
+		{
+			name:               "IfNoneMatchDoesNotMatch",
+			headers:            map[string]string{fiber.HeaderIfNoneMatch: `"outdated"`},
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    "0123456789",
+		},
+		{
+			name:               "IfModifiedSinceNotModified",
+			headers:            map[string]string{fiber.HeaderIfModifiedSince: lastModified},
+			expectedStatusCode: http.StatusNotModified,
+		},
+		{
+			name: "IfModifiedSinceModified",
+			headers: map[string]string{
+				fiber.HeaderIfModifiedSince: lastModifiedTime.Add(-time.Hour).Format(http.TimeFormat),
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    "0123456789",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 4. make API call for the artifact with provided headers.
+			resp := new(bytes.Buffer)
+			client := s.MlflowClient().WithQuery(
+				request.GetArtifactRequest{
+					RunID: run.ID,
+					Path:  "artifact.file",
+				},
+			).WithHeaders(
+				tt.headers,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute))
+			s.Equal(tt.expectedStatusCode, client.GetStatusCode())
+			s.Equal(tt.expectedContent, resp.String())
+			s.Equal(tt.expectedContentRange, client.GetResponseHeader(fiber.HeaderContentRange))
+			if tt.expectedStatusCode != http.StatusRequestedRangeNotSatisfiable {
+				s.Equal(etag, client.GetResponseHeader(fiber.HeaderETag))
+			}
+		})
+	}
+}
+
+func (s *GetArtifactRangeLocalTestSuite) Test_ProxiedArtifact_Ok() {
+	// 1. create artifact in the artifacts destination.
+	proxiedArtifactDir := filepath.Join(s.ArtifactsDestination, "0", "run", "artifacts")
+	s.Require().Nil(os.MkdirAll(proxiedArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(proxiedArtifactDir, "artifact.file"), []byte("proxied"), fs.ModePerm))
+
+	// 2. make API call with range header.
+	resp := new(bytes.Buffer)
+	client := s.MlflowArtifactsClient().WithHeaders(
+		map[string]string{fiber.HeaderRange: "bytes=2-"},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	)
+	s.Require().Nil(client.DoRequest("%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.file"))
+	s.Equal(http.StatusPartialContent, client.GetStatusCode())
+	s.Equal("bytes 2-6/7", client.GetResponseHeader(fiber.HeaderContentRange))
+	s.Equal("5", client.GetResponseHeader(fiber.HeaderContentLength))
+	s.Equal("oxied", resp.String())
+}
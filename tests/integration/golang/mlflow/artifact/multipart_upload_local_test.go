@@ -0,0 +1,398 @@
+package artifact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type MultipartUploadLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestMultipartUploadLocalTestSuite(t *testing.T) {
+	suite.Run(t, &MultipartUploadLocalTestSuite{
+		helpers.BaseTestSuite{
+			ServeArtifacts: true,
+		},
+	})
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_Ok() {
+	// 1. create multipart upload.
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{Path: "dir/checkpoint.bin", NumParts: 2},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCreateRoute, "dir/checkpoint.bin",
+		),
+	)
+	s.NotEmpty(createResp.UploadID)
+	s.Equal([]response.MultipartUploadCredentialPartialResponse{
+		{
+			PartNumber: 1,
+			URL: fmt.Sprintf(
+				"http://example.com/api/2.0/mlflow-artifacts/mpu/upload/dir/checkpoint.bin?part_number=1&upload_id=%s",
+				createResp.UploadID,
+			),
+			Headers: map[string]string{},
+		},
+		{
+			PartNumber: 2,
+			URL: fmt.Sprintf(
+				"http://example.com/api/2.0/mlflow-artifacts/mpu/upload/dir/checkpoint.bin?part_number=2&upload_id=%s",
+				createResp.UploadID,
+			),
+			Headers: map[string]string{},
+		},
+	}, createResp.Credentials)
+
+	// 2. upload parts out of order. the first part is uploaded twice, like after client restart.
+	etags := map[int]string{}
+	for _, part := range []struct {
+		number  int
+		content string
+	}{
+		{number: 2, content: "world"},
+		{number: 1, content: "broken"},
+		{number: 1, content: "hello "},
+	} {
+		partResp := response.MultipartUploadPartPartialResponse{}
+		s.Require().Nil(
+			s.MlflowArtifactsClient().WithMethod(
+				http.MethodPut,
+			).WithQuery(
+				request.UploadMultipartUploadPartRequest{UploadID: createResp.UploadID, PartNumber: part.number},
+			).WithRequest(
+				strings.NewReader(part.content),
+			).WithResponse(
+				&partResp,
+			).DoRequest(
+				"%s/%s", mlflow.MultipartUploadUploadRoute, "dir/checkpoint.bin",
+			),
+		)
+		s.Equal(part.number, partResp.PartNumber)
+		s.Equal(int64(len(part.content)), partResp.Size)
+		s.NotEmpty(partResp.ETag)
+		etags[part.number] = partResp.ETag
+	}
+
+	// 3. list uploaded parts.
+	partsResp := response.ListMultipartUploadPartsResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithQuery(
+			request.ListMultipartUploadPartsRequest{UploadID: createResp.UploadID},
+		).WithResponse(
+			&partsResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadPartsRoute, "dir/checkpoint.bin",
+		),
+	)
+	s.Equal([]response.MultipartUploadPartPartialResponse{
+		{PartNumber: 1, ETag: etags[1], Size: 6},
+		{PartNumber: 2, ETag: etags[2], Size: 5},
+	}, partsResp.Parts)
+
+	// 4. staged parts are not visible as artifacts.
+	listResp := response.ListProxiedArtifactsResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithResponse(
+			&listResp,
+		).DoRequest(
+			"%s", mlflow.ProxiedArtifactsRoute,
+		),
+	)
+	s.Empty(listResp.Files)
+
+	// 5. complete multipart upload.
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CompleteMultipartUploadRequest{
+				Path:     "dir/checkpoint.bin",
+				UploadID: createResp.UploadID,
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 2, ETag: etags[2]},
+					{PartNumber: 1, ETag: etags[1]},
+				},
+			},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCompleteRoute, "dir/checkpoint.bin",
+		),
+	)
+	data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, "dir/checkpoint.bin"))
+	s.Require().Nil(err)
+	s.Equal("hello world", string(data))
+
+	// 6. completed upload doesn't exist anymore.
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithQuery(
+			request.ListMultipartUploadPartsRequest{UploadID: createResp.UploadID},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadPartsRoute, "dir/checkpoint.bin",
+		),
+	)
+	s.Equal(
+		api.NewResourceDoesNotExistError(
+			"error listing parts of multipart upload '%s'", createResp.UploadID,
+		).Error(),
+		errResp.Error(),
+	)
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_LargePart() {
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{Path: "checkpoint.bin", NumParts: 1},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCreateRoute, "checkpoint.bin",
+		),
+	)
+
+	// parts larger than the limit of the request body are streamed into the storage.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 2*1024*1024)
+	partResp := response.MultipartUploadPartPartialResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithQuery(
+			request.UploadMultipartUploadPartRequest{UploadID: createResp.UploadID, PartNumber: 1},
+		).WithRequest(
+			bytes.NewReader(content),
+		).WithResponse(
+			&partResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadUploadRoute, "checkpoint.bin",
+		),
+	)
+	s.Equal(int64(len(content)), partResp.Size)
+
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CompleteMultipartUploadRequest{
+				Path:     "checkpoint.bin",
+				UploadID: createResp.UploadID,
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 1, ETag: partResp.ETag},
+				},
+			},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCompleteRoute, "checkpoint.bin",
+		),
+	)
+	data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, "checkpoint.bin"))
+	s.Require().Nil(err)
+	s.Equal(content, data)
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_SlowPart() {
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{Path: "checkpoint.bin", NumParts: 1},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCreateRoute, "checkpoint.bin",
+		),
+	)
+
+	// parts, which arrive longer than the default read timeout, are still uploaded.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf(
+			"%s/api/2.0/mlflow-artifacts%s/%s?upload_id=%s&part_number=1",
+			s.ListenServer(), mlflow.MultipartUploadUploadRoute, "checkpoint.bin", createResp.UploadID,
+		),
+		newSlowReader(content, 8, 7*time.Second),
+	)
+	s.Require().Nil(err)
+	req.ContentLength = int64(len(content))
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().Nil(err)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	partResp := response.MultipartUploadPartPartialResponse{}
+	s.Require().Nil(json.NewDecoder(resp.Body).Decode(&partResp))
+	s.Equal(1, partResp.PartNumber)
+	s.Equal(int64(len(content)), partResp.Size)
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_Abort() {
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{NumParts: 1},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCreateRoute, "aborted.bin",
+		),
+	)
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.AbortMultipartUploadRequest{UploadID: createResp.UploadID},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadAbortRoute, "aborted.bin",
+		),
+	)
+
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithQuery(
+			request.UploadMultipartUploadPartRequest{UploadID: createResp.UploadID, PartNumber: 1},
+		).WithRequest(
+			strings.NewReader("content"),
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadUploadRoute, "aborted.bin",
+		),
+	)
+	s.Equal(
+		api.NewResourceDoesNotExistError(
+			"error uploading part 1 of multipart upload '%s'", createResp.UploadID,
+		).Error(),
+		errResp.Error(),
+	)
+	_, err := os.Stat(filepath.Join(s.ArtifactsDestination, "aborted.bin"))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_Error() {
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{NumParts: 2},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/%s", mlflow.MultipartUploadCreateRoute, "file.bin",
+		),
+	)
+
+	tests := []struct {
+		name    string
+		route   string
+		path    string
+		request any
+		error   *api.ErrorResponse
+	}{
+		{
+			name:    "CreateWithIncorrectNumParts",
+			route:   mlflow.MultipartUploadCreateRoute,
+			path:    "file.bin",
+			request: request.CreateMultipartUploadRequest{NumParts: 0},
+			error:   api.NewInvalidParameterValueError("Parameter 'num_parts' must be between 1 and 10000, got 0"),
+		},
+		{
+			name:    "CreateWithIncorrectPath",
+			route:   mlflow.MultipartUploadCreateRoute,
+			path:    "dir/../../file.bin",
+			request: request.CreateMultipartUploadRequest{NumParts: 1},
+			error:   api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+		},
+		{
+			name:    "CompleteWithoutParts",
+			route:   mlflow.MultipartUploadCompleteRoute,
+			path:    "file.bin",
+			request: request.CompleteMultipartUploadRequest{UploadID: createResp.UploadID},
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'parts'"),
+		},
+		{
+			name:  "CompleteWithNotUploadedPart",
+			route: mlflow.MultipartUploadCompleteRoute,
+			path:  "file.bin",
+			request: request.CompleteMultipartUploadRequest{
+				UploadID: createResp.UploadID,
+				Parts:    []request.MultipartUploadPartPartialRequest{{PartNumber: 1}},
+			},
+			error: api.NewResourceDoesNotExistError(
+				"error completing multipart upload '%s'", createResp.UploadID,
+			),
+		},
+		{
+			name:    "AbortForAnotherPath",
+			route:   mlflow.MultipartUploadAbortRoute,
+			path:    "another.bin",
+			request: request.AbortMultipartUploadRequest{UploadID: createResp.UploadID},
+			error: api.NewResourceDoesNotExistError(
+				"error aborting multipart upload '%s'", createResp.UploadID,
+			),
+		},
+		{
+			name:    "AbortNotExistingUpload",
+			route:   mlflow.MultipartUploadAbortRoute,
+			path:    "file.bin",
+			request: request.AbortMultipartUploadRequest{UploadID: "../../file.bin"},
+			error:   api.NewResourceDoesNotExistError("error aborting multipart upload '../../file.bin'"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowArtifactsClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s", tt.route, tt.path,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
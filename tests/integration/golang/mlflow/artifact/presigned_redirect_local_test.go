@@ -0,0 +1,94 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PresignedRedirectLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestPresignedRedirectLocalTestSuite(t *testing.T) {
+	suite.Run(t, &PresignedRedirectLocalTestSuite{
+		helpers.BaseTestSuite{
+			ServeArtifacts:    true,
+			PresignedRedirect: true,
+		},
+	})
+}
+
+func (s *PresignedRedirectLocalTestSuite) Test_Ok() {
+	// 1. create test experiment and run.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Local Path",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifacts.
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+	proxiedArtifactDir := filepath.Join(s.ArtifactsDestination, "0", "run", "artifacts")
+	s.Require().Nil(os.MkdirAll(proxiedArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(proxiedArtifactDir, "artifact.file"), []byte("proxied"), fs.ModePerm))
+
+	// 3. local storage can't presign urls, so artifact is proxied.
+	resp := new(bytes.Buffer)
+	client := s.MlflowClient().WithQuery(
+		request.GetArtifactRequest{
+			RunID: run.ID,
+			Path:  "artifact.file",
+		},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	)
+	s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute))
+	s.Equal(http.StatusOK, client.GetStatusCode())
+	s.Empty(client.GetResponseHeader(fiber.HeaderLocation))
+	s.Equal("content", resp.String())
+
+	// 4. the same for the proxied artifacts.
+	resp = new(bytes.Buffer)
+	client = s.MlflowArtifactsClient().WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	)
+	s.Require().Nil(client.DoRequest("%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.file"))
+	s.Equal(http.StatusOK, client.GetStatusCode())
+	s.Empty(client.GetResponseHeader(fiber.HeaderLocation))
+	s.Equal("proxied", resp.String())
+}
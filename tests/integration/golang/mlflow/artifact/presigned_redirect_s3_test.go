@@ -0,0 +1,88 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PresignedRedirectS3TestSuite struct {
+	helpers.S3TestSuite
+}
+
+func TestPresignedRedirectS3TestSuite(t *testing.T) {
+	testSuite := &PresignedRedirectS3TestSuite{
+		helpers.NewS3TestSuite("bucket1"),
+	}
+	testSuite.PresignedRedirect = true
+	suite.Run(t, testSuite)
+}
+
+func (s *PresignedRedirectS3TestSuite) Test_Ok() {
+	// 1. create test experiment and run.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Bucket bucket1",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "s3://bucket1/1",
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. upload artifact object to S3.
+	_, err = s.Client.PutObject(context.Background(), &s3.PutObjectInput{
+		Key:    aws.String(fmt.Sprintf("1/%s/artifacts/artifact.file", runID)),
+		Body:   strings.NewReader("content"),
+		Bucket: aws.String("bucket1"),
+	})
+	s.Require().Nil(err)
+
+	// 3. make API call and check that it is redirected to the presigned url.
+	client := s.MlflowClient().WithQuery(request.GetArtifactRequest{
+		RunID: run.ID,
+		Path:  "artifact.file",
+	})
+	s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute))
+	s.Equal(http.StatusFound, client.GetStatusCode())
+
+	location, err := url.Parse(client.GetResponseHeader(fiber.HeaderLocation))
+	s.Require().Nil(err)
+	s.Equal(fmt.Sprintf("/bucket1/1/%s/artifacts/artifact.file", runID), location.Path)
+	s.NotEmpty(location.Query().Get("X-Amz-Signature"))
+	s.Equal("60", location.Query().Get("X-Amz-Expires"))
+
+	// 4. download artifact directly from S3 by the presigned url.
+	resp, err := http.Get(location.String())
+	s.Require().Nil(err)
+	//nolint:errcheck
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+	content, err := io.ReadAll(resp.Body)
+	s.Require().Nil(err)
+	s.Equal("content", string(content))
+}
@@ -0,0 +1,317 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ProxyLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestProxyLocalTestSuite(t *testing.T) {
+	suite.Run(t, &ProxyLocalTestSuite{
+		helpers.BaseTestSuite{
+			ServeArtifacts: true,
+		},
+	})
+}
+
+func (s *ProxyLocalTestSuite) Test_Ok() {
+	// 1. upload artifacts through the proxy.
+	for path, content := range map[string]string{
+		"0/run/artifacts/artifact.file1":              "contentX",
+		"0/run/artifacts/artifact.dir/artifact.file2": "contentXX",
+	} {
+		s.Require().Nil(
+			s.MlflowArtifactsClient().WithMethod(
+				http.MethodPut,
+			).WithRequest(
+				strings.NewReader(content),
+			).WithResponse(
+				&struct{}{},
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, path,
+			),
+		)
+		data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, path))
+		s.Require().Nil(err)
+		s.Equal(content, string(data))
+	}
+
+	// 2. list uploaded artifacts.
+	listResp := response.ListProxiedArtifactsResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithQuery(
+			request.ListProxiedArtifactsRequest{Path: "0/run/artifacts"},
+		).WithResponse(
+			&listResp,
+		).DoRequest(
+			"%s", mlflow.ProxiedArtifactsRoute,
+		),
+	)
+	s.Equal([]response.FilePartialResponse{
+		{Path: "artifact.dir", IsDir: true},
+		{Path: "artifact.file1", FileSize: 8},
+	}, listResp.Files)
+
+	// 3. download uploaded artifact.
+	downloadResp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			downloadResp,
+		).DoRequest(
+			"%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.dir/artifact.file2",
+		),
+	)
+	s.Equal("contentXX", downloadResp.String())
+
+	// 4. runs with `mlflow-artifacts` uri should be resolved into artifacts destination.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    "mlflow-artifacts:/0/run/artifacts",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	getResp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetArtifactRequest{RunID: run.ID, Path: "artifact.file1"},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			getResp,
+		).DoRequest(
+			"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+		),
+	)
+	s.Equal("contentX", getResp.String())
+
+	// 5. delete the whole directory.
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.dir",
+		),
+	)
+	_, err = os.Stat(filepath.Join(s.ArtifactsDestination, "0/run/artifacts/artifact.dir"))
+	s.ErrorIs(err, fs.ErrNotExist)
+}
+
+func (s *ProxyLocalTestSuite) Test_LargeArtifact() {
+	// artifacts larger than the limit of the request body are streamed into the storage.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 2*1024*1024)
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			bytes.NewReader(content),
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.large",
+		),
+	)
+	data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, "0/run/artifacts/artifact.large"))
+	s.Require().Nil(err)
+	s.Equal(content, data)
+
+	// the body of the other requests is still limited.
+	client := s.MlflowClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		bytes.NewReader(content),
+	)
+	s.Require().Nil(client.DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute))
+	s.Equal(http.StatusRequestEntityTooLarge, client.GetStatusCode())
+}
+
+func (s *ProxyLocalTestSuite) Test_SlowUpload() {
+	// artifacts, which arrive longer than the default read timeout, are still uploaded.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf(
+			"%s/api/2.0/mlflow-artifacts%s/%s",
+			s.ListenServer(), mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.slow",
+		),
+		newSlowReader(content, 8, 7*time.Second),
+	)
+	s.Require().Nil(err)
+	req.ContentLength = int64(len(content))
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().Nil(err)
+	s.Require().Nil(resp.Body.Close())
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, "0/run/artifacts/artifact.slow"))
+	s.Require().Nil(err)
+	s.Equal(content, data)
+}
+
+// newSlowReader returns the reader of the content, which is sent in the number of chunks over the duration.
+func newSlowReader(content []byte, chunks int, duration time.Duration) io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		size := (len(content) + chunks - 1) / chunks
+		for chunk := bytes.NewBuffer(content); chunk.Len() > 0; {
+			time.Sleep(duration / time.Duration(chunks))
+			if _, err := writer.Write(chunk.Next(size)); err != nil {
+				return
+			}
+		}
+		//nolint:errcheck,gosec
+		writer.Close()
+	}()
+	return reader
+}
+
+func (s *ProxyLocalTestSuite) Test_RootPath() {
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			strings.NewReader("content"),
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxiedArtifactsRoute, "0/run/artifacts/artifact.file",
+		),
+	)
+
+	// paths resolved to the root of the storage are rejected, so the whole storage can't be deleted or replaced.
+	for _, path := range []string{".", "./", "a/..", "%2E"} {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+			s.Run(fmt.Sprintf("%s %s", method, path), func() {
+				resp := api.ErrorResponse{}
+				client := s.MlflowArtifactsClient().WithMethod(
+					method,
+				).WithRequest(
+					strings.NewReader("content"),
+				).WithResponse(
+					&resp,
+				)
+				s.Require().Nil(client.DoRequest("%s/%s", mlflow.ProxiedArtifactsRoute, path))
+				s.GreaterOrEqual(client.GetStatusCode(), http.StatusBadRequest)
+				s.Equal(api.ErrorCode(api.ErrorCodeInvalidParameterValue), resp.ErrorCode)
+			})
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, "0/run/artifacts/artifact.file"))
+	s.Require().Nil(err)
+	s.Equal("content", string(data))
+}
+
+func (s *ProxyLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		error  *api.ErrorResponse
+	}{
+		{
+			name:   "DownloadNotExistingArtifact",
+			method: http.MethodGet,
+			path:   "not/existing/file",
+			error:  api.NewResourceDoesNotExistError("error getting artifact object for path: not/existing/file"),
+		},
+		{
+			name:   "DeleteNotExistingArtifact",
+			method: http.MethodDelete,
+			path:   "not/existing/file",
+			error:  api.NewResourceDoesNotExistError("error deleting artifact object for path: not/existing/file"),
+		},
+		{
+			name:   "DownloadWithIncorrectPath",
+			method: http.MethodGet,
+			path:   "path/../../file",
+			error:  api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+		},
+		{
+			name:   "UploadIntoMultipartUploadsDirectory",
+			method: http.MethodPut,
+			path:   ".multipart-uploads/upload-id/1.etag",
+			error:  api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+		},
+		{
+			name:   "DeleteMultipartUploadsDirectory",
+			method: http.MethodDelete,
+			path:   ".multipart-uploads",
+			error:  api.NewInvalidParameterValueError("provided 'path' parameter is invalid"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowArtifactsClient().WithMethod(
+					tt.method,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+type ProxyDisabledTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestProxyDisabledTestSuite(t *testing.T) {
+	suite.Run(t, new(ProxyDisabledTestSuite))
+}
+
+func (s *ProxyDisabledTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			strings.NewReader("content"),
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.ProxiedArtifactsRoute, "file",
+		),
+	)
+	s.Equal(
+		api.NewEndpointNotFound(
+			"mlflow-artifacts endpoints are disabled. To enable them, run the server with `--serve-artifacts`",
+		).Error(),
+		resp.Error(),
+	)
+}
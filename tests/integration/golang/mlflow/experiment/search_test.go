@@ -32,10 +32,12 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 		{
 			Name:           "Test Experiment 1",
 			LifecycleStage: models.LifecycleStageActive,
+			Tags:           []models.ExperimentTag{{Key: "team name", Value: "red"}},
 		},
 		{
 			Name:           "Test Experiment 2",
 			LifecycleStage: models.LifecycleStageActive,
+			Tags:           []models.ExperimentTag{{Key: "team name", Value: "green and blue"}},
 		},
 		{
 			Name:           "Test Experiment 3",
@@ -59,6 +61,7 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 			Name:           ex.Name,
 			NamespaceID:    s.DefaultNamespace.ID,
 			LifecycleStage: ex.LifecycleStage,
+			Tags:           ex.Tags,
 		})
 		s.Require().Nil(err)
 	}
@@ -80,6 +83,20 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 				"Test Experiment 4",
 			},
 		},
+		{
+			name: "TestFilterByTagWithIn",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.`team name` IN ('red', 'green and blue')",
+			},
+			expected: []string{"Test Experiment 1", "Test Experiment 2"},
+		},
+		{
+			name: "TestFilterByTagWithIsNull",
+			request: request.SearchExperimentsRequest{
+				Filter: `tags."team name" IS NULL AND name LIKE 'Test%'`,
+			},
+			expected: []string{"Test Experiment 3", "Test Experiment 4", "Test Experiment 5"},
+		},
 		{
 			name: "TestViewType",
 			request: request.SearchExperimentsRequest{
@@ -155,35 +172,43 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 		},
 		{
 			name:  "InvalidFilterValue",
-			error: api.NewInvalidParameterValueError("invalid numeric value 'cc'"),
+			error: api.NewInvalidParameterValueError("invalid filter at position 27: invalid numeric value 'cc'"),
 			request: request.SearchExperimentsRequest{
 				Filter: "attribute.creation_time > cc",
 			},
 		},
 		{
-			name:  "MalformedFilter",
-			error: api.NewInvalidParameterValueError("malformed filter 'invalid_filter'"),
+			name: "MalformedFilter",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 15: expected comparison operator, got end of filter",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "invalid_filter",
 			},
 		},
 		{
-			name:  "InvalidNumericValue",
-			error: api.NewInvalidParameterValueError("invalid numeric value 'invalid_value'"),
+			name: "InvalidNumericValue",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 17: invalid numeric value 'invalid_value'",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "creation_time > invalid_value",
 			},
 		},
 		{
-			name:  "InvalidStringOperator",
-			error: api.NewInvalidParameterValueError("invalid string attribute comparison operator '<'"),
+			name: "InvalidStringOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 16: invalid string attribute comparison operator '<'",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "attribute.name < 'value'",
 			},
 		},
 		{
-			name:  "InvalidTagOperator",
-			error: api.NewInvalidParameterValueError("invalid tag comparison operator '<'"),
+			name: "InvalidTagOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 11: invalid tag comparison operator '<'",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "tag.value < 'value'",
 			},
@@ -191,7 +216,7 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 		{
 			name: "InvalidEntity",
 			error: api.NewInvalidParameterValueError(
-				"invalid entity type 'invalid_entity'. Valid values are ['tag', 'attribute']",
+				"invalid filter at position 1: invalid entity type 'invalid_entity'. Valid values are ['tag', 'attribute']",
 			),
 			request: request.SearchExperimentsRequest{
 				Filter: "invalid_entity.name = value",
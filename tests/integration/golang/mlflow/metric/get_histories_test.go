@@ -13,6 +13,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/metric"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
@@ -219,6 +220,106 @@ func (s *GetHistoriesTestSuite) Test_Ok() {
 	}
 }
 
+func (s *GetHistoriesTestSuite) Test_Sampling() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	series := map[string][]float64{
+		"key1": {0, 5, 1, 9, 2, 3, -4, 1, 0, 7},
+		"key2": {1, 2, 3},
+	}
+	for key, values := range series {
+		for step, value := range values {
+			_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+				Key:       key,
+				Value:     value,
+				Timestamp: 1234567890 + int64(step),
+				RunID:     run.ID,
+				Step:      int64(step),
+				Iter:      int64(step),
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	type point struct {
+		Key  string
+		Step int64
+	}
+	tests := []struct {
+		name           string
+		request        *request.GetMetricHistoriesRequest
+		expectedPoints []point
+	}{
+		{
+			name: "LTTB",
+			request: &request.GetMetricHistoriesRequest{
+				RunIDs:    []string{run.ID},
+				NumPoints: 4,
+			},
+			expectedPoints: []point{
+				{Key: "key1", Step: 0},
+				{Key: "key1", Step: 3},
+				{Key: "key1", Step: 6},
+				{Key: "key1", Step: 9},
+				{Key: "key2", Step: 0},
+				{Key: "key2", Step: 1},
+				{Key: "key2", Step: 2},
+			},
+		},
+		{
+			name: "MinMaxWithStepRangeAndLimit",
+			request: &request.GetMetricHistoriesRequest{
+				RunIDs:     []string{run.ID},
+				NumPoints:  4,
+				Sampling:   request.SamplingMethodMinMax,
+				EndStep:    common.GetPointer[int64](8),
+				MaxResults: 5,
+			},
+			expectedPoints: []point{
+				{Key: "key1", Step: 0},
+				{Key: "key1", Step: 3},
+				{Key: "key1", Step: 6},
+				{Key: "key1", Step: 8},
+				{Key: "key2", Step: 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoriesRoute,
+				),
+			)
+
+			metrics, err := helpers.DecodeArrowMetrics(resp)
+			s.Require().Nil(err)
+			points := make([]point, len(metrics))
+			for i, metric := range metrics {
+				points[i] = point{Key: metric.Key, Step: metric.Step}
+			}
+			s.Equal(tt.expectedPoints, points)
+		})
+	}
+}
+
 func (s *GetHistoriesTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -252,6 +353,25 @@ func (s *GetHistoriesTestSuite) Test_Error() {
 			},
 			error: api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied."),
 		},
+		{
+			name: "IncorrectNumPoints",
+			request: request.GetMetricHistoriesRequest{
+				RunIDs:    []string{"id"},
+				NumPoints: 1,
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_points' supplied. It must be at least 3, but got value 1",
+			),
+		},
+		{
+			name: "UnsupportedSampling",
+			request: request.GetMetricHistoriesRequest{
+				RunIDs:    []string{"id"},
+				NumPoints: 10,
+				Sampling:  "unsupported",
+			},
+			error: api.NewInvalidParameterValueError("Invalid sampling 'unsupported'"),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -109,6 +109,82 @@ func (s *GetHistoriesBulkTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoriesBulkTestSuite) Test_Sampling() {
+	run1, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+	for step, value := range []float64{0, 5, 1, 9, 2, 3, -4, 1, 0, 7} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     value,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run1.ID,
+			Step:      int64(step),
+			Iter:      int64(step),
+		})
+		s.Require().Nil(err)
+	}
+
+	run2, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run2",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+	for step, value := range []float64{1, 2} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     value,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run2.ID,
+			Step:      int64(step),
+			Iter:      int64(step),
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := response.GetMetricHistoryBulkResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryBulkRequest{
+				RunIDs:    []string{run1.ID, run2.ID},
+				MetricKey: "key1",
+				NumPoints: 4,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryBulkRoute,
+		),
+	)
+
+	type point struct {
+		RunID string
+		Step  int64
+	}
+	points := make([]point, len(resp.Metrics))
+	for i, metric := range resp.Metrics {
+		points[i] = point{RunID: metric.RunID, Step: metric.Step}
+	}
+	s.Equal([]point{
+		{RunID: run1.ID, Step: 0},
+		{RunID: run1.ID, Step: 3},
+		{RunID: run1.ID, Step: 6},
+		{RunID: run1.ID, Step: 9},
+		{RunID: run2.ID, Step: 0},
+		{RunID: run2.ID, Step: 1},
+	}, points)
+}
+
 func (s *GetHistoriesBulkTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -11,6 +11,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -86,6 +87,219 @@ func (s *GetHistoryTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoryTestSuite) Test_Pagination() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// metrics are created in reverse order to make sure, that they are returned ordered by step.
+	for step := int64(5); step > 0; step-- {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     float64(step),
+			Timestamp: 1234567890 + step,
+			RunID:     run.ID,
+			Step:      step,
+			Iter:      step,
+		})
+		s.Require().Nil(err)
+	}
+
+	var steps []int64
+	req := request.GetMetricHistoryRequest{
+		RunID:      run.ID,
+		MetricKey:  "key1",
+		MaxResults: 2,
+	}
+	for pages := 1; ; pages++ {
+		resp := response.GetMetricHistoryResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+			),
+		)
+		s.LessOrEqual(len(resp.Metrics), 2)
+		for _, metric := range resp.Metrics {
+			steps = append(steps, metric.Step)
+		}
+		if resp.NextPageToken == "" {
+			s.Equal(3, pages)
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	s.Equal([]int64{1, 2, 3, 4, 5}, steps)
+
+	// without `max_results` and `page_token` the whole history is returned.
+	resp := response.GetMetricHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+	s.Len(resp.Metrics, 5)
+	s.Empty(resp.NextPageToken)
+}
+
+func (s *GetHistoryTestSuite) Test_Sampling() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step, value := range []float64{0, 5, 1, 9, 2, 3, -4, 1, 0, 7} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     value,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run.ID,
+			Step:      int64(step),
+			Iter:      int64(step),
+		})
+		s.Require().Nil(err)
+	}
+
+	// the long series has the minimal and the maximal values in the middle of it.
+	for step := int64(0); step < 100; step++ {
+		value := float64(step)
+		switch step {
+		case 37:
+			value = -10
+		case 61:
+			value = 1000
+		}
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key2",
+			Value:     value,
+			Timestamp: 1234567890 + step,
+			RunID:     run.ID,
+			Step:      step,
+			Iter:      step,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name          string
+		request       request.GetMetricHistoryRequest
+		expectedSteps []int64
+	}{
+		{
+			name: "LTTB",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				NumPoints: 4,
+			},
+			expectedSteps: []int64{0, 3, 6, 9},
+		},
+		{
+			name: "MinMax",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				NumPoints: 6,
+				Sampling:  request.SamplingMethodMinMax,
+			},
+			expectedSteps: []int64{0, 2, 3, 5, 6, 9},
+		},
+		{
+			name: "StepRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](2),
+				EndStep:   common.GetPointer[int64](5),
+			},
+			expectedSteps: []int64{2, 3, 4, 5},
+		},
+		{
+			name: "StepRangeWithSamplingAndPagination",
+			request: request.GetMetricHistoryRequest{
+				RunID:      run.ID,
+				MetricKey:  "key1",
+				StartStep:  common.GetPointer[int64](1),
+				NumPoints:  4,
+				MaxResults: 2,
+			},
+			expectedSteps: []int64{1, 3},
+		},
+		{
+			name: "LTTBLongSeries",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key2",
+				NumPoints: 4,
+			},
+			expectedSteps: []int64{0, 37, 61, 99},
+		},
+		{
+			name: "MinMaxLongSeries",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key2",
+				NumPoints: 4,
+				Sampling:  request.SamplingMethodMinMax,
+			},
+			expectedSteps: []int64{0, 37, 61, 99},
+		},
+		{
+			name: "MinMaxLongSeriesWithPagination",
+			request: request.GetMetricHistoryRequest{
+				RunID:      run.ID,
+				MetricKey:  "key2",
+				NumPoints:  4,
+				Sampling:   request.SamplingMethodMinMax,
+				MaxResults: 2,
+				// {"offset":2}
+				PageToken: "eyJvZmZzZXQiOjJ9",
+			},
+			expectedSteps: []int64{61, 99},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			steps := make([]int64, len(resp.Metrics))
+			for i, metric := range resp.Metrics {
+				steps[i] = metric.Step
+			}
+			s.Equal(tt.expectedSteps, steps)
+		})
+	}
+}
+
 func (s *GetHistoryTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -104,6 +318,17 @@ func (s *GetHistoryTestSuite) Test_Error() {
 			},
 			error: api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'"),
 		},
+		{
+			name: "IncorrectMaxResults",
+			request: request.GetMetricHistoryRequest{
+				RunID:      "id",
+				MetricKey:  "key1",
+				MaxResults: 1000001,
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000000, but got value 1000001",
+			),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
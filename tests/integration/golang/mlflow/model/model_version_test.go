@@ -0,0 +1,272 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ModelVersionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestModelVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelVersionTestSuite))
+}
+
+func (s *ModelVersionTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run",
+		Name:           "run",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{Name: "model"},
+		).WithResponse(
+			&response.RegisteredModelResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+
+	// 1. create two versions, they should be numbered sequentially.
+	for _, version := range []string{"1", "2"} {
+		resp := response.ModelVersionResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.CreateModelVersionRequest{
+					Name:   "model",
+					Source: "s3://bucket/model",
+					RunID:  run.ID,
+					Tags: []request.ModelVersionTagPartialRequest{
+						{Key: "key", Value: "value"},
+					},
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+			),
+		)
+		s.Equal(version, resp.ModelVersion.Version)
+		s.Equal(string(models.ModelVersionStageNone), resp.ModelVersion.CurrentStage)
+		s.Equal(models.ModelVersionStatusReady, resp.ModelVersion.Status)
+		s.Equal(run.ID, resp.ModelVersion.RunID)
+	}
+
+	// 2. move both versions to production, archiving the previous one.
+	for _, version := range []string{"1", "2"} {
+		resp := response.ModelVersionResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.TransitionModelVersionStageRequest{
+					Name:                    "model",
+					Version:                 version,
+					Stage:                   "production",
+					ArchiveExistingVersions: true,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+			),
+		)
+		s.Equal(string(models.ModelVersionStageProduction), resp.ModelVersion.CurrentStage)
+	}
+
+	latestResp := response.GetLatestVersionsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetLatestVersionsRequest{Name: "model"},
+		).WithResponse(
+			&latestResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetLatestVersionsRoute,
+		),
+	)
+	s.Require().Len(latestResp.ModelVersions, 2)
+	s.Equal("2", latestResp.ModelVersions[0].Version)
+	s.Equal(string(models.ModelVersionStageProduction), latestResp.ModelVersions[0].CurrentStage)
+	s.Equal("1", latestResp.ModelVersions[1].Version)
+	s.Equal(string(models.ModelVersionStageArchived), latestResp.ModelVersions[1].CurrentStage)
+
+	// 3. point alias to the second version and resolve it back.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRegisteredModelAliasRequest{Name: "model", Alias: "champion", Version: "2"},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	aliasResp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionByAliasRequest{Name: "model", Alias: "champion"},
+		).WithResponse(
+			&aliasResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Equal("2", aliasResp.ModelVersion.Version)
+	s.Equal([]string{"champion"}, aliasResp.ModelVersion.Aliases)
+
+	// 4. delete the second version. Its alias should be removed and its number never reused.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteModelVersionRequest{Name: "model", Version: "2"},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsDeleteRoute,
+		),
+	)
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionRequest{Name: "model", Version: "2"},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetRoute,
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("Model Version (name=model, version=2) not found").Error(), errResp.Error())
+
+	createResp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateModelVersionRequest{Name: "model", Source: "s3://bucket/model"},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+		),
+	)
+	s.Equal("3", createResp.ModelVersion.Version)
+
+	// 5. search versions of the model.
+	searchResp := response.SearchModelVersionsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.SearchModelVersionsRequest{Filter: "name = 'model' AND tags.key = 'value'"},
+		).WithResponse(
+			&searchResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+		),
+	)
+	s.Require().Len(searchResp.ModelVersions, 1)
+	s.Equal("1", searchResp.ModelVersions[0].Version)
+	s.Equal("model", searchResp.ModelVersions[0].Name)
+
+	searchResp = response.SearchModelVersionsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.SearchModelVersionsRequest{Filter: "version_number >= 2 AND source_path LIKE 's3://%'"},
+		).WithResponse(
+			&searchResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+		),
+	)
+	s.Require().Len(searchResp.ModelVersions, 1)
+	s.Equal("3", searchResp.ModelVersions[0].Version)
+
+	downloadResp := response.GetModelVersionDownloadURIResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionDownloadURIRequest{Name: "model", Version: "3"},
+		).WithResponse(
+			&downloadResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetDownloadURIRoute,
+		),
+	)
+	s.Equal("s3://bucket/model", downloadResp.ArtifactURI)
+}
+
+func (s *ModelVersionTestSuite) Test_Error() {
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{Name: "model"},
+		).WithResponse(
+			&response.RegisteredModelResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateModelVersionRequest
+	}{
+		{
+			name:    "EmptySourceProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'source'"),
+			request: &request.CreateModelVersionRequest{Name: "model"},
+		},
+		{
+			name:    "NotExistingRegisteredModel",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-existing not found"),
+			request: &request.CreateModelVersionRequest{Name: "not-existing", Source: "source"},
+		},
+		{
+			name:    "NotExistingRun",
+			error:   api.NewResourceDoesNotExistError("Run with id=not-existing not found"),
+			request: &request.CreateModelVersionRequest{Name: "model", Source: "source", RunID: "not-existing"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package model
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(RegisteredModelTestSuite))
+}
+
+func (s *RegisteredModelTestSuite) Test_Ok() {
+	// 1. create registered model.
+	createResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{
+				Name:        "model",
+				Description: "description",
+				Tags: []request.RegisteredModelTagPartialRequest{
+					{Key: "key1", Value: "value1"},
+				},
+			},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+	s.Equal("model", createResp.RegisteredModel.Name)
+	s.Equal("description", createResp.RegisteredModel.Description)
+	s.Equal([]response.RegisteredModelTagPartialResponse{{Key: "key1", Value: "value1"}}, createResp.RegisteredModel.Tags)
+	s.NotZero(createResp.RegisteredModel.CreationTimestamp)
+
+	// 2. set and delete tags.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRegisteredModelTagRequest{Name: "model", Key: "key2", Value: "value2"},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSetTagRoute,
+		),
+	)
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelTagRequest{Name: "model", Key: "key1"},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteTagRoute,
+		),
+	)
+
+	// 3. update and rename registered model.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.UpdateRegisteredModelRequest{Name: "model", Description: "new description"},
+		).WithResponse(
+			&response.RegisteredModelResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsUpdateRoute,
+		),
+	)
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RenameRegisteredModelRequest{Name: "model", NewName: "renamed"},
+		).WithResponse(
+			&response.RegisteredModelResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsRenameRoute,
+		),
+	)
+
+	// 4. check the final state.
+	getResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRegisteredModelRequest{Name: "renamed"},
+		).WithResponse(
+			&getResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal("renamed", getResp.RegisteredModel.Name)
+	s.Equal("new description", getResp.RegisteredModel.Description)
+	s.Equal([]response.RegisteredModelTagPartialResponse{{Key: "key2", Value: "value2"}}, getResp.RegisteredModel.Tags)
+
+	// 5. delete registered model.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelRequest{Name: "renamed"},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteRoute,
+		),
+	)
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRegisteredModelRequest{Name: "renamed"},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("Registered Model with name=renamed not found").Error(), errResp.Error())
+}
+
+func (s *RegisteredModelTestSuite) Test_Error() {
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{Name: "existing"},
+		).WithResponse(
+			&response.RegisteredModelResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:    "AlreadyExists",
+			error:   api.NewResourceAlreadyExistsError("Registered Model (name=existing) already exists."),
+			request: &request.CreateRegisteredModelRequest{Name: "existing"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
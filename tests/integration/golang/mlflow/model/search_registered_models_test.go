@@ -0,0 +1,144 @@
+package model
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchRegisteredModelsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchRegisteredModelsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchRegisteredModelsTestSuite))
+}
+
+func (s *SearchRegisteredModelsTestSuite) Test_Ok() {
+	for _, req := range []request.CreateRegisteredModelRequest{
+		{Name: "model-a", Tags: []request.RegisteredModelTagPartialRequest{{Key: "team", Value: "red"}}},
+		{Name: "Model-B", Tags: []request.RegisteredModelTagPartialRequest{{Key: "team", Value: "blue"}}},
+		{Name: "other"},
+	} {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&response.RegisteredModelResponse{},
+			).DoRequest(
+				"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+			),
+		)
+	}
+
+	tests := []struct {
+		name          string
+		request       request.SearchRegisteredModelsRequest
+		expectedNames []string
+		hasNextPage   bool
+	}{
+		{
+			name:          "WithoutFilter",
+			request:       request.SearchRegisteredModelsRequest{},
+			expectedNames: []string{"Model-B", "model-a", "other"},
+		},
+		{
+			name:          "FilterByNameWithILike",
+			request:       request.SearchRegisteredModelsRequest{Filter: "name ILIKE 'model%'"},
+			expectedNames: []string{"Model-B", "model-a"},
+		},
+		{
+			name:          "FilterByTag",
+			request:       request.SearchRegisteredModelsRequest{Filter: "tags.team = 'red'"},
+			expectedNames: []string{"model-a"},
+		},
+		{
+			name:          "OrderByNameDesc",
+			request:       request.SearchRegisteredModelsRequest{OrderBy: []string{"name DESC"}},
+			expectedNames: []string{"other", "model-a", "Model-B"},
+		},
+		{
+			name:          "WithMaxResults",
+			request:       request.SearchRegisteredModelsRequest{MaxResults: 2},
+			expectedNames: []string{"Model-B", "model-a"},
+			hasNextPage:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRegisteredModelsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			names := make([]string, len(resp.RegisteredModels))
+			for n, registeredModel := range resp.RegisteredModels {
+				names[n] = registeredModel.Name
+			}
+			s.Equal(tt.expectedNames, names)
+			s.Equal(tt.hasNextPage, resp.NextPageToken != "")
+		})
+	}
+}
+
+func (s *SearchRegisteredModelsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchRegisteredModelsRequest
+	}{
+		{
+			name: "InvalidAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 1: invalid attribute 'source'. Valid values are ['name']",
+			),
+			request: request.SearchRegisteredModelsRequest{Filter: "source = 'value'"},
+		},
+		{
+			name: "InvalidTagOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 11: invalid tag comparison operator 'IN'",
+			),
+			request: request.SearchRegisteredModelsRequest{Filter: "tags.team IN ('red')"},
+		},
+		{
+			name: "InvalidOrderByAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid attribute 'unknown'. Valid values are " +
+					"['name', 'creation_timestamp', 'last_updated_timestamp']",
+			),
+			request: request.SearchRegisteredModelsRequest{OrderBy: []string{"unknown"}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
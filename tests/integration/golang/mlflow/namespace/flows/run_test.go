@@ -455,6 +455,12 @@ func (s *RunFlowTestSuite) testRunFlow(
 							Step:      1,
 							Value:     1.1,
 							Timestamp: 123456789,
+							Summary: &response.RunMetricSummaryPartialResponse{
+								First: 1.1,
+								Min:   common.GetPointer(1.1),
+								Max:   common.GetPointer(1.1),
+								Count: 1,
+							},
 						},
 					},
 				},
@@ -483,6 +489,12 @@ func (s *RunFlowTestSuite) testRunFlow(
 							Step:      1,
 							Value:     2.2,
 							Timestamp: 123456789,
+							Summary: &response.RunMetricSummaryPartialResponse{
+								First: 2.2,
+								Min:   common.GetPointer(2.2),
+								Max:   common.GetPointer(2.2),
+								Count: 1,
+							},
 						},
 					},
 				},
@@ -786,6 +798,12 @@ func (s *RunFlowTestSuite) testRunFlow(
 							Step:      1,
 							Value:     1.1,
 							Timestamp: 123456789,
+							Summary: &response.RunMetricSummaryPartialResponse{
+								First: 1.1,
+								Min:   common.GetPointer(1.1),
+								Max:   common.GetPointer(1.1),
+								Count: 1,
+							},
 						},
 					},
 				},
@@ -831,6 +849,12 @@ func (s *RunFlowTestSuite) testRunFlow(
 							Step:      1,
 							Value:     2.2,
 							Timestamp: 123456789,
+							Summary: &response.RunMetricSummaryPartialResponse{
+								First: 2.2,
+								Min:   common.GetPointer(2.2),
+								Max:   common.GetPointer(2.2),
+								Count: 1,
+							},
 						},
 					},
 				},
@@ -0,0 +1,124 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunTreeTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunTreeTestSuite))
+}
+
+func (s *GetRunTreeTestSuite) Test_Ok() {
+	// create the root run, two children and one grandchild.
+	for i, run := range []struct {
+		id       string
+		parentID string
+		status   models.Status
+	}{
+		{id: "root", status: models.StatusRunning},
+		{id: "child1", parentID: "root", status: models.StatusFinished},
+		{id: "child2", parentID: "root", status: models.StatusFailed},
+		{id: "grandchild", parentID: "child1", status: models.StatusFinished},
+		{id: "other", status: models.StatusRunning},
+	} {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             run.id,
+			Name:           run.id,
+			Status:         run.status,
+			SourceType:     "JOB",
+			StartTime:      sql.NullInt64{Int64: int64(1234567890 + i), Valid: true},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if run.parentID != "" {
+			_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+				Key:   common.ParentRunIDTagKey,
+				Value: run.parentID,
+				RunID: run.id,
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	resp := response.GetRunTreeResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunTreeRequest{RunID: "root"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetTreeRoute,
+		),
+	)
+
+	s.Equal("root", resp.Run.Info.ID)
+	s.Equal(fmt.Sprintf("%d", *s.DefaultExperiment.ID), resp.Run.Info.ExperimentID)
+	s.Equal(map[string]int{
+		string(models.StatusFinished): 2,
+		string(models.StatusFailed):   1,
+	}, resp.Run.ChildStatusCounts)
+	s.Require().Len(resp.Run.Children, 2)
+	s.Equal("child1", resp.Run.Children[0].Info.ID)
+	s.Equal(map[string]int{string(models.StatusFinished): 1}, resp.Run.Children[0].ChildStatusCounts)
+	s.Require().Len(resp.Run.Children[0].Children, 1)
+	s.Equal("grandchild", resp.Run.Children[0].Children[0].Info.ID)
+	s.Equal("child2", resp.Run.Children[1].Info.ID)
+	s.Nil(resp.Run.Children[1].ChildStatusCounts)
+	s.Nil(resp.Run.Children[1].Children)
+}
+
+func (s *GetRunTreeTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetRunTreeRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.GetRunTreeRequest{},
+			error: api.NewInvalidParameterValueError(
+				"Missing value for required parameter 'run_id'",
+			),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.GetRunTreeRequest{
+				RunID: "id",
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetTreeRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -155,7 +157,12 @@ func (s *LogBatchTestSuite) TestParams_Ok() {
 			params, err := s.ParamFixtures.GetParamsByRunID(context.Background(), run.ID)
 			s.Require().Nil(err)
 			for _, param := range tt.request.Params {
-				s.Contains(params, models.Param{Key: param.Key, Value: param.Value, RunID: run.ID})
+				s.Contains(params, models.Param{
+					Key:       param.Key,
+					Value:     param.Value,
+					RunID:     run.ID,
+					ValueType: common.ParamTypeString,
+				})
 			}
 		})
 	}
@@ -415,6 +422,78 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 	}
 }
 
+func (s *LogBatchTestSuite) TestMetricsSummary_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// the summary is maintained across the batches. the late batch with the earlier metric
+	// replaces only the first value, and the duplicate of the existing metric is not counted.
+	for _, metrics := range [][]request.MetricPartialRequest{
+		{
+			{Key: "loss", Value: 3.0, Timestamp: 1687325992, Step: 1},
+			{Key: "loss", Value: 1.0, Timestamp: 1687325993, Step: 2},
+		},
+		{
+			{Key: "loss", Value: 5.0, Timestamp: 1687325994, Step: 3},
+			{Key: "loss", Value: -2.0, Timestamp: 1687325995, Step: 4},
+		},
+		{
+			{Key: "loss", Value: "NaN", Timestamp: 1687325991, Step: 0},
+			{Key: "loss", Value: 5.0, Timestamp: 1687325994, Step: 3},
+		},
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				&request.LogBatchRequest{
+					RunID:   run.ID,
+					Metrics: metrics,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogBatchRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	resp := response.GetRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{
+				RunID: run.ID,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.Equal([]response.RunMetricPartialResponse{
+		{
+			Key:       "loss",
+			Value:     -2.0,
+			Timestamp: 1687325995,
+			Step:      4,
+			Summary: &response.RunMetricSummaryPartialResponse{
+				First: common.NANValue,
+				Min:   common.GetPointer(-2.0),
+				Max:   common.GetPointer(5.0),
+				Count: 5,
+			},
+		},
+	}, resp.Run.Data.Metrics)
+}
+
 func (s *LogBatchTestSuite) Test_Error() {
 	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
 		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
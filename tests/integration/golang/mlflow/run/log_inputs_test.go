@@ -0,0 +1,236 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogInputsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogInputsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogInputsTestSuite))
+}
+
+func (s *LogInputsTestSuite) Test_Ok() {
+	runs := make([]*models.Run, 2)
+	for n := range runs {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+			ExperimentID:   *s.DefaultExperiment.ID,
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			Status:         models.StatusRunning,
+		})
+		s.Require().Nil(err)
+		runs[n] = run
+	}
+
+	// 1. log inputs, the second time for the first run to check that logging is idempotent.
+	for _, req := range []request.LogInputsRequest{
+		{
+			RunID: runs[0].ID,
+			Datasets: []request.DatasetInputPartialRequest{
+				{
+					Tags: []request.TagPartialRequest{{Key: "mlflow.data.context", Value: "training"}},
+					Dataset: request.DatasetPartialRequest{
+						Name:       "Train",
+						Digest:     "digest1",
+						SourceType: "local",
+						Source:     `{"uri": "/data/train.csv"}`,
+						Schema:     `{"mlflow_colspec": []}`,
+					},
+				},
+			},
+		},
+		{
+			RunID: runs[0].ID,
+			Datasets: []request.DatasetInputPartialRequest{
+				{
+					Tags: []request.TagPartialRequest{{Key: "mlflow.data.context", Value: "training"}},
+					Dataset: request.DatasetPartialRequest{
+						Name:       "Train",
+						Digest:     "digest1",
+						SourceType: "local",
+						Source:     `{"uri": "/data/train.csv"}`,
+						Schema:     `{"mlflow_colspec": []}`,
+					},
+				},
+			},
+		},
+		{
+			RunID: runs[1].ID,
+			Datasets: []request.DatasetInputPartialRequest{
+				{
+					Tags: []request.TagPartialRequest{{Key: "mlflow.data.context", Value: "evaluation"}},
+					Dataset: request.DatasetPartialRequest{
+						Name:       "eval",
+						Digest:     "digest2",
+						SourceType: "local",
+						Source:     `{"uri": "/data/eval.csv"}`,
+					},
+				},
+			},
+		},
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	// 2. check that logged inputs are returned by `runs/get`.
+	getResp := response.GetRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{RunID: runs[0].ID},
+		).WithResponse(
+			&getResp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.Equal(response.RunInputsPartialResponse{
+		DatasetInputs: []response.DatasetInputPartialResponse{
+			{
+				Tags: []response.RunTagPartialResponse{{Key: "mlflow.data.context", Value: "training"}},
+				Dataset: response.DatasetPartialResponse{
+					Name:       "Train",
+					Digest:     "digest1",
+					SourceType: "local",
+					Source:     `{"uri": "/data/train.csv"}`,
+					Schema:     `{"mlflow_colspec": []}`,
+				},
+			},
+		},
+	}, getResp.Run.Inputs)
+
+	// 3. check that runs could be filtered by datasets.
+	tests := []struct {
+		name         string
+		filter       string
+		expectedRuns []string
+	}{
+		{
+			name:         "FilterByDatasetName",
+			filter:       "datasets.name = 'Train'",
+			expectedRuns: []string{runs[0].ID},
+		},
+		{
+			name:         "FilterByDatasetNameWithILike",
+			filter:       "datasets.name ILIKE 'train'",
+			expectedRuns: []string{runs[0].ID},
+		},
+		{
+			name:         "FilterByDatasetDigestWithIn",
+			filter:       "datasets.digest IN ('digest1', 'digest2')",
+			expectedRuns: []string{runs[0].ID, runs[1].ID},
+		},
+		{
+			name:         "FilterByDatasetContext",
+			filter:       "dataset.context = 'evaluation'",
+			expectedRuns: []string{runs[1].ID},
+		},
+		{
+			name:         "FilterByNotExistingDataset",
+			filter:       "datasets.name = 'test'",
+			expectedRuns: []string{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						Filter:        tt.filter,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			runIDs := make([]string, len(resp.Runs))
+			for n, run := range resp.Runs {
+				runIDs[n] = run.Info.ID
+			}
+			s.ElementsMatch(tt.expectedRuns, runIDs)
+		})
+	}
+}
+
+func (s *LogInputsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogInputsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.LogInputsRequest{},
+		},
+		{
+			name:  "EmptyDatasetDigest",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.digest'"),
+			request: request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{
+					{Dataset: request.DatasetPartialRequest{Name: "name", SourceType: "local", Source: "{}"}},
+				},
+			},
+		},
+		{
+			name:  "NotExistingRun",
+			error: api.NewResourceDoesNotExistError("Unable to find active run 'id'"),
+			request: request.LogInputsRequest{
+				RunID: "id",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -51,13 +52,19 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Step:      1,
 			},
 			expectedMetric: &models.LatestMetric{
-				Key:       "key1",
-				Value:     1.1,
-				Timestamp: 1234567890,
-				Step:      1,
-				IsNan:     false,
-				RunID:     run.ID,
-				LastIter:  1,
+				Key:            "key1",
+				Value:          1.1,
+				Timestamp:      1234567890,
+				Step:           1,
+				IsNan:          false,
+				RunID:          run.ID,
+				LastIter:       1,
+				MinValue:       common.GetPointer(1.1),
+				MaxValue:       common.GetPointer(1.1),
+				FirstValue:     1.1,
+				FirstStep:      1,
+				FirstTimestamp: 1234567890,
+				Count:          1,
 			},
 		},
 		{
@@ -70,13 +77,19 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Step:      1,
 			},
 			expectedMetric: &models.LatestMetric{
-				Key:       "key1",
-				Value:     0,
-				Timestamp: 1234567890,
-				Step:      1,
-				IsNan:     true,
-				RunID:     run.ID,
-				LastIter:  2,
+				Key:            "key1",
+				Value:          0,
+				Timestamp:      1234567890,
+				Step:           1,
+				IsNan:          true,
+				RunID:          run.ID,
+				LastIter:       2,
+				MinValue:       common.GetPointer(1.1),
+				MaxValue:       common.GetPointer(1.1),
+				FirstValue:     1.1,
+				FirstStep:      1,
+				FirstTimestamp: 1234567890,
+				Count:          2,
 			},
 		},
 		{
@@ -89,12 +102,18 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Step:      1,
 			},
 			expectedMetric: &models.LatestMetric{
-				Key:       "key1",
-				Value:     math.MaxFloat64,
-				Timestamp: 1234567890,
-				Step:      1,
-				RunID:     run.ID,
-				LastIter:  3,
+				Key:            "key1",
+				Value:          math.MaxFloat64,
+				Timestamp:      1234567890,
+				Step:           1,
+				RunID:          run.ID,
+				LastIter:       3,
+				MinValue:       common.GetPointer(1.1),
+				MaxValue:       common.GetPointer(math.MaxFloat64),
+				FirstValue:     1.1,
+				FirstStep:      1,
+				FirstTimestamp: 1234567890,
+				Count:          3,
 			},
 		},
 		{
@@ -107,12 +126,18 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Step:      1,
 			},
 			expectedMetric: &models.LatestMetric{
-				Key:       "key1",
-				Value:     -math.MaxFloat64,
-				Timestamp: 1234567890,
-				Step:      1,
-				RunID:     run.ID,
-				LastIter:  4,
+				Key:            "key1",
+				Value:          -math.MaxFloat64,
+				Timestamp:      1234567890,
+				Step:           1,
+				RunID:          run.ID,
+				LastIter:       4,
+				MinValue:       common.GetPointer(-math.MaxFloat64),
+				MaxValue:       common.GetPointer(math.MaxFloat64),
+				FirstValue:     1.1,
+				FirstStep:      1,
+				FirstTimestamp: 1234567890,
+				Count:          4,
 			},
 		},
 	}
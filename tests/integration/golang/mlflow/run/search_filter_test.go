@@ -0,0 +1,203 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchFilterTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchFilterTestSuite))
+}
+
+func (s *SearchFilterTestSuite) Test_Ok() {
+	// 1. prepare runs with tags and params, which have special characters in keys and values.
+	for _, run := range []struct {
+		id      string
+		endTime sql.NullInt64
+		tags    map[string]string
+		params  map[string]string
+	}{
+		{
+			id:      "id1",
+			endTime: sql.NullInt64{Int64: 123456789, Valid: true},
+			tags:    map[string]string{"team name": "red and blue"},
+			params:  map[string]string{"learning-rate": "0.1", "optimizer": "adam"},
+		},
+		{
+			id:     "id2",
+			tags:   map[string]string{"team name": "green"},
+			params: map[string]string{"learning-rate": "0.01", "optimizer": "sgd"},
+		},
+		{
+			id:     "id3",
+			params: map[string]string{"optimizer": "it's"},
+		},
+	} {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             run.id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			StartTime:      sql.NullInt64{Int64: 123456789, Valid: true},
+			EndTime:        run.endTime,
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for key, value := range run.tags {
+			_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{Key: key, Value: value, RunID: run.id})
+			s.Require().Nil(err)
+		}
+		for key, value := range run.params {
+			_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+				Key: key, Value: value, RunID: run.id,
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	// 2. search runs with the different filters.
+	tests := []struct {
+		name         string
+		filter       string
+		expectedRuns []string
+	}{
+		{
+			name:         "ValueContainingAnd",
+			filter:       "tags.`team name` = 'red and blue'",
+			expectedRuns: []string{"id1"},
+		},
+		{
+			name:         "QuotedKeyWithSpecialCharacters",
+			filter:       `params."learning-rate" = '0.01' AND attributes.status = 'RUNNING'`,
+			expectedRuns: []string{"id2"},
+		},
+		{
+			name:         "EscapedQuoteInValue",
+			filter:       "params.optimizer = 'it''s'",
+			expectedRuns: []string{"id3"},
+		},
+		{
+			name:         "ParamsIn",
+			filter:       "params.optimizer IN ('adam', 'sgd')",
+			expectedRuns: []string{"id1", "id2"},
+		},
+		{
+			name:         "TagsNotIn",
+			filter:       "tags.`team name` NOT IN ('green')",
+			expectedRuns: []string{"id1"},
+		},
+		{
+			name:         "TagsIsNull",
+			filter:       "tags.`team name` IS NULL",
+			expectedRuns: []string{"id3"},
+		},
+		{
+			name:         "ParamsIsNotNull",
+			filter:       `params."learning-rate" IS NOT NULL`,
+			expectedRuns: []string{"id1", "id2"},
+		},
+		{
+			name:         "AttributeIsNull",
+			filter:       "attributes.end_time IS NULL and params.optimizer ILIKE 'SGD'",
+			expectedRuns: []string{"id2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						Filter:        tt.filter,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			runIDs := make([]string, len(resp.Runs))
+			for n, run := range resp.Runs {
+				runIDs[n] = run.Info.ID
+			}
+			s.ElementsMatch(tt.expectedRuns, runIDs)
+		})
+	}
+}
+
+func (s *SearchFilterTestSuite) Test_Error() {
+	tests := []struct {
+		name   string
+		filter string
+		error  *api.ErrorResponse
+	}{
+		{
+			name:   "MalformedFilter",
+			filter: "params.optimizer = 'adam' OR params.optimizer = 'sgd'",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 27: 'OR' is not supported, comparisons could be combined with 'AND' only",
+			),
+		},
+		{
+			name:   "InvalidMetricOperator",
+			filter: "metrics.loss IN (1, 2)",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 14: invalid metric comparison operator 'IN'",
+			),
+		},
+		{
+			name:   "InvalidAttribute",
+			filter: "attributes.unknown = 'value'",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 12: invalid attribute 'unknown'. " +
+					"Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']",
+			),
+		},
+		{
+			name:   "InvalidNumericValue",
+			filter: "attributes.start_time > 'yesterday'",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter at position 25: invalid numeric value ''yesterday''",
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						Filter:        tt.filter,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
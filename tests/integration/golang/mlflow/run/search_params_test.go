@@ -0,0 +1,141 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchParamsTestSuite struct {
+	helpers.BaseTestSuite
+	runs []*models.Run
+}
+
+func TestSearchParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchParamsTestSuite))
+}
+
+func (s *SearchParamsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.runs, err = s.RunFixtures.CreateExampleRuns(context.Background(), s.DefaultExperiment, 3)
+	s.Require().Nil(err)
+	for i, value := range []string{"0.001", "1e-2", "adam"} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "lr",
+			Value: value,
+			RunID: s.runs[i].ID,
+		})
+		s.Require().Nil(err)
+	}
+	for i, value := range []string{"True", "1"} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "flag",
+			Value: value,
+			RunID: s.runs[i].ID,
+		})
+		s.Require().Nil(err)
+	}
+}
+
+func (s *SearchParamsTestSuite) Test_Ok() {
+	tests := []struct {
+		name   string
+		filter string
+		runs   []*models.Run
+	}{
+		{
+			name:   "NumericLess",
+			filter: `params.lr < 0.005`,
+			runs:   []*models.Run{s.runs[0]},
+		},
+		{
+			name:   "NumericGreaterOrEqualSkipsStrings",
+			filter: `params.lr >= 0`,
+			runs:   []*models.Run{s.runs[0], s.runs[1]},
+		},
+		{
+			name:   "NumericEqual",
+			filter: `params.lr = 0.01`,
+			runs:   []*models.Run{s.runs[1]},
+		},
+		{
+			name:   "StringEqual",
+			filter: `params.lr = '0.01'`,
+			runs:   []*models.Run{},
+		},
+		{
+			name:   "StringEqualAsLogged",
+			filter: `params.lr = '1e-2'`,
+			runs:   []*models.Run{s.runs[1]},
+		},
+		{
+			name:   "StringGreater",
+			filter: `params.lr > 'b'`,
+			runs:   []*models.Run{},
+		},
+		{
+			name:   "StringGreaterOrEqual",
+			filter: `params.lr >= 'adam'`,
+			runs:   []*models.Run{s.runs[2]},
+		},
+		{
+			name:   "NumericNotEqualKeepsStrings",
+			filter: `params.lr != 0.01`,
+			runs:   []*models.Run{s.runs[0], s.runs[2]},
+		},
+		{
+			name:   "NumericNotEqualKeepsBools",
+			filter: `params.flag != 1`,
+			runs:   []*models.Run{s.runs[0]},
+		},
+		{
+			name:   "NumericEqualSkipsBools",
+			filter: `params.flag = 1`,
+			runs:   []*models.Run{s.runs[1]},
+		},
+		{
+			name:   "StringEqualBool",
+			filter: `params.flag = 'True'`,
+			runs:   []*models.Run{s.runs[0]},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						Filter:        tt.filter,
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			runIDs := make([]string, len(resp.Runs))
+			for i, run := range resp.Runs {
+				runIDs[i] = run.Info.ID
+			}
+			expectedIDs := make([]string, len(tt.runs))
+			for i, run := range tt.runs {
+				expectedIDs[i] = run.ID
+			}
+			s.ElementsMatch(expectedIDs, runIDs)
+		})
+	}
+}